@@ -0,0 +1,513 @@
+// Package borshtest fills generated structs with random values and compares them after a round trip.
+// The tests written by borshgen -gen-tests use it. It reads the struct tags the way the generator does,
+// so it only sets and compares the fields that are encoded
+package borshtest
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+// Options tells Fill and Diff how the structs were generated
+type Options struct {
+	Tag      string // primary struct tag, e.g. msg
+	Fallback string // tag read when the primary one is missing
+	Ignore   string // tag value of the fields that are not encoded, "-" when empty
+	// Variants holds a value of every variant of each Borsh enum interface, e.g.
+	//
+	//	reflect.TypeOf((*Instruction)(nil)).Elem(): {Transfer{}, &Mint{}, Burn{}}
+	//
+	// Interface fields of other types are neither filled nor compared
+	Variants map[reflect.Type][]any
+}
+
+// maxDepth is the struct nesting below which Fill leaves pointers nil and slices and maps empty,
+// so recursive types stay small
+const maxDepth = 3
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	bigIntType      = reflect.TypeOf((*big.Int)(nil))
+	unmarshalerType = reflect.TypeOf((*borsh.BinaryUnmarshaler)(nil)).Elem()
+	readerFromType  = reflect.TypeOf((*borsh.ReaderFrom)(nil)).Elem()
+	validatorType   = reflect.TypeOf((*validator)(nil)).Elem()
+)
+
+// validator is implemented by the value enums borshgen generates
+type validator interface {
+	Valid() bool
+}
+
+// Tag modifiers that do not name a custom encoder
+var modifiers = map[string]bool{
+	"": true, "set": true, "nested": true, "inline": true, "u128": true, "i128": true,
+	"unix": true, "unixmilli": true, "unixnano": true,
+	"string": true, "bool": true, "byte": true, "rune": true, "uintptr": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true, "complex64": true, "complex128": true,
+}
+
+// field is an encoded field of a struct, or an inline embed its fields are promoted through
+type field struct {
+	name   string
+	index  []int
+	mod    string // tag modifier, e.g. set or unixmilli
+	max    int    // limit of a max:"N" tag, 0 when there is none
+	depth  int    // number of inline embeds the field is promoted through
+	inline bool
+	skip   bool // neither filled nor compared
+}
+
+// Fill sets every encoded field of the struct v points to to a random value
+func Fill(rng *rand.Rand, v any, opts Options) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		panic(fmt.Sprintf("borshtest: Fill needs a non-nil pointer, got %T", v))
+	}
+	opts.fill(rng, rv.Elem(), "", 0, 0, false)
+}
+
+// Diff compares the encoded fields of want and got and describes the first difference,
+// or returns "" when there is none. Nil and empty slices and maps are equal, as are
+// times at the same instant and a nil *big.Int and zero
+func Diff(want, got any, opts Options) string {
+	w, g := reflect.ValueOf(want), reflect.ValueOf(got)
+	if !w.IsValid() || !g.IsValid() || w.Type() != g.Type() {
+		return fmt.Sprintf("got a %T, want a %T", got, want)
+	}
+	t := w.Type()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return opts.diff(t.Name(), w, g)
+}
+
+// tag returns the modifier of a field and whether it is ignored
+func (o Options) tag(sf reflect.StructField) (mod string, ignore bool) {
+	ignoreValue := o.Ignore
+	if ignoreValue == "" {
+		ignoreValue = "-"
+	}
+	if value := sf.Tag.Get(o.Tag); value != "" {
+		if value == ignoreValue {
+			return "", true
+		}
+		parts := strings.Split(value, ",")
+		if len(parts) > 1 {
+			mod = parts[1]
+		}
+		return mod, false
+	}
+	return "", o.Fallback != "" && sf.Tag.Get(o.Fallback) == ignoreValue
+}
+
+// fields lists the encoded fields of a struct type with its inline embeds flattened.
+// As in Go, a promoted field is shadowed by a field of the same name at a shallower depth
+func (o Options) fields(t reflect.Type) []field {
+	all := o.flatten(t, nil, 0)
+	shallowest := make(map[string]int)
+	count := make(map[string]int)
+	for _, f := range all {
+		if f.inline {
+			continue
+		}
+		depth, ok := shallowest[f.name]
+		switch {
+		case !ok || f.depth < depth:
+			shallowest[f.name] = f.depth
+			count[f.name] = 1
+		case f.depth == depth:
+			count[f.name]++
+		}
+	}
+	visible := all[:0]
+	for _, f := range all {
+		if f.inline || (f.depth == shallowest[f.name] && count[f.name] == 1) {
+			visible = append(visible, f)
+		}
+	}
+	return visible
+}
+
+func (o Options) flatten(t reflect.Type, prefix []int, depth int) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		mod, ignore := o.tag(sf)
+		if ignore {
+			continue
+		}
+		index := append(append([]int{}, prefix...), i)
+		if sf.Anonymous && mod == "inline" {
+			elem := sf.Type
+			if elem.Kind() == reflect.Pointer {
+				elem = elem.Elem()
+			}
+			fields = append(fields, field{name: sf.Name, index: index, depth: depth, inline: true})
+			fields = append(fields, o.flatten(elem, index, depth+1)...)
+			continue
+		}
+		max, _ := strconv.Atoi(sf.Tag.Get("max"))
+		fields = append(fields, field{
+			name:  sf.Name,
+			index: index,
+			mod:   mod,
+			max:   max,
+			depth: depth,
+			skip:  !sf.IsExported() || !modifiers[mod] && !strings.HasPrefix(mod, "[]") || !o.supported(sf.Type),
+		})
+	}
+	return fields
+}
+
+// supported reports whether Fill can set values of t that marshal. Interfaces need Variants, and
+// named types with their own UnmarshalBorsh or ReadBorsh methods may need values only they know
+func (o Options) supported(t reflect.Type) bool {
+	if t == timeType || t == bigIntType {
+		return true
+	}
+	if t.Name() != "" && t.Kind() != reflect.Struct {
+		if p := reflect.PointerTo(t); p.Implements(unmarshalerType) || p.Implements(readerFromType) {
+			return false
+		}
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return len(o.Variants[t]) > 0
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return o.supported(t.Elem())
+	case reflect.Map:
+		return o.supported(t.Key()) && o.supported(t.Elem())
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer, reflect.Complex64, reflect.Complex128:
+		return false
+	}
+	return true
+}
+
+// reach returns the field of v at index, going through embedded pointers.
+// Nil pointers are allocated when alloc is set, otherwise the field is unreachable
+func reach(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !alloc || !v.CanSet() {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// fill sets v to a random value. Pointers are only left nil when they are optional,
+// as a nil slice element or map value has no encoding
+func (o Options) fill(rng *rand.Rand, v reflect.Value, mod string, max, depth int, optional bool) {
+	t := v.Type()
+	switch t {
+	case timeType:
+		v.Set(reflect.ValueOf(randomTime(rng, mod)))
+		return
+	case bigIntType:
+		v.Set(reflect.ValueOf(randomBig(rng, mod)))
+		return
+	}
+	if values := enumValues(t); values != nil {
+		if len(values) > 0 {
+			v.Set(values[rng.Intn(len(values))])
+		}
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		v.SetBool(rng.Intn(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(rng.Uint64()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(rng.Uint64())
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(rng.NormFloat64() * 1e6)
+	case reflect.String:
+		v.SetString(randomString(rng, max))
+	case reflect.Slice:
+		n := rng.Intn(4)
+		switch {
+		case mod == "set":
+			// A set is written sorted, so a longer one would not decode in the order it was filled
+			n = rng.Intn(2)
+		case t.Elem().Kind() == reflect.Uint8:
+			n = rng.Intn(17)
+		}
+		if depth >= maxDepth {
+			n = 0
+		}
+		if max > 0 && n > max {
+			n = max
+		}
+		s := reflect.MakeSlice(t, n, n)
+		for i := 0; i < n; i++ {
+			o.fill(rng, s.Index(i), elemModifier(mod), 0, depth, false)
+		}
+		v.Set(s)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			o.fill(rng, v.Index(i), elemModifier(mod), 0, depth, false)
+		}
+	case reflect.Map:
+		n := rng.Intn(4)
+		if depth >= maxDepth {
+			n = 0
+		}
+		if max > 0 && n > max {
+			n = max
+		}
+		m := reflect.MakeMapWithSize(t, n)
+		for i := 0; i < n; i++ {
+			key, value := reflect.New(t.Key()).Elem(), reflect.New(t.Elem()).Elem()
+			o.fill(rng, key, "", 0, depth, false)
+			o.fill(rng, value, "", 0, depth, false)
+			m.SetMapIndex(key, value)
+		}
+		v.Set(m)
+	case reflect.Pointer:
+		if optional && (depth >= maxDepth || rng.Intn(3) == 0) {
+			v.Set(reflect.Zero(t))
+			return
+		}
+		p := reflect.New(t.Elem())
+		o.fill(rng, p.Elem(), mod, max, depth, false)
+		v.Set(p)
+	case reflect.Interface:
+		variants := o.Variants[t]
+		if len(variants) == 0 {
+			return
+		}
+		vt := reflect.TypeOf(variants[rng.Intn(len(variants))])
+		var variant reflect.Value
+		if vt.Kind() == reflect.Pointer {
+			variant = reflect.New(vt.Elem())
+			o.fill(rng, variant.Elem(), "", 0, depth, false)
+		} else {
+			variant = reflect.New(vt).Elem()
+			o.fill(rng, variant, "", 0, depth, false)
+		}
+		v.Set(variant)
+	case reflect.Struct:
+		o.fillStruct(rng, v, depth+1)
+	}
+}
+
+func (o Options) fillStruct(rng *rand.Rand, v reflect.Value, depth int) {
+	// A field of an instantiated generic struct may have a type parameter as its type,
+	// and a nil pointer type argument has no encoding
+	optional := !strings.Contains(v.Type().Name(), "[")
+	for _, f := range o.fields(v.Type()) {
+		fv, ok := reach(v, f.index, true)
+		if !ok || !fv.CanSet() {
+			continue
+		}
+		if f.inline {
+			// A pointer embed is flattened, so it must not be nil even when none of its fields are filled
+			if fv.Kind() == reflect.Pointer && fv.IsNil() {
+				fv.Set(reflect.New(fv.Type().Elem()))
+			}
+			continue
+		}
+		if !f.skip {
+			o.fill(rng, fv, f.mod, f.max, depth, optional)
+		}
+	}
+}
+
+// elemModifier is the modifier of the elements of a field: a set's elements are plain values
+func elemModifier(mod string) string {
+	if mod == "set" {
+		return ""
+	}
+	return mod
+}
+
+// alphabet holds the runes of random strings, one of them two bytes long in UTF-8
+var alphabet = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 é")
+
+// randomString returns up to 8 bytes of text, and at most max bytes when max is set
+func randomString(rng *rand.Rand, max int) string {
+	n := rng.Intn(9)
+	if max > 0 && n > max {
+		n = max
+	}
+	var b strings.Builder
+	for b.Len() < n {
+		c := alphabet[rng.Intn(len(alphabet))]
+		if b.Len()+len(string(c)) > n {
+			c = 'x'
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// randomTime returns a UTC time with the precision the modifier encodes
+func randomTime(rng *rand.Rand, mod string) time.Time {
+	sec, nsec := rng.Int63n(1<<33), rng.Int63n(int64(time.Second))
+	switch mod {
+	case "unix":
+		nsec = 0
+	case "unixmilli":
+		nsec -= nsec % int64(time.Millisecond)
+	}
+	return time.Unix(sec, nsec).UTC()
+}
+
+// randomBig returns an integer in the range of a u128, or of an i128 for the i128 modifier
+func randomBig(rng *rand.Rand, mod string) *big.Int {
+	hi := rng.Uint64()
+	if mod == "i128" {
+		hi >>= 1
+	}
+	n := new(big.Int).SetUint64(hi)
+	n.Lsh(n, 64).Or(n, new(big.Int).SetUint64(rng.Uint64()))
+	if mod == "i128" && rng.Intn(2) == 1 {
+		n.Neg(n)
+	}
+	return n
+}
+
+var enumCache sync.Map // reflect.Type to []reflect.Value
+
+// enumValues returns the valid values of a value enum, or nil when t is not one
+func enumValues(t reflect.Type) []reflect.Value {
+	if cached, ok := enumCache.Load(t); ok {
+		return cached.([]reflect.Value)
+	}
+	if !t.Implements(validatorType) {
+		return nil
+	}
+	var limit uint64
+	switch t.Kind() {
+	case reflect.Uint8, reflect.Int8:
+		limit = 1 << 8
+	case reflect.Uint16, reflect.Int16, reflect.Uint32, reflect.Int32, reflect.Uint64, reflect.Int64:
+		limit = 1 << 16
+	default:
+		return nil
+	}
+	values := []reflect.Value{}
+	for i := uint64(0); i < limit; i++ {
+		v := reflect.New(t).Elem()
+		if t.Kind() >= reflect.Uint8 {
+			v.SetUint(i)
+		} else {
+			v.SetInt(int64(i))
+		}
+		if v.Interface().(validator).Valid() {
+			values = append(values, v)
+		}
+	}
+	enumCache.Store(t, values)
+	return values
+}
+
+func (o Options) diff(path string, w, g reflect.Value) string {
+	t := w.Type()
+	switch t {
+	case timeType:
+		if wt, gt := w.Interface().(time.Time), g.Interface().(time.Time); !wt.Equal(gt) {
+			return fmt.Sprintf("%s = %v, want %v", path, gt, wt)
+		}
+		return ""
+	case bigIntType:
+		wb, gb := w.Interface().(*big.Int), g.Interface().(*big.Int)
+		if wb == nil {
+			wb = new(big.Int)
+		}
+		if gb == nil {
+			gb = new(big.Int)
+		}
+		if wb.Cmp(gb) != 0 {
+			return fmt.Sprintf("%s = %v, want %v", path, gb, wb)
+		}
+		return ""
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if w.IsNil() || g.IsNil() {
+			if w.IsNil() != g.IsNil() {
+				return fmt.Sprintf("%s = %s, want %s", path, nilness(g), nilness(w))
+			}
+			return ""
+		}
+		if t.Kind() == reflect.Interface && w.Elem().Type() != g.Elem().Type() {
+			return fmt.Sprintf("%s is a %s, want a %s", path, g.Elem().Type(), w.Elem().Type())
+		}
+		return o.diff(path, w.Elem(), g.Elem())
+	case reflect.Struct:
+		for _, f := range o.fields(t) {
+			if f.inline || f.skip {
+				continue
+			}
+			wf, ok := reach(w, f.index, false)
+			if !ok || !wf.CanInterface() {
+				continue
+			}
+			gf, ok := reach(g, f.index, false)
+			if !ok {
+				return fmt.Sprintf("%s.%s is behind a nil embedded pointer", path, f.name)
+			}
+			if d := o.diff(path+"."+f.name, wf, gf); d != "" {
+				return d
+			}
+		}
+		return ""
+	case reflect.Slice, reflect.Array:
+		if w.Len() != g.Len() {
+			return fmt.Sprintf("%s has %d elements, want %d", path, g.Len(), w.Len())
+		}
+		for i := 0; i < w.Len(); i++ {
+			if d := o.diff(fmt.Sprintf("%s[%d]", path, i), w.Index(i), g.Index(i)); d != "" {
+				return d
+			}
+		}
+		return ""
+	case reflect.Map:
+		if w.Len() != g.Len() {
+			return fmt.Sprintf("%s has %d entries, want %d", path, g.Len(), w.Len())
+		}
+		iter := w.MapRange()
+		for iter.Next() {
+			key := fmt.Sprintf("%s[%v]", path, iter.Key())
+			gv := g.MapIndex(iter.Key())
+			if !gv.IsValid() {
+				return key + " is missing"
+			}
+			if d := o.diff(key, iter.Value(), gv); d != "" {
+				return d
+			}
+		}
+		return ""
+	}
+	if !reflect.DeepEqual(w.Interface(), g.Interface()) {
+		return fmt.Sprintf("%s = %v, want %v", path, g, w)
+	}
+	return ""
+}
+
+func nilness(v reflect.Value) string {
+	if v.IsNil() {
+		return "nil"
+	}
+	return "non-nil"
+}
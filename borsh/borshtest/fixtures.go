@@ -0,0 +1,205 @@
+package borshtest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Fixture is a value encoded by Go, which the tests of the code generated in another language
+// decode and encode again
+type Fixture struct {
+	Type   string `json:"type"`   // name of the struct
+	Borsh  string `json:"borsh"`  // hex of MarshalBorsh
+	Encode string `json:"encode"` // hex of Encode
+}
+
+// Encoder is implemented by the structs borshgen generates
+type Encoder interface {
+	MarshalBorsh() ([]byte, error)
+	Encode() ([]byte, error)
+}
+
+// NewFixture encodes v, a value of the struct named typ
+func NewFixture(typ string, v Encoder) (Fixture, error) {
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		return Fixture{}, err
+	}
+	enc, err := v.Encode()
+	if err != nil {
+		return Fixture{}, err
+	}
+	return Fixture{Type: typ, Borsh: hex.EncodeToString(data), Encode: hex.EncodeToString(enc)}, nil
+}
+
+// WriteFixtures writes fixtures to a JSON file
+func WriteFixtures(path string, fixtures []Fixture) error {
+	data, err := json.MarshalIndent(fixtures, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// RunTypeScript runs a TypeScript file with node, and fails t with its output when it fails.
+// It uses the node of $BORSHGEN_NODE, or else the one on the PATH, and skips t unless it runs
+// TypeScript, which needs node 22.6 or later
+func RunTypeScript(t *testing.T, file string) {
+	t.Helper()
+	node := os.Getenv("BORSHGEN_NODE")
+	if node == "" {
+		var err error
+		if node, err = exec.LookPath("node"); err != nil {
+			t.Skip("node not found, set BORSHGEN_NODE to run the TypeScript tests")
+		}
+	}
+	out, err := exec.Command(node, "--version").Output()
+	if err != nil {
+		t.Skipf("%s --version failed: %v", node, err)
+	}
+	if version := strings.TrimSpace(string(out)); !stripsTypes(version) {
+		t.Skipf("node %s does not run TypeScript, it needs 22.6 or later", version)
+	}
+	path, err := filepath.Abs(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(node, "--experimental-strip-types", "--no-warnings", path)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("node %s failed: %v\n%s", file, err, bytes.TrimSpace(out))
+	}
+}
+
+// stripsTypes reports whether a node version, e.g. v22.6.0, runs TypeScript
+func stripsTypes(version string) bool {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	major, err1 := strconv.Atoi(parts[0])
+	minor, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return major > 22 || major == 22 && minor >= 6
+}
+
+// rustManifest is the Cargo.toml of the crate RunRust builds
+const rustManifest = `[package]
+name = "borshgen-check"
+version = "0.0.0"
+edition = "2021"
+
+[dependencies]
+borsh = { version = "1", features = ["derive"] }
+
+[workspace]
+`
+
+// rustMain checks the fixtures of its FIXTURES array: each is deserialized into the struct it
+// names and must serialize to the same bytes
+const rustMain = `use borsh::{BorshDeserialize, BorshSerialize};
+
+fn hex(data: &[u8]) -> String {
+    data.iter().map(|b| format!("{b:02x}")).collect()
+}
+
+fn unhex(s: &str) -> Vec<u8> {
+    (0..s.len()).step_by(2).map(|i| u8::from_str_radix(&s[i..i + 2], 16).unwrap()).collect()
+}
+
+fn check<T: BorshSerialize + BorshDeserialize>(typ: &str, want: &str) -> Result<(), String> {
+    let data = unhex(want);
+    let v = T::try_from_slice(&data).map_err(|e| format!("{typ}: deserializing {want} failed: {e}"))?;
+    let out = borsh::to_vec(&v).map_err(|e| format!("{typ}: serializing failed: {e}"))?;
+    if out != data {
+        return Err(format!("{typ}: serialized {}, want {want}", hex(&out)));
+    }
+    Ok(())
+}
+
+fn main() {
+    let mut failed = 0;
+    for (typ, want) in FIXTURES {
+        let result = match *typ {
+%s            _ => Err(format!("unknown type {typ}")),
+        };
+        if let Err(e) = result {
+            eprintln!("{e}");
+            failed += 1;
+        }
+    }
+    if failed > 0 {
+        std::process::exit(1);
+    }
+    println!("ok {} fixtures", FIXTURES.len());
+}
+`
+
+// RunRust checks fixtures against the Rust structs of module, a file written with -lang=rust next
+// to the borshgen.rs runtime: it builds a crate with cargo in which every fixture must deserialize
+// into the struct it names and serialize to the same MarshalBorsh bytes, and fails t with the
+// output otherwise. It uses the cargo of $BORSHGEN_CARGO, or else the one on the PATH, and skips t
+// when there is none or it cannot fetch the borsh crate
+func RunRust(t *testing.T, module string, fixtures []Fixture) {
+	t.Helper()
+	cargo := os.Getenv("BORSHGEN_CARGO")
+	if cargo == "" {
+		var err error
+		if cargo, err = exec.LookPath("cargo"); err != nil {
+			t.Skip("cargo not found, set BORSHGEN_CARGO to run the Rust tests")
+		}
+	}
+	path, err := filepath.Abs(module)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runtime := filepath.Join(filepath.Dir(path), "borshgen.rs")
+
+	var src strings.Builder
+	fmt.Fprintf(&src, "#[path = %q]\nmod borshgen;\n#[path = %q]\nmod generated;\n\n", runtime, path)
+	src.WriteString("const FIXTURES: &[(&str, &str)] = &[\n")
+	var arms strings.Builder
+	seen := make(map[string]bool)
+	for _, f := range fixtures {
+		fmt.Fprintf(&src, "    (%q, %q),\n", f.Type, f.Borsh)
+		if !seen[f.Type] {
+			seen[f.Type] = true
+			fmt.Fprintf(&arms, "            %q => check::<generated::%s>(typ, want),\n", f.Type, f.Type)
+		}
+	}
+	src.WriteString("];\n\n")
+	fmt.Fprintf(&src, rustMain, arms.String())
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "src"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "Cargo.toml"), []byte(rustManifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "src", "main.rs"), []byte(src.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	run := func(args ...string) ([]byte, error) {
+		cmd := exec.Command(cargo, args...)
+		cmd.Dir = dir
+		// Builds share a target directory so that borsh is compiled once
+		cmd.Env = append(os.Environ(), "CARGO_TARGET_DIR="+filepath.Join(os.TempDir(), "borshgen-rust-target"))
+		return cmd.CombinedOutput()
+	}
+	if out, err := run("fetch"); err != nil {
+		t.Skipf("cargo fetch failed, the Rust tests need the borsh crate: %v\n%s", err, bytes.TrimSpace(out))
+	}
+	if out, err := run("run", "-q"); err != nil {
+		t.Fatalf("cargo run for %s failed: %v\n%s", module, err, bytes.TrimSpace(out))
+	}
+}
@@ -0,0 +1,111 @@
+package borshtest
+
+import (
+	"fmt"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+// Walk reads data as an encoding of the declaration of schema, following its definitions.
+// It returns an error unless every count is in its range, every enum tag selects a variant
+// and the value ends exactly at the end of data, so tests can check that a schema describes
+// what MarshalBorsh writes
+func Walk(schema *borsh.Schema, data []byte) error {
+	w := walker{schema: schema, data: data}
+	if err := w.walk(schema.Declaration, schema.Declaration); err != nil {
+		return err
+	}
+	if w.offset != len(data) {
+		return fmt.Errorf("%s ends at offset %d, before the %d bytes of data", schema.Declaration, w.offset, len(data))
+	}
+	return nil
+}
+
+type walker struct {
+	schema *borsh.Schema
+	data   []byte
+	offset int
+}
+
+// take consumes n bytes
+func (w *walker) take(path string, n uint64) ([]byte, error) {
+	if n > uint64(len(w.data)-w.offset) {
+		return nil, fmt.Errorf("%s: %d bytes at offset %d, only %d left", path, n, w.offset, len(w.data)-w.offset)
+	}
+	b := w.data[w.offset : w.offset+int(n)]
+	w.offset += int(n)
+	return b, nil
+}
+
+// uint consumes a little-endian unsigned integer of width bytes
+func (w *walker) uint(path string, width uint8) (uint64, error) {
+	b, err := w.take(path, uint64(width))
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	return v, nil
+}
+
+func (w *walker) walk(path, decl string) error {
+	def, ok := w.schema.Definitions[decl]
+	if !ok {
+		return fmt.Errorf("%s: %s is not defined", path, decl)
+	}
+	switch {
+	case def.Primitive != nil:
+		_, err := w.take(path, uint64(*def.Primitive))
+		return err
+	case def.Sequence != nil:
+		s := def.Sequence
+		count := s.LengthRange.Start
+		if s.LengthWidth > 0 {
+			var err error
+			if count, err = w.uint(path, s.LengthWidth); err != nil {
+				return err
+			}
+		}
+		if count < s.LengthRange.Start || count > s.LengthRange.End {
+			return fmt.Errorf("%s: %s of %d elements, want %d to %d", path, decl, count, s.LengthRange.Start, s.LengthRange.End)
+		}
+		if elem, ok := w.schema.Definitions[s.Elements]; ok && elem.Primitive != nil {
+			_, err := w.take(path, count*uint64(*elem.Primitive))
+			return err
+		}
+		for i := uint64(0); i < count; i++ {
+			if err := w.walk(fmt.Sprintf("%s[%d]", path, i), s.Elements); err != nil {
+				return err
+			}
+		}
+		return nil
+	case def.Tuple != nil:
+		for i, elem := range def.Tuple.Elements {
+			if err := w.walk(fmt.Sprintf("%s.%d", path, i), elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	case def.Enum != nil:
+		tag, err := w.uint(path, def.Enum.TagWidth)
+		if err != nil {
+			return err
+		}
+		for _, v := range def.Enum.Variants {
+			if uint64(v.Discriminant) == tag {
+				return w.walk(path+"."+v.Name, v.Declaration)
+			}
+		}
+		return fmt.Errorf("%s: tag %d selects no variant of %s", path, tag, decl)
+	case def.Struct != nil:
+		for _, f := range def.Struct.Fields {
+			if err := w.walk(path+"."+f.Name, f.Declaration); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("%s: empty definition of %s", path, decl)
+}
@@ -0,0 +1,12 @@
+// Package borsh is the runtime of the code generated by borshgen.
+//
+// Generated MarshalBorsh, UnmarshalBorsh and BinarySize methods write through a Writer and read
+// through a Reader, which tracks the offset, strict mode and allocation budget of a decode.
+// Failures are DecodeError and EncodeError values classified by an ErrorKind, with the path of
+// the field they occurred at.
+//
+// The package also holds what generated code shares across packages: the Marshaler interfaces
+// that nested and custom-encoded types implement, the default encoders for times, raw bytes and
+// JSON, the Registry of type IDs used by interface fields, the Schema types that describe a
+// layout, and Uint128 and Int128 for Borsh integers that have no native Go equivalent.
+package borsh
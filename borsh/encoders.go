@@ -0,0 +1,219 @@
+package borsh
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Default encoders for the types generated code encodes as length-prefixed bytes, and for times,
+// which it writes in place through Write and Read.
+// Generated packages refer to them through package-level variables such as _DefaultByteArrayEncoder
+
+// _zeroUnixNano stands for the zero time in nanosecond precision, which is outside the range of an i64
+var _zeroUnixNano int64 = math.MinInt64
+
+type DefaultJsonRawMessageEncoder struct{}
+
+func (c DefaultJsonRawMessageEncoder) MarshalBorsh(field any, parentStruct any) ([]byte, error) {
+	if field == nil {
+		return []byte{}, nil
+	}
+	if f, ok := field.(json.RawMessage); !ok {
+		return []byte{}, fmt.Errorf("expected json.RawMessage, got %T", field)
+	} else {
+		return []byte(f), nil
+	}
+}
+func (c DefaultJsonRawMessageEncoder) UnmarshalBorsh(data []byte) (any, error) {
+	m := json.RawMessage(data)
+	return m, nil
+}
+
+func (c DefaultJsonRawMessageEncoder) BinarySize(field any, parentStruct any) (int, error) {
+	if field == nil {
+		return 0, nil
+	}
+	return len(field.(json.RawMessage)), nil
+
+}
+func (c DefaultJsonRawMessageEncoder) Encode(field any, parent any) ([]byte, error) {
+	if field == nil {
+		return []byte{}, nil
+	}
+	if f, ok := field.(json.RawMessage); !ok {
+		return nil, fmt.Errorf("expected json.RawMessage, got %T", field)
+	} else {
+		return f, nil
+	}
+}
+
+type DefaultByteArrayEncoder struct{}
+
+func (c DefaultByteArrayEncoder) MarshalBorsh(field any, parentStruct any) ([]byte, error) {
+	if field == nil {
+		return []byte{}, nil
+	}
+	if f, ok := field.([]byte); !ok {
+		return []byte{}, fmt.Errorf("expected []byte, got %T", field)
+	} else {
+		return f, nil
+	}
+}
+func (c DefaultByteArrayEncoder) UnmarshalBorsh(data []byte) (any, error) {
+	m := data
+	return m, nil
+}
+
+func (c DefaultByteArrayEncoder) BinarySize(field any, parentStruct any) (int, error) {
+	if field == nil {
+		return 0, nil
+	}
+	return len(field.([]byte)), nil
+}
+func (c DefaultByteArrayEncoder) Encode(field any, parentStruct any) ([]byte, error) {
+	if field == nil {
+		return []byte{}, nil
+	}
+	if f, ok := field.([]byte); !ok {
+		return nil, fmt.Errorf("expected []byte, got %T", field)
+	} else {
+		return f, nil
+	}
+}
+
+// DefaultTimeEncoder writes a time.Time as an i64 count of Unit since the Unix epoch,
+// or as i64 seconds followed by u32 nanoseconds when Unit is zero.
+// Decoded times are in UTC and the zero time decodes to the zero time
+type DefaultTimeEncoder struct {
+	Unit time.Duration
+}
+
+func (c DefaultTimeEncoder) MarshalBorsh(field any, parentStruct any) ([]byte, error) {
+	t, ok := field.(time.Time)
+	if !ok {
+		return nil, fmt.Errorf("expected time.Time, got %T", field)
+	}
+	w := NewBufferWriter(make([]byte, 0, 12))
+	if err := c.Write(w, t); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+func (c DefaultTimeEncoder) UnmarshalBorsh(data []byte) (any, error) {
+	size, _ := c.BinarySize(time.Time{}, nil)
+	if len(data) != size {
+		return nil, fmt.Errorf("expected %d bytes for time.Time, got %d", size, len(data))
+	}
+	return c.Read(NewBytesReader(data))
+}
+
+// Write writes t in place, as generated code does for time.Time fields
+func (c DefaultTimeEncoder) Write(w *Writer, t time.Time) error {
+	switch c.Unit {
+	case 0:
+		w.WriteUint64(uint64(t.Unix()))
+		w.WriteUint32(uint32(t.Nanosecond()))
+	case time.Second:
+		w.WriteUint64(uint64(t.Unix()))
+	case time.Millisecond:
+		w.WriteUint64(uint64(t.UnixMilli()))
+	case time.Nanosecond:
+		if t.IsZero() {
+			w.WriteUint64(uint64(_zeroUnixNano))
+			return nil
+		}
+		// UnixNano is only defined between the years 1678 and 2262
+		if t.Before(time.Unix(0, math.MinInt64+1)) || t.After(time.Unix(0, math.MaxInt64)) {
+			return fmt.Errorf("time %s is out of range for unix nanoseconds", t.UTC())
+		}
+		w.WriteUint64(uint64(t.UnixNano()))
+	default:
+		return fmt.Errorf("unsupported time unit %s", c.Unit)
+	}
+	return nil
+}
+
+// Read reads a time written by Write
+func (c DefaultTimeEncoder) Read(r *Reader) (time.Time, error) {
+	u, err := r.ReadUint64()
+	if err != nil {
+		return time.Time{}, err
+	}
+	v := int64(u)
+	var t time.Time
+	switch c.Unit {
+	case 0:
+		nsec, err := r.ReadUint32()
+		if err != nil {
+			return time.Time{}, err
+		}
+		if nsec >= uint32(time.Second) {
+			return time.Time{}, r.Errorf(InvalidValue, "invalid nanoseconds %d", nsec)
+		}
+		t = time.Unix(v, int64(nsec))
+	case time.Second:
+		t = time.Unix(v, 0)
+	case time.Millisecond:
+		t = time.UnixMilli(v)
+	case time.Nanosecond:
+		if v == _zeroUnixNano {
+			return time.Time{}, nil
+		}
+		t = time.Unix(0, v)
+	default:
+		return time.Time{}, fmt.Errorf("unsupported time unit %s", c.Unit)
+	}
+	return t.UTC(), nil
+}
+
+func (c DefaultTimeEncoder) BinarySize(field any, parentStruct any) (int, error) {
+	if c.Unit == 0 {
+		return 12, nil
+	}
+	return 8, nil
+}
+
+func (c DefaultTimeEncoder) Encode(field any, parentStruct any) ([]byte, error) {
+	return c.MarshalBorsh(field, parentStruct)
+}
+
+// DefaultUUIDEncoder writes a UUID as its 16 bytes. It accepts any type with a 16 byte
+// MarshalBinary, such as github.com/google/uuid.UUID, and decodes to a [16]byte
+type DefaultUUIDEncoder struct{}
+
+func (c DefaultUUIDEncoder) MarshalBorsh(field any, parentStruct any) ([]byte, error) {
+	switch f := field.(type) {
+	case [16]byte:
+		return f[:], nil
+	case interface{ MarshalBinary() ([]byte, error) }:
+		data, err := f.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != 16 {
+			return nil, fmt.Errorf("expected 16 bytes for %T, got %d", field, len(data))
+		}
+		return data, nil
+	}
+	return nil, fmt.Errorf("expected uuid.UUID, got %T", field)
+}
+
+func (c DefaultUUIDEncoder) UnmarshalBorsh(data []byte) (any, error) {
+	var u [16]byte
+	if len(data) != len(u) {
+		return nil, fmt.Errorf("expected 16 bytes for uuid.UUID, got %d", len(data))
+	}
+	copy(u[:], data)
+	return u, nil
+}
+
+func (c DefaultUUIDEncoder) BinarySize(field any, parentStruct any) (int, error) {
+	return 16, nil
+}
+
+func (c DefaultUUIDEncoder) Encode(field any, parentStruct any) ([]byte, error) {
+	return c.MarshalBorsh(field, parentStruct)
+}
@@ -0,0 +1,233 @@
+package borsh
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrorKind classifies a DecodeError or EncodeError. It is an error itself,
+// so errors.Is(err, borsh.ShortBuffer) tells whether err is of that kind
+type ErrorKind uint8
+
+const (
+	// ShortBuffer: the input ends before the value does
+	ShortBuffer ErrorKind = iota + 1
+	// LengthLimit: a length exceeds MaxStringLen, MaxSliceLen or another limit of the generated package
+	LengthLimit
+	// InvalidBool: a bool is encoded as a byte other than 0 or 1
+	InvalidBool
+	// UnknownVariant: an enum variant index or an option flag that is not declared
+	UnknownVariant
+	// TrailingBytes: input is left over after the value
+	TrailingBytes
+	// MaxDepth: structs are nested deeper than the MaxDepth of the generated package
+	MaxDepth
+	// NotCanonical: map keys or set elements that are unsorted or repeated
+	NotCanonical
+	// InvalidValue: a value that is not valid for its type, such as an undeclared enum constant
+	// or an integer out of range, and errors of custom encoders
+	InvalidValue
+	// UnknownDiscriminator: the input does not start with the discriminator of the decoded type,
+	// or of any type of a Registry
+	UnknownDiscriminator
+	// UnknownType: a type ID that no type of the Registry has
+	UnknownType
+)
+
+var kindNames = [...]string{
+	ShortBuffer:          "short buffer",
+	LengthLimit:          "length limit exceeded",
+	InvalidBool:          "invalid bool",
+	UnknownVariant:       "unknown variant",
+	TrailingBytes:        "trailing bytes",
+	MaxDepth:             "maximum depth exceeded",
+	NotCanonical:         "not canonical",
+	InvalidValue:         "invalid value",
+	UnknownDiscriminator: "unknown discriminator",
+	UnknownType:          "unknown type",
+}
+
+func (k ErrorKind) String() string {
+	if int(k) < len(kindNames) && kindNames[k] != "" {
+		return kindNames[k]
+	}
+	return "ErrorKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+func (k ErrorKind) Error() string {
+	return k.String()
+}
+
+// ErrUnsupportedType is returned for nested values that are neither generated nor hand-written encoders
+var ErrUnsupportedType = errors.New("unsupported type")
+
+// DecodeError is returned by generated unmarshal code
+type DecodeError struct {
+	Kind ErrorKind
+	// Offset is the position in the input where the error was detected:
+	// the start of a value that is cut short, or the end of an invalid one
+	Offset int64
+	// FieldPath is the field being decoded, e.g. Event.Paths[2].ID
+	FieldPath string
+	Err       error
+}
+
+func (e *DecodeError) Error() string {
+	msg := "borsh: decoding "
+	if e.FieldPath != "" {
+		msg += e.FieldPath + " "
+	}
+	msg += "at offset " + strconv.FormatInt(e.Offset, 10) + ": " + e.Kind.String()
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the Kind of e
+func (e *DecodeError) Is(target error) bool {
+	k, ok := target.(ErrorKind)
+	return ok && k == e.Kind
+}
+
+// EncodeError is returned by generated marshal and encode code
+type EncodeError struct {
+	Kind ErrorKind
+	// FieldPath is the field being encoded, e.g. Event.Paths[2].ID
+	FieldPath string
+	Err       error
+}
+
+func (e *EncodeError) Error() string {
+	msg := "borsh: encoding "
+	if e.FieldPath != "" {
+		msg += e.FieldPath + ": "
+	}
+	msg += e.Kind.String()
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *EncodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the Kind of e
+func (e *EncodeError) Is(target error) bool {
+	k, ok := target.(ErrorKind)
+	return ok && k == e.Kind
+}
+
+// kindError is an error of a known kind that does not have a position yet
+type kindError struct {
+	kind ErrorKind
+	err  error
+}
+
+func (e *kindError) Error() string {
+	return e.err.Error()
+}
+
+func (e *kindError) Unwrap() error {
+	return e.err
+}
+
+func (e *kindError) Is(target error) bool {
+	return target == e.kind
+}
+
+// Errorf returns an error of kind, which becomes the Kind of the DecodeError or EncodeError it ends up in
+func Errorf(kind ErrorKind, format string, args ...any) error {
+	return &kindError{kind: kind, err: fmt.Errorf(format, args...)}
+}
+
+// KindOf returns the kind of err, or InvalidValue when it has none
+func KindOf(err error) ErrorKind {
+	var d *DecodeError
+	if errors.As(err, &d) {
+		return d.Kind
+	}
+	var e *EncodeError
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	var k *kindError
+	if errors.As(err, &k) {
+		return k.kind
+	}
+	return InvalidValue
+}
+
+// Errorf returns a DecodeError of kind at the current position
+func (r *Reader) Errorf(kind ErrorKind, format string, args ...any) error {
+	return &DecodeError{Kind: kind, Offset: r.Pos(), Err: fmt.Errorf(format, args...)}
+}
+
+// FieldError attributes err to the field at path, relative to the struct being decoded.
+// A DecodeError from a nested struct gets path prepended to its FieldPath,
+// any other error becomes a DecodeError at the current position
+func (r *Reader) FieldError(path string, err error) error {
+	if d, ok := err.(*DecodeError); ok {
+		d.FieldPath = joinPath(path, d.FieldPath)
+		return d
+	}
+	return &DecodeError{Kind: KindOf(err), Offset: r.Pos(), FieldPath: path, Err: err}
+}
+
+// EncodeFieldError attributes err to the field at path, relative to the struct being encoded.
+// An EncodeError from a nested struct gets path prepended to its FieldPath
+func EncodeFieldError(path string, err error) error {
+	if e, ok := err.(*EncodeError); ok {
+		e.FieldPath = joinPath(path, e.FieldPath)
+		return e
+	}
+	return &EncodeError{Kind: KindOf(err), FieldPath: path, Err: err}
+}
+
+// WithType prepends the name of the decoded or encoded type to the FieldPath of err.
+// Generated entry points such as UnmarshalBorsh call it, so paths start at the outermost type
+func WithType(name string, err error) error {
+	switch e := err.(type) {
+	case *DecodeError:
+		e.FieldPath = joinPath(name, e.FieldPath)
+	case *EncodeError:
+		e.FieldPath = joinPath(name, e.FieldPath)
+	}
+	return err
+}
+
+// Rebase adds base to the Offset of a DecodeError from decoding a part of the input on its own,
+// so it is the offset in the whole input
+func Rebase(err error, base int64) error {
+	if d, ok := err.(*DecodeError); ok {
+		d.Offset += base
+	}
+	return err
+}
+
+// Index returns the path of element i of the slice or array at path
+func Index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// Key returns the path of the value for key in the map at path
+func Key(path string, key any) string {
+	return fmt.Sprintf("%s[%v]", path, key)
+}
+
+func joinPath(prefix, path string) string {
+	switch {
+	case path == "":
+		return prefix
+	case path[0] == '[':
+		return prefix + path
+	}
+	return prefix + "." + path
+}
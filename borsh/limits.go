@@ -0,0 +1,49 @@
+package borsh
+
+import "unsafe"
+
+// SetAllocLimit caps the memory decoding from r may allocate at limit bytes. Generated code charges
+// strings, byte slices, slices and maps against it as soon as their length is read, before anything
+// is allocated. 0 removes the cap
+func (r *Reader) SetAllocLimit(limit int64) {
+	r.limit = limit
+}
+
+// Allocated returns the bytes charged against the allocation limit of r so far
+func (r *Reader) Allocated() int64 {
+	return r.alloc
+}
+
+// Alloc charges n bytes against the allocation limit of r, and fails with a LengthLimit error
+// once the bytes charged exceed it
+func (r *Reader) Alloc(n int64) error {
+	if n < 0 {
+		return r.Errorf(LengthLimit, "allocation of %d bytes", n)
+	}
+	r.alloc += n
+	if r.limit > 0 && (r.alloc > r.limit || r.alloc < 0) {
+		return r.Errorf(LengthLimit, "decoding needs more than the allocation limit of %d bytes", r.limit)
+	}
+	return nil
+}
+
+// MakeSlice charges n elements against the allocation limit of r and returns an empty slice for them.
+// Its capacity is bounded by CapHint, so the slice grows as the elements arrive
+func MakeSlice[S ~[]E, E any](r *Reader, n int) (S, error) {
+	var e E
+	if err := r.Alloc(int64(n) * int64(unsafe.Sizeof(e))); err != nil {
+		return nil, err
+	}
+	return make(S, 0, r.CapHint(n)), nil
+}
+
+// MakeMap charges n entries against the allocation limit of r and returns an empty map for them,
+// sized by CapHint like MakeSlice
+func MakeMap[M ~map[K]V, K comparable, V any](r *Reader, n int) (M, error) {
+	var k K
+	var v V
+	if err := r.Alloc(int64(n) * int64(unsafe.Sizeof(k)+unsafe.Sizeof(v))); err != nil {
+		return nil, err
+	}
+	return make(M, r.CapHint(n)), nil
+}
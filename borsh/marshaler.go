@@ -0,0 +1,67 @@
+package borsh
+
+// Marshaler holds the methods generated structs have on their value receiver,
+// so both a generated struct and a pointer to it satisfy it.
+// Use it to constrain the type parameters of generic structs:
+//
+//	type Envelope[T borsh.Marshaler] struct {
+//		Nonce   uint64 `msg:"nonce"`
+//		Payload T      `msg:"payload"`
+//	}
+type Marshaler interface {
+	MarshalBorsh() ([]byte, error)
+	BinarySize() (int, error)
+	Encode() ([]byte, error)
+}
+
+// BinaryMarshaler is implemented by generated structs and by hand-written types encoded as nested values
+type BinaryMarshaler interface {
+	MarshalBorsh() ([]byte, error)
+	BinarySize() (int, error)
+}
+
+type BinaryUnmarshaler interface {
+	UnmarshalBorsh(data []byte) error
+}
+
+type BinaryEncoder interface {
+	Encode() ([]byte, error)
+}
+
+type BorshEncoder interface {
+	BinaryEncoder
+	BinaryMarshaler
+	BinaryUnmarshaler
+}
+
+// WriterTo is implemented by generated structs, which write their encoding to a Writer
+// without buffering it, whichever package they are nested in
+type WriterTo interface {
+	WriteBorsh(w *Writer) error
+}
+
+// EncoderTo is implemented by generated structs, which write their Encode form to a Writer
+type EncoderTo interface {
+	EncodeBorsh(w *Writer) error
+}
+
+// ReaderFrom is implemented by generated structs, which decode from a Reader at a nesting depth.
+// Hand-written types implement it to take part in the depth count and to be read from streams
+type ReaderFrom interface {
+	ReadBorsh(r *Reader, depth int) error
+}
+
+// Comparer is implemented by generated structs, which order by their encoded fields in wire order,
+// as a Rust Ord derive does. other has the type of the receiver
+type Comparer interface {
+	CompareBorsh(other any) int
+}
+
+// CustomElementEncoder encodes the fields tagged with its name. field is the value of the field
+// and parent the struct holding it
+type CustomElementEncoder interface {
+	MarshalBorsh(field any, parent any) ([]byte, error)
+	UnmarshalBorsh(data []byte) (any, error)
+	BinarySize(field any, parent any) (int, error)
+	Encode(field any, parent any) ([]byte, error)
+}
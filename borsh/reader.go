@@ -0,0 +1,272 @@
+package borsh
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+)
+
+// StreamCapHint is the most elements allocated up front for a collection read from a stream
+const StreamCapHint = 1024
+
+// Reader reads an encoding from a buffer. A Reader created by NewReader refills the buffer
+// from its io.Reader, reading only the bytes that are asked for, so it never reads past the decoded value.
+// The buffer then holds the bytes of the value being read rather than the whole input
+type Reader struct {
+	buf    []byte
+	off    int       // read position in buf
+	src    io.Reader // nil when buf holds the whole input
+	base   int64     // position of buf[0] in the input
+	pins   int       // number of marks whose bytes must stay in buf
+	pinned int64     // position of the first mark
+	read   int64     // bytes read from src
+	strict bool      // reject input that is not in canonical form
+	limit  int64     // most bytes Alloc accepts, 0 for no limit
+	alloc  int64     // bytes charged by Alloc
+}
+
+// NewReader returns a Reader that reads from src
+func NewReader(src io.Reader) *Reader {
+	return &Reader{src: src}
+}
+
+// NewBytesReader returns a Reader over data
+func NewBytesReader(data []byte) *Reader {
+	return &Reader{buf: data}
+}
+
+// SetStrict makes r reject input that is not in canonical form: bools and option flags
+// other than 0 or 1, NaNs other than the canonical one, unsorted map keys and, in Decode, trailing bytes
+func (r *Reader) SetStrict(strict bool) {
+	r.strict = strict
+}
+
+// Strict reports whether r rejects input that is not in canonical form
+func (r *Reader) Strict() bool {
+	return r.strict
+}
+
+// Streaming reports whether the Reader reads from an io.Reader
+func (r *Reader) Streaming() bool {
+	return r.src != nil
+}
+
+// BytesRead returns the number of bytes read from the io.Reader
+func (r *Reader) BytesRead() int64 {
+	return r.read
+}
+
+// Pos returns the position of the next byte in the input
+func (r *Reader) Pos() int64 {
+	return r.base + int64(r.off)
+}
+
+// Remaining returns the unread bytes of a Reader over a byte slice
+func (r *Reader) Remaining() []byte {
+	return r.buf[r.off:]
+}
+
+// Next returns the next n bytes and advances past them.
+// The bytes are only valid until the next read from a streaming Reader
+func (r *Reader) Next(n int) ([]byte, error) {
+	if n < 0 || n > len(r.buf)-r.off {
+		if err := r.fill(n); err != nil {
+			return nil, err
+		}
+	}
+	b := r.buf[r.off : r.off+n : r.off+n]
+	r.off += n
+	return b, nil
+}
+
+// fill makes n bytes available from off, reading them from src.
+// buf grows with the bytes read, so a large length prefix does not allocate before its data arrives
+func (r *Reader) fill(n int) error {
+	if r.src == nil || n < 0 {
+		return r.Errorf(ShortBuffer, "buffer too short for %d bytes", n)
+	}
+	// Drop the bytes already read unless a mark still needs them
+	drop := r.off
+	if r.pins > 0 {
+		drop = int(r.pinned - r.base)
+	}
+	if drop > 0 {
+		r.buf = r.buf[:copy(r.buf, r.buf[drop:])]
+		r.off -= drop
+		r.base += int64(drop)
+	}
+	need := r.off + n
+	for len(r.buf) < need {
+		if len(r.buf) == cap(r.buf) {
+			grown := make([]byte, len(r.buf), min(need, 2*cap(r.buf)+512))
+			copy(grown, r.buf)
+			r.buf = grown
+		}
+		m, err := io.ReadFull(r.src, r.buf[len(r.buf):min(need, cap(r.buf))])
+		r.buf = r.buf[:len(r.buf)+m]
+		r.read += int64(m)
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return &DecodeError{Kind: ShortBuffer, Offset: r.Pos(), Err: fmt.Errorf("failed to read %d bytes: %w", n, err)}
+		}
+	}
+	return nil
+}
+
+// Mark keeps the bytes read from here on in the buffer until Release, and returns the position
+func (r *Reader) Mark() int64 {
+	if r.pins == 0 {
+		r.pinned = r.Pos()
+	}
+	r.pins++
+	return r.Pos()
+}
+
+// Since returns the bytes read from the position returned by Mark
+func (r *Reader) Since(mark int64) []byte {
+	return r.buf[int(mark-r.base):r.off]
+}
+
+// Release ends a Mark
+func (r *Reader) Release() {
+	r.pins--
+}
+
+// Bytes returns the next n bytes. A streaming Reader copies them, since its buffer is reused
+func (r *Reader) Bytes(n int) ([]byte, error) {
+	b, err := r.Next(n)
+	if err != nil || r.src == nil {
+		return b, err
+	}
+	return append(make([]byte, 0, n), b...), nil
+}
+
+func (r *Reader) ReadByte() (byte, error) {
+	b, err := r.Next(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// ReadBool reads a single byte as a bool. Any non-zero byte is true unless r is strict
+func (r *Reader) ReadBool() (bool, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return false, err
+	}
+	if b > 1 && r.strict {
+		return false, &DecodeError{Kind: InvalidBool, Offset: r.Pos() - 1, Err: fmt.Errorf("bool byte %d", b)}
+	}
+	return b != 0, nil
+}
+
+// ReadOption reads the flag written before an option and reports whether a value follows.
+// Any non-zero flag is a value unless r is strict
+func (r *Reader) ReadOption() (bool, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return false, err
+	}
+	if b > 1 && r.strict {
+		return false, &DecodeError{Kind: UnknownVariant, Offset: r.Pos() - 1, Err: fmt.Errorf("option flag %d", b)}
+	}
+	return b != 0, nil
+}
+
+// ReadDiscriminator reads the discriminator written before a type and checks that it is want
+func (r *Reader) ReadDiscriminator(want []byte) error {
+	start := r.Pos()
+	got, err := r.Next(len(want))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(got, want) {
+		return &DecodeError{Kind: UnknownDiscriminator, Offset: start, Err: fmt.Errorf("discriminator %x, want %x", got, want)}
+	}
+	return nil
+}
+
+func (r *Reader) ReadUint16() (uint16, error) {
+	b, err := r.Next(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (r *Reader) ReadUint32() (uint32, error) {
+	b, err := r.Next(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (r *Reader) ReadUint64() (uint64, error) {
+	b, err := r.Next(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+// ReadFloat32 reads a little-endian f32. A strict Reader only accepts the NaN written by Writer.WriteFloat32
+func (r *Reader) ReadFloat32() (float32, error) {
+	b, err := r.ReadUint32()
+	if err != nil {
+		return 0, err
+	}
+	v := math.Float32frombits(b)
+	if r.strict && v != v && b != canonicalNaN32 {
+		return 0, &DecodeError{Kind: InvalidValue, Offset: r.Pos() - 4, Err: fmt.Errorf("non-canonical NaN %#08x", b)}
+	}
+	return v, nil
+}
+
+// ReadFloat64 reads a little-endian f64. A strict Reader only accepts the NaN written by Writer.WriteFloat64
+func (r *Reader) ReadFloat64() (float64, error) {
+	b, err := r.ReadUint64()
+	if err != nil {
+		return 0, err
+	}
+	v := math.Float64frombits(b)
+	if r.strict && v != v && b != canonicalNaN64 {
+		return 0, &DecodeError{Kind: InvalidValue, Offset: r.Pos() - 8, Err: fmt.Errorf("non-canonical NaN %#016x", b)}
+	}
+	return v, nil
+}
+
+// ReadU128 reads a little-endian u128
+func (r *Reader) ReadU128() (*big.Int, error) {
+	b, err := r.Next(16)
+	if err != nil {
+		return nil, err
+	}
+	return getBigLE(b), nil
+}
+
+// ReadI128 reads a little-endian two's complement i128
+func (r *Reader) ReadI128() (*big.Int, error) {
+	b, err := r.Next(16)
+	if err != nil {
+		return nil, err
+	}
+	var i Int128
+	copy(i[:], b)
+	return i.BigInt(), nil
+}
+
+// CapHint bounds the capacity allocated for length elements before any of them is read:
+// by the bytes left in a byte slice, or by StreamCapHint for a stream
+func (r *Reader) CapHint(length int) int {
+	if r.src == nil {
+		return max(0, min(length, len(r.buf)-r.off))
+	}
+	return max(0, min(length, StreamCapHint))
+}
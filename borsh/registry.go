@@ -0,0 +1,179 @@
+package borsh
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// TypeIDSize is the width of the type ID MarshalAny writes before a value
+const TypeIDSize = 8
+
+// TypeIdentifier is implemented by generated structs. The type ID is the xxhash of the package path
+// and the name of the struct unless a //borshgen:typeid directive gives another one
+type TypeIdentifier interface {
+	BorshTypeID() uint64
+}
+
+// Registry maps the discriminators and the type IDs of generated structs to the types, so data
+// such as the raw bytes of a Solana account, or a value of an interface field, decodes into the
+// struct it holds. Every generated package has one named Registry, which all its structs add
+// themselves to
+type Registry struct {
+	mu             sync.RWMutex
+	discriminators map[string]func() BorshEncoder
+	lengths        []int // lengths of the registered discriminators, ascending
+	types          map[uint64]func() BorshEncoder
+	included       []*Registry
+}
+
+// NewRegistry returns an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		discriminators: make(map[string]func() BorshEncoder),
+		types:          make(map[uint64]func() BorshEncoder),
+	}
+}
+
+// RegisterDiscriminator adds the type new returns a pointer to, whose encoding starts with discriminator.
+// It panics when discriminator is empty, or when one discriminator is a prefix of another, since
+// data would then not tell the types apart
+func (r *Registry) RegisterDiscriminator(discriminator []byte, new func() BorshEncoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(discriminator) == 0 {
+		panic("borsh: RegisterDiscriminator with an empty discriminator")
+	}
+	for d, other := range r.discriminators {
+		if bytes.HasPrefix([]byte(d), discriminator) || bytes.HasPrefix(discriminator, []byte(d)) {
+			panic(fmt.Sprintf("borsh: discriminator %x of %T conflicts with %x of %T", discriminator, new(), d, other()))
+		}
+	}
+	r.discriminators[string(discriminator)] = new
+	if i, found := slices.BinarySearch(r.lengths, len(discriminator)); !found {
+		r.lengths = slices.Insert(r.lengths, i, len(discriminator))
+	}
+}
+
+// Lookup returns a new value of the type whose discriminator data starts with
+func (r *Registry) Lookup(data []byte) (BorshEncoder, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, n := range r.lengths {
+		if n > len(data) {
+			break
+		}
+		if new, ok := r.discriminators[string(data[:n])]; ok {
+			return new(), true
+		}
+	}
+	return nil, false
+}
+
+// Decode unmarshals data, discriminator included, into a new value of the type whose
+// discriminator it starts with, and returns a pointer to it
+func (r *Registry) Decode(data []byte) (BorshEncoder, error) {
+	v, ok := r.Lookup(data)
+	if !ok {
+		return nil, &DecodeError{Kind: UnknownDiscriminator, Err: fmt.Errorf("no type is registered for %x", data[:min(len(data), 8)])}
+	}
+	if err := v.UnmarshalBorsh(data); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Register adds the type new returns a pointer to under its type ID.
+// It panics when id is 0 or already taken by another type
+func (r *Registry) Register(id uint64, new func() BorshEncoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if id == 0 {
+		panic(fmt.Sprintf("borsh: Register of %T with type ID 0", new()))
+	}
+	if other, ok := r.types[id]; ok {
+		panic(fmt.Sprintf("borsh: type ID %#x of %T is also the type ID of %T", id, new(), other()))
+	}
+	r.types[id] = new
+}
+
+// Include makes the types of other registries, such as those of other generated packages,
+// decode through r. Types of r itself come first
+func (r *Registry) Include(others ...*Registry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.included = append(r.included, others...)
+}
+
+// New returns a new value of the type registered under id, in r or the registries it includes
+func (r *Registry) New(id uint64) (BorshEncoder, bool) {
+	return r.lookupType(id, make(map[*Registry]bool))
+}
+
+func (r *Registry) lookupType(id uint64, seen map[*Registry]bool) (BorshEncoder, bool) {
+	seen[r] = true
+	r.mu.RLock()
+	new, ok := r.types[id]
+	included := r.included
+	r.mu.RUnlock()
+	if ok {
+		return new(), true
+	}
+	for _, other := range included {
+		if seen[other] {
+			continue
+		}
+		if v, ok := other.lookupType(id, seen); ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// TypeIDOf returns the type ID of v, which must be a generated struct or a pointer to one
+func TypeIDOf(v any) (uint64, error) {
+	if v == nil {
+		return 0, Errorf(InvalidValue, "nil value has no type ID")
+	}
+	ti, ok := v.(TypeIdentifier)
+	if !ok {
+		return 0, fmt.Errorf("%w: %T has no type ID", ErrUnsupportedType, v)
+	}
+	if err := CheckNil(v); err != nil {
+		return 0, err
+	}
+	return ti.BorshTypeID(), nil
+}
+
+// MarshalAny encodes v, a generated struct or a pointer to one, after its type ID,
+// so UnmarshalAny decodes it without knowing its type
+func (r *Registry) MarshalAny(v any) ([]byte, error) {
+	id, err := TypeIDOf(v)
+	if err != nil {
+		return nil, err
+	}
+	data, err := MarshalValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return append(binary.LittleEndian.AppendUint64(make([]byte, 0, TypeIDSize+len(data)), id), data...), nil
+}
+
+// UnmarshalAny decodes data written by MarshalAny into a new value of the type registered under its
+// type ID, and returns a pointer to it
+func (r *Registry) UnmarshalAny(data []byte) (BorshEncoder, error) {
+	if len(data) < TypeIDSize {
+		return nil, &DecodeError{Kind: ShortBuffer, Err: fmt.Errorf("%d bytes are too short for a type ID", len(data))}
+	}
+	id := binary.LittleEndian.Uint64(data)
+	v, ok := r.New(id)
+	if !ok {
+		return nil, &DecodeError{Kind: UnknownType, Err: fmt.Errorf("no type is registered for type ID %#x", id)}
+	}
+	if err := v.UnmarshalBorsh(data[TypeIDSize:]); err != nil {
+		return nil, Rebase(err, TypeIDSize)
+	}
+	return v, nil
+}
@@ -0,0 +1,223 @@
+package borsh
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Schema describes the encoding of a type as Rust's borsh::schema::BorshSchemaContainer does:
+// the declaration of the type and the definitions of every type it refers to, keyed by declaration.
+// Its JSON is the container serialized with serde's default representation
+type Schema struct {
+	Declaration string                `json:"declaration"`
+	Definitions map[string]Definition `json:"definitions"`
+}
+
+// Definition is the encoding of one declared type. Exactly one of its fields is set
+type Definition struct {
+	Primitive *uint8    `json:"Primitive,omitempty"` // size in bytes of a fixed-width value
+	Sequence  *Sequence `json:"Sequence,omitempty"`
+	Tuple     *Tuple    `json:"Tuple,omitempty"`
+	Enum      *Enum     `json:"Enum,omitempty"`
+	Struct    *Struct   `json:"Struct,omitempty"`
+}
+
+// Sequence is a run of elements preceded by their count in LengthWidth bytes.
+// A fixed-size array has a LengthWidth of 0 and a LengthRange of its length alone
+type Sequence struct {
+	LengthWidth uint8  `json:"length_width"`
+	LengthRange Range  `json:"length_range"`
+	Elements    string `json:"elements"`
+}
+
+// Range is the inclusive range of the element count of a Sequence
+type Range struct {
+	Start uint64 `json:"start"`
+	End   uint64 `json:"end"`
+}
+
+// Tuple is a fixed list of unnamed values, such as a map entry
+type Tuple struct {
+	Elements []string `json:"elements"`
+}
+
+// Enum is a tag of TagWidth bytes followed by the value of the variant it selects
+type Enum struct {
+	TagWidth  uint8     `json:"tag_width"`
+	TagSigned bool      `json:"tag_signed"`
+	Variants  []Variant `json:"variants"`
+}
+
+// Variant is one variant of an Enum. Its JSON is [discriminant, name, declaration]
+type Variant struct {
+	Discriminant int64
+	Name         string
+	Declaration  string
+}
+
+// Struct lists the fields of a struct in the order they are written
+type Struct struct {
+	Fields []Field `json:"fields"`
+}
+
+// Field is a named field of a Struct. Its JSON is [name, declaration]
+type Field struct {
+	Name        string
+	Declaration string
+}
+
+// Declarations of the types every schema can refer to
+const (
+	UnitDeclaration   = "()"
+	StringDeclaration = "String"
+)
+
+// PrimitiveDef defines a fixed-width value of size bytes
+func PrimitiveDef(size uint8) Definition {
+	return Definition{Primitive: &size}
+}
+
+// VecDef defines a sequence with a u32 count, as written for strings, slices, maps and sets
+func VecDef(elements string) Definition {
+	return SequenceDef(4, 0, math.MaxUint32, elements)
+}
+
+// SequenceDef defines a sequence of elements with a count of width bytes between min and max
+func SequenceDef(width uint8, min, max uint64, elements string) Definition {
+	return Definition{Sequence: &Sequence{LengthWidth: width, LengthRange: Range{Start: min, End: max}, Elements: elements}}
+}
+
+// ArrayDef defines a fixed-size array of n elements, which is written without a count
+func ArrayDef(n uint64, elements string) Definition {
+	return SequenceDef(0, n, n, elements)
+}
+
+// TupleDef defines a fixed list of unnamed values
+func TupleDef(elements ...string) Definition {
+	return Definition{Tuple: &Tuple{Elements: elements}}
+}
+
+// EnumDef defines an enum with an unsigned tag of width bytes
+func EnumDef(width uint8, variants ...Variant) Definition {
+	return Definition{Enum: &Enum{TagWidth: width, Variants: variants}}
+}
+
+// OptionDef defines an option of some, the Enum Rust's borsh writes for Option
+func OptionDef(some string) Definition {
+	return EnumDef(1, Variant{0, "None", UnitDeclaration}, Variant{1, "Some", some})
+}
+
+// StructDef defines a struct with fields in the order they are written
+func StructDef(fields ...Field) Definition {
+	return Definition{Struct: &Struct{Fields: fields}}
+}
+
+// OptionDeclaration, VecDeclaration and the functions below name composite types as Rust's borsh does
+func OptionDeclaration(some string) string { return "Option<" + some + ">" }
+
+func VecDeclaration(elements string) string { return "Vec<" + elements + ">" }
+
+func ArrayDeclaration(n uint64, elements string) string {
+	return fmt.Sprintf("[%s; %d]", elements, n)
+}
+
+func MapDeclaration(key, value string) string { return "BTreeMap<" + key + ", " + value + ">" }
+
+func SetDeclaration(elements string) string { return "BTreeSet<" + elements + ">" }
+
+func TupleDeclaration(elements ...string) string {
+	s := "("
+	for i, e := range elements {
+		if i > 0 {
+			s += ", "
+		}
+		s += e
+	}
+	return s + ")"
+}
+
+func (v Variant) MarshalJSON() ([]byte, error) {
+	return marshalJSON([]any{v.Discriminant, v.Name, v.Declaration})
+}
+
+func (v *Variant) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("enum variant %s: expected [discriminant, name, declaration]", data)
+	}
+	if err := json.Unmarshal(raw[0], &v.Discriminant); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw[1], &v.Name); err != nil {
+		return err
+	}
+	return json.Unmarshal(raw[2], &v.Declaration)
+}
+
+// MarshalJSON writes the fields as Rust's Fields enum: {"NamedFields": [[name, declaration], ...]},
+// or "Empty" when there are none
+func (s Struct) MarshalJSON() ([]byte, error) {
+	if len(s.Fields) == 0 {
+		return []byte(`{"fields":"Empty"}`), nil
+	}
+	named := make([][2]string, len(s.Fields))
+	for i, f := range s.Fields {
+		named[i] = [2]string{f.Name, f.Declaration}
+	}
+	return marshalJSON(map[string]any{"fields": map[string]any{"NamedFields": named}})
+}
+
+func (s *Struct) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Fields json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	var empty string
+	if json.Unmarshal(raw.Fields, &empty) == nil {
+		if empty != "Empty" {
+			return fmt.Errorf("struct fields %s: expected Empty or NamedFields", raw.Fields)
+		}
+		s.Fields = nil
+		return nil
+	}
+	var fields struct {
+		NamedFields   *[][2]string `json:"NamedFields"`
+		UnnamedFields *[]string    `json:"UnnamedFields"`
+	}
+	if err := json.Unmarshal(raw.Fields, &fields); err != nil {
+		return err
+	}
+	s.Fields = nil
+	switch {
+	case fields.NamedFields != nil:
+		for _, f := range *fields.NamedFields {
+			s.Fields = append(s.Fields, Field{Name: f[0], Declaration: f[1]})
+		}
+	case fields.UnnamedFields != nil:
+		// Tuple structs name their fields by position
+		for i, d := range *fields.UnnamedFields {
+			s.Fields = append(s.Fields, Field{Name: fmt.Sprint(i), Declaration: d})
+		}
+	default:
+		return fmt.Errorf("struct fields %s: expected Empty or NamedFields", raw.Fields)
+	}
+	return nil
+}
+
+// marshalJSON is json.Marshal without escaping the angle brackets of declarations such as Vec<u8>
+func marshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,176 @@
+package borsh
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+var (
+	twoPow128 = new(big.Int).Lsh(big.NewInt(1), 128)
+	maxInt128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	minInt128 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+)
+
+// Uint128 is a Borsh u128: 16 bytes, little-endian.
+// It only carries the value; convert with BigInt for arithmetic.
+type Uint128 [16]byte
+
+// Int128 is a Borsh i128: 16 bytes, little-endian two's complement.
+// It only carries the value; convert with BigInt for arithmetic.
+type Int128 [16]byte
+
+// NewUint128 builds a Uint128 from its high and low 64 bits
+func NewUint128(hi, lo uint64) Uint128 {
+	var u Uint128
+	binary.LittleEndian.PutUint64(u[:8], lo)
+	binary.LittleEndian.PutUint64(u[8:], hi)
+	return u
+}
+
+// Uint128FromUint64 converts v to a Uint128
+func Uint128FromUint64(v uint64) Uint128 {
+	return NewUint128(0, v)
+}
+
+// Uint128FromBig converts v to a Uint128. It fails if v is negative or does not fit in 128 bits
+func Uint128FromBig(v *big.Int) (Uint128, error) {
+	var u Uint128
+	if v == nil {
+		return u, nil
+	}
+	if v.Sign() < 0 || v.BitLen() > 128 {
+		return u, fmt.Errorf("value %s out of range for u128", v)
+	}
+	putBigLE(u[:], v)
+	return u, nil
+}
+
+// Lo returns the low 64 bits
+func (u Uint128) Lo() uint64 {
+	return binary.LittleEndian.Uint64(u[:8])
+}
+
+// Hi returns the high 64 bits
+func (u Uint128) Hi() uint64 {
+	return binary.LittleEndian.Uint64(u[8:])
+}
+
+// IsZero reports whether u is zero
+func (u Uint128) IsZero() bool {
+	return u == Uint128{}
+}
+
+// BigInt returns u as a new big.Int
+func (u Uint128) BigInt() *big.Int {
+	return getBigLE(u[:])
+}
+
+// String returns the decimal representation of u
+func (u Uint128) String() string {
+	return u.BigInt().String()
+}
+
+// Cmp returns -1, 0 or +1 depending on whether u is less than, equal to or greater than v
+func (u Uint128) Cmp(v Uint128) int {
+	if c := cmp.Compare(u.Hi(), v.Hi()); c != 0 {
+		return c
+	}
+	return cmp.Compare(u.Lo(), v.Lo())
+}
+
+// NewInt128 builds an Int128 from its high and low 64 bits in two's complement
+func NewInt128(hi int64, lo uint64) Int128 {
+	var i Int128
+	binary.LittleEndian.PutUint64(i[:8], lo)
+	binary.LittleEndian.PutUint64(i[8:], uint64(hi))
+	return i
+}
+
+// Int128FromInt64 converts v to an Int128, sign-extending it to 128 bits
+func Int128FromInt64(v int64) Int128 {
+	return NewInt128(v>>63, uint64(v))
+}
+
+// Int128FromBig converts v to an Int128. It fails if v does not fit in 128 bits
+func Int128FromBig(v *big.Int) (Int128, error) {
+	var i Int128
+	if v == nil {
+		return i, nil
+	}
+	if v.Cmp(minInt128) < 0 || v.Cmp(maxInt128) > 0 {
+		return i, fmt.Errorf("value %s out of range for i128", v)
+	}
+	if v.Sign() < 0 {
+		v = new(big.Int).Add(v, twoPow128)
+	}
+	putBigLE(i[:], v)
+	return i, nil
+}
+
+// Lo returns the low 64 bits
+func (i Int128) Lo() uint64 {
+	return binary.LittleEndian.Uint64(i[:8])
+}
+
+// Hi returns the high 64 bits, including the sign bit
+func (i Int128) Hi() int64 {
+	return int64(binary.LittleEndian.Uint64(i[8:]))
+}
+
+// IsZero reports whether i is zero
+func (i Int128) IsZero() bool {
+	return i == Int128{}
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of i
+func (i Int128) Sign() int {
+	switch {
+	case i[15]&0x80 != 0:
+		return -1
+	case i.IsZero():
+		return 0
+	}
+	return 1
+}
+
+// BigInt returns i as a new big.Int
+func (i Int128) BigInt() *big.Int {
+	v := getBigLE(i[:])
+	if i[15]&0x80 != 0 {
+		v.Sub(v, twoPow128)
+	}
+	return v
+}
+
+// String returns the decimal representation of i
+func (i Int128) String() string {
+	return i.BigInt().String()
+}
+
+// Cmp returns -1, 0 or +1 depending on whether i is less than, equal to or greater than j
+func (i Int128) Cmp(j Int128) int {
+	if c := cmp.Compare(i.Hi(), j.Hi()); c != 0 {
+		return c
+	}
+	return cmp.Compare(i.Lo(), j.Lo())
+}
+
+// putBigLE writes the non-negative v into b as 16 little-endian bytes
+func putBigLE(b []byte, v *big.Int) {
+	var be [16]byte
+	v.FillBytes(be[:])
+	for j := 0; j < 16; j++ {
+		b[j] = be[15-j]
+	}
+}
+
+// getBigLE reads 16 little-endian bytes as a non-negative big.Int
+func getBigLE(b []byte) *big.Int {
+	var be [16]byte
+	for j := 0; j < 16; j++ {
+		be[j] = b[15-j]
+	}
+	return new(big.Int).SetBytes(be[:])
+}
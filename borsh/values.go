@@ -0,0 +1,301 @@
+package borsh
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CheckNil rejects a nil pointer to a nested value. Only an option holds nil, so a nil slice element
+// or map value has no encoding
+func CheckNil(v interface{}) error {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return Errorf(InvalidValue, "nil %T", v)
+	}
+	return nil
+}
+
+// MarshalValue encodes a nested value: a generated struct, a hand-written BinaryMarshaler or raw bytes
+func MarshalValue(v interface{}) ([]byte, error) {
+	if be, ok := v.([]byte); ok {
+		return be, nil
+	}
+	if be, ok := v.(string); ok {
+		return []byte(be), nil
+	}
+	if bm, ok := v.(BinaryMarshaler); ok {
+		if err := CheckNil(v); err != nil {
+			return nil, err
+		}
+		return bm.MarshalBorsh()
+	}
+	return nil, fmt.Errorf("%w for marshaling: %T", ErrUnsupportedType, v)
+}
+
+// UnmarshalValue decodes data into the nested value v at the nesting depth
+func UnmarshalValue(data []byte, v interface{}, depth int) error {
+	if be, ok := v.(*[]byte); ok {
+		*be = data
+		return nil
+	}
+	if be, ok := v.(*string); ok {
+		*be = string(data)
+		return nil
+	}
+	if rf, ok := v.(ReaderFrom); ok {
+		return rf.ReadBorsh(NewBytesReader(data), depth)
+	}
+	if bu, ok := v.(BinaryUnmarshaler); ok {
+		return bu.UnmarshalBorsh(data)
+	}
+	return fmt.Errorf("%w for unmarshaling: %T", ErrUnsupportedType, v)
+}
+
+// Decode decodes data into v, which must be the whole encoding of v.
+// With strict, trailing bytes and any other input that is not in canonical form are rejected
+func Decode(data []byte, v ReaderFrom, strict bool) error {
+	r := NewBytesReader(data)
+	r.SetStrict(strict)
+	return r.Decode(v)
+}
+
+// Decode decodes v from the rest of the input of r. When r is strict, bytes left over are rejected
+func (r *Reader) Decode(v ReaderFrom) error {
+	if err := v.ReadBorsh(r, 0); err != nil {
+		return err
+	}
+	if n := len(r.Remaining()); n > 0 && r.strict {
+		return r.Errorf(TrailingBytes, "%d bytes after the value", n)
+	}
+	return nil
+}
+
+// DecodePart decodes data, the length-prefixed encoding of a nested value that r just read, into v at depth.
+// It keeps the strictness and the allocation limit of r, and offsets of errors are those in the input of r
+func (r *Reader) DecodePart(data []byte, v interface{}, depth int) error {
+	rf, ok := v.(ReaderFrom)
+	if !ok {
+		return Rebase(UnmarshalValue(data, v, depth), r.Pos()-int64(len(data)))
+	}
+	sub := NewBytesReader(data)
+	sub.base = r.Pos() - int64(len(data))
+	sub.strict = r.strict
+	sub.limit, sub.alloc = r.limit, r.alloc
+	err := rf.ReadBorsh(sub, depth)
+	r.alloc = sub.alloc
+	if err != nil {
+		return err
+	}
+	if n := len(sub.Remaining()); n > 0 && sub.strict {
+		return sub.Errorf(TrailingBytes, "%d bytes after the value", n)
+	}
+	return nil
+}
+
+// EncodeValue returns the Encode form of a nested value
+func EncodeValue(v interface{}) ([]byte, error) {
+	if be, ok := v.([]byte); ok {
+		return be, nil
+	}
+	if be, ok := v.(string); ok {
+		return []byte(be), nil
+	}
+	if be, ok := v.(BinaryEncoder); ok {
+		if err := CheckNil(v); err != nil {
+			return nil, err
+		}
+		return be.Encode()
+	}
+	return nil, fmt.Errorf("%w for encoding: %T", ErrUnsupportedType, v)
+}
+
+// WriteEncoded writes the Encode form of a nested value to w.
+// Generated structs write it in place, so errors keep the path of the nested field
+func WriteEncoded(w *Writer, v interface{}) error {
+	if et, ok := v.(EncoderTo); ok {
+		if err := CheckNil(v); err != nil {
+			return err
+		}
+		return et.EncodeBorsh(w)
+	}
+	data, err := EncodeValue(v)
+	if err != nil {
+		return err
+	}
+	w.Write(data)
+	return nil
+}
+
+// SizeOf returns the encoded size of a nested value
+func SizeOf(v interface{}) (int, error) {
+	if be, ok := v.([]byte); ok {
+		return len(be), nil
+	}
+	if be, ok := v.(BinaryMarshaler); ok {
+		if err := CheckNil(v); err != nil {
+			return 0, err
+		}
+		return be.BinarySize()
+	}
+	return 0, fmt.Errorf("%w for binary size: %T", ErrUnsupportedType, v)
+}
+
+// CheckParam rejects nil pointer type arguments of generic structs, which have no encoding
+func CheckParam[T any](v T) error {
+	if rv := reflect.ValueOf(&v).Elem(); (rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface) && rv.IsNil() {
+		return fmt.Errorf("nil %T", v)
+	}
+	return nil
+}
+
+// NewParam returns a new value for a pointer type argument T to decode into
+func NewParam[T any]() (T, error) {
+	var v T
+	t := reflect.TypeOf(&v).Elem()
+	if t.Kind() != reflect.Pointer {
+		return v, fmt.Errorf("%w for unmarshaling: %T", ErrUnsupportedType, &v)
+	}
+	return reflect.New(t.Elem()).Interface().(T), nil
+}
+
+// MapEntry is an encoded map key and its encoded value. Order is the Go value of the key,
+// which entries are sorted by
+type MapEntry struct {
+	Key   []byte
+	Value []byte
+	Order any
+}
+
+// SortMapEntries orders entries by the values of their keys, see Compare. Two keys with the same
+// encoding are an error
+func SortMapEntries(entries []MapEntry) error {
+	sort.Slice(entries, func(i, j int) bool {
+		if c := Compare(entries[i].Order, entries[j].Order); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(entries[i].Key, entries[j].Key) < 0
+	})
+	for i := 1; i < len(entries); i++ {
+		if bytes.Equal(entries[i-1].Key, entries[i].Key) {
+			return Errorf(NotCanonical, "duplicate map key %x", entries[i].Key)
+		}
+	}
+	return nil
+}
+
+// CheckAscending rejects the value i of a map or set unless it is the first one
+// or sorts strictly after prev. what names the value in the error
+func CheckAscending(prev, cur any, i int, what string) error {
+	if i == 0 {
+		return nil
+	}
+	switch c := Compare(prev, cur); {
+	case c == 0:
+		return Errorf(NotCanonical, "duplicate %s %v", what, cur)
+	case c > 0:
+		return Errorf(NotCanonical, "%s %v is not sorted", what, cur)
+	}
+	return nil
+}
+
+// Compare orders two values of the same type the way Rust orders them, which is the order of the
+// keys of a Borsh map and the elements of a Borsh set: numbers by value, strings byte by byte,
+// slices and arrays element by element and then by length, structs field by field, and nil
+// pointers first. Generated structs compare only their encoded fields, see Comparer. It returns -1, 0 or +1
+func Compare(a, b any) int {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b)
+		}
+	case uint64:
+		if b, ok := b.(uint64); ok {
+			return cmp.Compare(a, b)
+		}
+	case int64:
+		if b, ok := b.(int64); ok {
+			return cmp.Compare(a, b)
+		}
+	case int:
+		if b, ok := b.(int); ok {
+			return cmp.Compare(a, b)
+		}
+	}
+	return compareValues(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func compareValues(a, b reflect.Value) int {
+	if !a.IsValid() || !b.IsValid() {
+		return cmp.Compare(boolOrder(a.IsValid()), boolOrder(b.IsValid()))
+	}
+	if a.Type() != b.Type() {
+		// Only values of interfaces differ in type
+		return strings.Compare(a.Type().String(), b.Type().String())
+	}
+	if a.CanInterface() && b.CanInterface() {
+		switch x := a.Interface().(type) {
+		case Uint128:
+			return x.Cmp(b.Interface().(Uint128))
+		case Int128:
+			return x.Cmp(b.Interface().(Int128))
+		case time.Time:
+			return x.Compare(b.Interface().(time.Time))
+		case *big.Int:
+			if y := b.Interface().(*big.Int); x != nil && y != nil {
+				return x.Cmp(y)
+			}
+		}
+	}
+	switch a.Kind() {
+	case reflect.Bool:
+		return cmp.Compare(boolOrder(a.Bool()), boolOrder(b.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	case reflect.Slice, reflect.Array:
+		if a.Kind() == reflect.Slice && a.Type().Elem().Kind() == reflect.Uint8 {
+			return bytes.Compare(a.Bytes(), b.Bytes())
+		}
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			if c := compareValues(a.Index(i), b.Index(i)); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.Len(), b.Len())
+	case reflect.Struct:
+		if a.CanInterface() && b.CanInterface() {
+			// Fields that are not encoded do not take part in the order
+			if x, ok := a.Interface().(Comparer); ok {
+				return x.CompareBorsh(b.Interface())
+			}
+		}
+		for i := 0; i < a.NumField(); i++ {
+			if c := compareValues(a.Field(i), b.Field(i)); c != 0 {
+				return c
+			}
+		}
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return cmp.Compare(boolOrder(!a.IsNil()), boolOrder(!b.IsNil()))
+		}
+		return compareValues(a.Elem(), b.Elem())
+	}
+	return 0
+}
+
+func boolOrder(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
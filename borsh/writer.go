@@ -0,0 +1,201 @@
+package borsh
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"math/big"
+	"sync"
+)
+
+// WriterBufferSize is the size the buffer of a streaming Writer reaches before it is flushed
+const WriterBufferSize = 32 << 10
+
+// Writer collects an encoding in a buffer. A Writer created by NewWriter flushes the buffer
+// to its io.Writer whenever it reaches WriterBufferSize, so streaming an encoding keeps a bounded buffer.
+// Errors writing to the io.Writer are kept and returned by Flush
+type Writer struct {
+	buf  []byte
+	out  io.Writer
+	n    int64 // bytes flushed to out
+	hold int   // buf is not flushed while positive, since bytes in it are still compared
+	err  error // first error writing to out
+}
+
+// NewWriter returns a Writer that streams to out. Call Flush once the encoding is written
+func NewWriter(out io.Writer) *Writer {
+	return &Writer{buf: make([]byte, 0, WriterBufferSize), out: out}
+}
+
+// NewBufferWriter returns a Writer that appends to dst
+func NewBufferWriter(dst []byte) *Writer {
+	return &Writer{buf: dst}
+}
+
+var writerPool = sync.Pool{
+	New: func() interface{} {
+		return new(Writer)
+	},
+}
+
+// GetWriter returns a pooled Writer that appends to dst. Return it with PutWriter once its Bytes are taken
+func GetWriter(dst []byte) *Writer {
+	w := writerPool.Get().(*Writer)
+	w.buf = dst
+	return w
+}
+
+// PutWriter resets w and returns it to the pool
+func PutWriter(w *Writer) {
+	*w = Writer{}
+	writerPool.Put(w)
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.out != nil && w.hold == 0 && len(p) >= WriterBufferSize {
+		// Large values go straight to out rather than through buf
+		w.Flush()
+		w.writeOut(p)
+		return len(p), nil
+	}
+	w.buf = append(w.buf, p...)
+	w.spill()
+	return len(p), nil
+}
+
+func (w *Writer) WriteString(str string) (int, error) {
+	if w.out != nil && w.hold == 0 && len(str) >= WriterBufferSize {
+		w.Flush()
+		w.writeOut([]byte(str))
+		return len(str), nil
+	}
+	w.buf = append(w.buf, str...)
+	w.spill()
+	return len(str), nil
+}
+
+func (w *Writer) WriteByte(c byte) error {
+	w.buf = append(w.buf, c)
+	w.spill()
+	return nil
+}
+
+// WriteBool writes b as a single 0 or 1 byte
+func (w *Writer) WriteBool(b bool) {
+	if b {
+		w.WriteByte(1)
+	} else {
+		w.WriteByte(0)
+	}
+}
+
+func (w *Writer) WriteUint16(v uint16) {
+	w.buf = binary.LittleEndian.AppendUint16(w.buf, v)
+	w.spill()
+}
+
+func (w *Writer) WriteUint32(v uint32) {
+	w.buf = binary.LittleEndian.AppendUint32(w.buf, v)
+	w.spill()
+}
+
+func (w *Writer) WriteUint64(v uint64) {
+	w.buf = binary.LittleEndian.AppendUint64(w.buf, v)
+	w.spill()
+}
+
+// The quiet NaNs with no payload. Every NaN is written as one of them, so a float has a single encoding
+const (
+	canonicalNaN32 = 0x7fc00000
+	canonicalNaN64 = 0x7ff8000000000000
+)
+
+// WriteFloat32 writes v as a little-endian f32, and any NaN as the canonical NaN
+func (w *Writer) WriteFloat32(v float32) {
+	if v != v {
+		w.WriteUint32(canonicalNaN32)
+		return
+	}
+	w.WriteUint32(math.Float32bits(v))
+}
+
+// WriteFloat64 writes v as a little-endian f64, and any NaN as the canonical NaN
+func (w *Writer) WriteFloat64(v float64) {
+	if v != v {
+		w.WriteUint64(canonicalNaN64)
+		return
+	}
+	w.WriteUint64(math.Float64bits(v))
+}
+
+// WriteU128 writes v as a little-endian u128. A nil v is written as zero
+func (w *Writer) WriteU128(v *big.Int) error {
+	u, err := Uint128FromBig(v)
+	if err != nil {
+		return err
+	}
+	w.Write(u[:])
+	return nil
+}
+
+// WriteI128 writes v as a little-endian two's complement i128. A nil v is written as zero
+func (w *Writer) WriteI128(v *big.Int) error {
+	i, err := Int128FromBig(v)
+	if err != nil {
+		return err
+	}
+	w.Write(i[:])
+	return nil
+}
+
+// Len returns the number of bytes in the buffer
+func (w *Writer) Len() int {
+	return len(w.buf)
+}
+
+// Bytes returns the buffer, which is the whole encoding unless the Writer streams
+func (w *Writer) Bytes() []byte {
+	return w.buf
+}
+
+// Written returns the number of bytes flushed to the io.Writer
+func (w *Writer) Written() int64 {
+	return w.n
+}
+
+// Hold keeps the bytes written from here on in the buffer until the matching Release,
+// so they can be compared with Bytes
+func (w *Writer) Hold() {
+	w.hold++
+}
+
+// Release ends a Hold and flushes the buffer if it is full
+func (w *Writer) Release() {
+	w.hold--
+	w.spill()
+}
+
+// spill flushes buf once it is full
+func (w *Writer) spill() {
+	if w.out != nil && w.hold == 0 && len(w.buf) >= WriterBufferSize {
+		w.Flush()
+	}
+}
+
+// Flush writes the buffer to the io.Writer and returns the first error writing to it
+func (w *Writer) Flush() error {
+	if w.out != nil {
+		w.writeOut(w.buf)
+		w.buf = w.buf[:0]
+	}
+	return w.err
+}
+
+func (w *Writer) writeOut(p []byte) {
+	if w.err != nil || len(p) == 0 {
+		return
+	}
+	n, err := w.out.Write(p)
+	w.n += int64(n)
+	w.err = err
+}
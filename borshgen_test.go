@@ -1,8 +1,10 @@
 package main
 
 import (
+	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/mlayerprotocol/go-borshgen/generator"
@@ -24,7 +26,171 @@ import (
 		// dir := filepath.Dir(tmpFile)
 		err := generator.GenerateDir(filepath.Join(dir, "tests"), "msg", "json", "enc", "-", true, 1024 * 100 )
 		if err != nil {
-			t.Log("Successfully Generate files")
+			t.Fatal(err)
 		}
-		 t.Error(err)
+		t.Log("Successfully Generate files")
  }
+
+func TestImport(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		panic("could not get caller info")
+	}
+	dir := filepath.Join(filepath.Dir(filename), "tests", "imported")
+	options := generator.DefaultOptions()
+	options.GenTests = true
+	for _, name := range []string{"conformance.schema", "vault", "escrow"} {
+		output := filepath.Join(dir, strings.TrimSuffix(name, ".schema")+".go")
+		err := generator.Import(filepath.Join(dir, "testdata", name+".json"), generator.ImportOptions{Output: output}, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// generatePackage writes files to a new module and generates code for it with the default options
+func generatePackage(t *testing.T, files map[string]string) error {
+	t.Helper()
+	dir := t.TempDir()
+	files["go.mod"] = "module example.com/gen\n\ngo 1.21\n"
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return generator.GenerateDirWithOptions(dir, generator.DefaultOptions())
+}
+
+func TestGeneratorErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{
+			name: "wire differs across files",
+			files: map[string]string{
+				"a.go": "package gen\n\n//go:generate borshgen\ntype A struct {\n\tX uint32\n}\n",
+				"b.go": "package gen\n\n//go:generate borshgen -wire=borsh\ntype B struct {\n\tY uint32\n}\n",
+			},
+			want: "-wire",
+		},
+		{
+			name: "strict differs across files",
+			files: map[string]string{
+				"a.go": "package gen\n\n//go:generate borshgen -strict\ntype A struct {\n\tX uint32\n}\n",
+				"b.go": "package gen\n\n//go:generate borshgen\ntype B struct {\n\tY uint32\n}\n",
+			},
+			want: "-strict",
+		},
+		{
+			name: "unknown wire layout",
+			files: map[string]string{
+				"a.go": "package gen\n\n//go:generate borshgen -wire=bosh\ntype A struct {\n\tX uint32\n}\n",
+			},
+			want: "a.go:3:1: unknown wire layout \"bosh\"",
+		},
+		{
+			name: "invalid max depth",
+			files: map[string]string{
+				"a.go": "package gen\n\n//go:generate borshgen -max-depth=0\ntype A struct {\n\tX uint32\n}\n",
+			},
+			want: "max depth",
+		},
+		{
+			name: "ambiguous promoted field",
+			files: map[string]string{
+				"a.go": "package gen\n\ntype A struct {\n\tX uint32\n}\n\ntype B struct {\n\tX uint32\n}\n\n" +
+					"//go:generate borshgen -tag=msg\ntype C struct {\n\tA `msg:\",inline\"`\n\tB `msg:\",inline\"`\n}\n",
+			},
+			want: "a.go:12:8: C: ambiguous field X",
+		},
+		{
+			name: "shadowed promoted field",
+			files: map[string]string{
+				"a.go": "package gen\n\ntype A struct {\n\tX uint32\n}\n\n" +
+					"//go:generate borshgen -tag=msg\ntype C struct {\n\tA `msg:\",inline\"`\n\tX uint32\n}\n",
+			},
+			want: "a.go:8:8: C: field A.X is shadowed by X",
+		},
+		{
+			name: "time modifier on a non-time field",
+			files: map[string]string{
+				"a.go": "package gen\n\n//go:generate borshgen -tag=msg\ntype A struct {\n\tX uint32\n\tAt int64 `msg:\",unixmilli\"`\n}\n",
+			},
+			want: "a.go:6:2: A.At: unixmilli requires a time.Time field",
+		},
+		{
+			name: "wide integer modifier on a non-big.Int field",
+			files: map[string]string{
+				"a.go": "package gen\n\n//go:generate borshgen -tag=msg\ntype A struct {\n\tN uint64 `msg:\",u128\"`\n}\n",
+			},
+			want: "a.go:5:2: A.N: u128 requires a *big.Int",
+		},
+		{
+			name: "set modifier on a non-slice field",
+			files: map[string]string{
+				"a.go": "package gen\n\n//go:generate borshgen -tag=msg\ntype A struct {\n\tTags map[string]bool `msg:\",set\"`\n}\n",
+			},
+			want: "a.go:5:2: A.Tags: set requires a slice field",
+		},
+		{
+			name: "unsupported field type",
+			files: map[string]string{
+				"a.go": "package gen\n\n//go:generate borshgen\ntype A struct {\n\tC chan int\n}\n",
+			},
+			want: "a.go:5:2: A.C: unsupported type chan int",
+		},
+		{
+			name: "negative value enum constant",
+			files: map[string]string{
+				"a.go": "package gen\n\n//borshgen:enum\ntype Level int8\n\nconst (\n\tLow Level = -1\n\tHigh Level = 1\n)\n\n" +
+					"//go:generate borshgen\ntype A struct {\n\tL Level\n}\n",
+			},
+			want: "constant Low = -1 is negative",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := generatePackage(t, c.files)
+			if err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Fatalf("generating %s = %v, want an error mentioning %q", c.name, err, c.want)
+			}
+		})
+	}
+}
+
+// go generate runs borshgen in the directory of the file, so a relative path must name its
+// generated files as generating the directory does
+func TestGenerateFileRelativePath(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/gen\n\ngo 1.21\n",
+		"a.go":   "package gen\n\n//go:generate borshgen -tag=msg\ntype A struct {\n\tX uint32 `msg:\"x\"`\n}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	if err := generator.GenerateFileWithOptions("a.go", generator.DefaultOptions()); err != nil {
+		t.Fatal(err)
+	}
+	fromFile, _ := filepath.Glob("*_gen.go")
+	if err := generator.GenerateDirWithOptions(dir, generator.DefaultOptions()); err != nil {
+		t.Fatal(err)
+	}
+	fromDir, _ := filepath.Glob("*_gen.go")
+	if len(fromFile) == 0 || strings.Join(fromFile, ",") != strings.Join(fromDir, ",") {
+		t.Errorf("generating a.go wrote %v, want the %v of generating its directory", fromFile, fromDir)
+	}
+}
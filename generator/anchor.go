@@ -0,0 +1,288 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+// anchorIDL is the part of an Anchor IDL that describes Borsh types, in the layout of Anchor 0.30
+// and later, where accounts and events name a type of Types, or of earlier versions, where they
+// hold their own type or fields
+type anchorIDL struct {
+	Instructions []struct {
+		Name          string        `json:"name"`
+		Docs          []string      `json:"docs"`
+		Discriminator []int         `json:"discriminator"`
+		Args          []anchorField `json:"args"`
+	} `json:"instructions"`
+	Accounts []anchorTypeDef `json:"accounts"`
+	Events   []anchorTypeDef `json:"events"`
+	Types    []anchorTypeDef `json:"types"`
+}
+
+// anchorTypeDef is a named type of an Anchor IDL
+type anchorTypeDef struct {
+	Name          string            `json:"name"`
+	Docs          []string          `json:"docs"`
+	Serialization string            `json:"serialization"`
+	Generics      []json.RawMessage `json:"generics"`
+	Discriminator []int             `json:"discriminator"` // of accounts and events since Anchor 0.30
+	Type          *struct {
+		Kind     string          `json:"kind"`
+		Fields   json.RawMessage `json:"fields"`
+		Variants []struct {
+			Name   string          `json:"name"`
+			Fields json.RawMessage `json:"fields"`
+		} `json:"variants"`
+		Alias json.RawMessage `json:"alias"`
+		Value json.RawMessage `json:"value"`
+	} `json:"type"`
+	Fields []anchorField `json:"fields"` // fields of an event before Anchor 0.30
+}
+
+// anchorField is a named field of an Anchor struct or instruction
+type anchorField struct {
+	Name string          `json:"name"`
+	Docs []string        `json:"docs"`
+	Type json.RawMessage `json:"type"`
+}
+
+// addAnchorIDL adds the accounts, events, instruction arguments and types of an Anchor IDL.
+// Each instruction gets a struct of its arguments named after it, e.g. InitializeArgs
+func (im *schemaImporter) addAnchorIDL(data []byte) error {
+	var idl anchorIDL
+	if err := json.Unmarshal(data, &idl); err != nil {
+		return err
+	}
+	for _, list := range []struct {
+		kind string // discriminator directive of the types, e.g. anchor-account
+		defs []anchorTypeDef
+	}{{"anchor-account", idl.Accounts}, {"anchor-event", idl.Events}, {"", idl.Types}} {
+		for _, def := range list.defs {
+			if list.kind != "" {
+				im.discriminators[def.Name] = importedDiscriminator{kind: list.kind, name: def.Name, bytes: anchorBytes(def.Discriminator)}
+			}
+			if def.Type == nil && def.Fields == nil {
+				// Accounts and events of Anchor 0.30 are declared in types
+				im.roots = append(im.roots, def.Name)
+				continue
+			}
+			added, err := im.addAnchorType(def)
+			if err != nil {
+				return fmt.Errorf("%s: %v", def.Name, err)
+			}
+			if added {
+				im.roots = append(im.roots, def.Name)
+			}
+		}
+	}
+	for _, ix := range idl.Instructions {
+		decl := goTypeName(ix.Name) + "Args"
+		if len(ix.Docs) > 0 {
+			im.docs[decl] = ix.Docs
+		} else {
+			im.docs[decl] = []string{fmt.Sprintf("%s are the arguments of the instruction %s", decl, ix.Name)}
+		}
+		fields, err := im.anchorFields(decl, ix.Args)
+		if err != nil {
+			return fmt.Errorf("instruction %s: %v", ix.Name, err)
+		}
+		if _, ok := im.defs[decl]; ok {
+			return fmt.Errorf("instruction %s: %s is also a type", ix.Name, decl)
+		}
+		im.defs[decl] = borsh.StructDef(fields...)
+		im.roots = append(im.roots, decl)
+		im.discriminators[decl] = importedDiscriminator{kind: "anchor-instruction", name: snakeCase(ix.Name), bytes: anchorBytes(ix.Discriminator)}
+	}
+	return nil
+}
+
+// anchorBytes converts the discriminator of an IDL, a JSON array of numbers, to bytes
+func anchorBytes(discriminator []int) []byte {
+	if discriminator == nil {
+		return nil
+	}
+	b := make([]byte, len(discriminator))
+	for i, v := range discriminator {
+		b[i] = byte(v)
+	}
+	return b
+}
+
+// addAnchorType defines a struct, enum or alias. Types Borsh does not describe, such as zero-copy
+// accounts and generic types, are left out with a warning
+func (im *schemaImporter) addAnchorType(def anchorTypeDef) (bool, error) {
+	if def.Serialization != "" && def.Serialization != "borsh" {
+		printWarning(fmt.Sprintf("not importing %s, which has %s serialization", def.Name, def.Serialization))
+		im.skipped[def.Name] = true
+		return false, nil
+	}
+	if len(def.Generics) > 0 {
+		printWarning(fmt.Sprintf("not importing the generic type %s", def.Name))
+		im.skipped[def.Name] = true
+		return false, nil
+	}
+	if len(def.Docs) > 0 {
+		im.docs[def.Name] = def.Docs
+	}
+	if def.Type == nil {
+		// An event before Anchor 0.30
+		fields, err := im.anchorFields(def.Name, def.Fields)
+		if err != nil {
+			return false, err
+		}
+		return true, im.define(def.Name, borsh.StructDef(fields...))
+	}
+	switch def.Type.Kind {
+	case "struct":
+		fields, err := im.anchorStructFields(def.Name, def.Type.Fields)
+		if err != nil {
+			return false, err
+		}
+		return true, im.define(def.Name, borsh.StructDef(fields...))
+	case "enum":
+		variants := make([]borsh.Variant, len(def.Type.Variants))
+		for i, v := range def.Type.Variants {
+			decl := def.Name + goTypeName(v.Name)
+			fields, err := im.anchorStructFields(decl, v.Fields)
+			if err != nil {
+				return false, err
+			}
+			if err := im.define(decl, borsh.StructDef(fields...)); err != nil {
+				return false, err
+			}
+			variants[i] = borsh.Variant{Discriminant: int64(i), Name: v.Name, Declaration: decl}
+		}
+		return true, im.define(def.Name, borsh.EnumDef(1, variants...))
+	case "type", "alias":
+		value := def.Type.Value
+		if value == nil {
+			value = def.Type.Alias
+		}
+		target, err := im.anchorType(value)
+		if err != nil {
+			return false, err
+		}
+		im.aliases[def.Name] = target
+		return false, nil
+	}
+	return false, fmt.Errorf("unsupported type kind %q", def.Type.Kind)
+}
+
+// anchorStructFields reads the fields of a struct or enum variant, which are named fields or the
+// types of a tuple
+func (im *schemaImporter) anchorStructFields(decl string, raw json.RawMessage) ([]borsh.Field, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(raw, &elems); err != nil {
+		return nil, err
+	}
+	var named []anchorField
+	for i, elem := range elems {
+		var f anchorField
+		if json.Unmarshal(elem, &f) != nil || f.Name == "" || f.Type == nil {
+			f = anchorField{Name: fmt.Sprint(i), Type: elem}
+		}
+		named = append(named, f)
+	}
+	return im.anchorFields(decl, named)
+}
+
+// anchorFields defines the types of fields and returns them in order
+func (im *schemaImporter) anchorFields(decl string, fields []anchorField) ([]borsh.Field, error) {
+	out := make([]borsh.Field, len(fields))
+	for i, f := range fields {
+		typ, err := im.anchorType(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", decl, f.Name, err)
+		}
+		if len(f.Docs) > 0 {
+			im.docs[decl+"."+f.Name] = f.Docs
+		}
+		out[i] = borsh.Field{Name: f.Name, Declaration: typ}
+	}
+	return out, nil
+}
+
+// anchorType defines an Anchor type and returns its declaration
+func (im *schemaImporter) anchorType(raw json.RawMessage) (string, error) {
+	var name string
+	if json.Unmarshal(raw, &name) == nil {
+		switch name {
+		case "string":
+			im.defs["u8"] = borsh.PrimitiveDef(1)
+			return borsh.StringDeclaration, im.define(borsh.StringDeclaration, borsh.VecDef("u8"))
+		case "bytes":
+			im.defs["u8"] = borsh.PrimitiveDef(1)
+			return bytesDeclaration, im.define(bytesDeclaration, borsh.VecDef("u8"))
+		case "publicKey", "pubkey":
+			im.defs["u8"] = borsh.PrimitiveDef(1)
+			decl := borsh.ArrayDeclaration(32, "u8")
+			return decl, im.define(decl, borsh.ArrayDef(32, "u8"))
+		}
+		if size, ok := primitiveSizes[name]; ok && name != borsh.UnitDeclaration {
+			return name, im.define(name, borsh.PrimitiveDef(size))
+		}
+		return "", fmt.Errorf("unsupported type %q", name)
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil || len(obj) != 1 {
+		return "", fmt.Errorf("unsupported type %s", raw)
+	}
+	for key, value := range obj {
+		switch key {
+		case "vec":
+			elem, err := im.anchorType(value)
+			if err != nil {
+				return "", err
+			}
+			decl := borsh.VecDeclaration(elem)
+			return decl, im.define(decl, borsh.VecDef(elem))
+		case "option":
+			some, err := im.anchorType(value)
+			if err != nil {
+				return "", err
+			}
+			im.defs[borsh.UnitDeclaration] = borsh.PrimitiveDef(0)
+			decl := borsh.OptionDeclaration(some)
+			return decl, im.define(decl, borsh.OptionDef(some))
+		case "array":
+			var array [2]json.RawMessage
+			var n uint64
+			if err := json.Unmarshal(value, &array); err != nil {
+				return "", err
+			}
+			if err := json.Unmarshal(array[1], &n); err != nil {
+				return "", fmt.Errorf("array length %s: only constant lengths are supported", array[1])
+			}
+			elem, err := im.anchorType(array[0])
+			if err != nil {
+				return "", err
+			}
+			decl := borsh.ArrayDeclaration(n, elem)
+			return decl, im.define(decl, borsh.ArrayDef(n, elem))
+		case "defined":
+			var defined struct {
+				Name     string            `json:"name"`
+				Generics []json.RawMessage `json:"generics"`
+			}
+			if json.Unmarshal(value, &defined.Name) != nil {
+				if err := json.Unmarshal(value, &defined); err != nil {
+					return "", err
+				}
+			}
+			if len(defined.Generics) > 0 {
+				return "", fmt.Errorf("%s: generic types are not supported", defined.Name)
+			}
+			return defined.Name, nil
+		case "coption":
+			return "", fmt.Errorf("COption has a 4-byte tag, which borshgen does not write")
+		}
+	}
+	return "", fmt.Errorf("unsupported type %s", raw)
+}
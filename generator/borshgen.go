@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"bytes"
 	"crypto/rand"
-	_ "embed"
 	"encoding/hex"
 	"fmt"
 	"go/ast"
@@ -18,6 +17,7 @@ import (
 	"reflect"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"text/template"
@@ -25,12 +25,10 @@ import (
 	"golang.org/x/tools/go/packages"
 
 	"github.com/cespare/xxhash"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
 	"github.com/mlayerprotocol/go-borshgen/templates"
 )
 
-//go:embed default_encoders.go
-var customEncodersBytes []byte
-
 func printWarning(message ...any) {
 	yellow := "\033[33m"
 	reset := "\033[0m"
@@ -90,19 +88,76 @@ func templateDict(values ...interface{}) map[string]interface{} {
 	return dict
 }
 
-// Enhanced options with zero-copy support
+// GeneratorOptions configures the code generated for a package
 type GeneratorOptions struct {
 	PrimaryTag   string
 	FallbackTag  string
 	IgnoreTag    string
 	UsePooling   bool
 	PackageName  string // Custom package name
-	ZeroCopy     bool   // NEW: Enable zero-copy reads
-	SafeMode     bool   // NEW: Safe vs unsafe zero-copy
 	MaxStringLen int
 	MaxSliceLen  int
 	EncodeTag    string
 	PoolSize string
+	Wire         string // Wire layout for length prefixes: WireLegacy or WireBorsh
+	Strict       bool   // Reject input that is not in canonical form when decoding
+	MaxDepth     int    // Deepest struct nesting accepted when decoding
+	MaxAlloc     int    // Most bytes one decode call allocates for strings, byte slices, slices and maps
+	Fuzz         bool   // Write a FuzzUnmarshal<Type> test for each struct
+	GenTests     bool   // Also write a round-trip test and benchmarks for each struct
+	Langs        []string // Languages to write codecs in besides Go, e.g. LangTypeScript
+}
+
+// DefaultMaxDepth is the struct nesting depth decoding accepts unless -max-depth= is given
+const DefaultMaxDepth = 64
+
+// DefaultMaxAlloc is the memory one decode call may allocate unless -max-alloc= is given
+const DefaultMaxAlloc = 64 << 20
+
+// Wire layouts selectable with -wire=
+const (
+	// WireLegacy writes uint16 length prefixes and length-prefixed nested structs
+	WireLegacy = "legacy"
+	// WireBorsh follows the Borsh spec: u32 length prefixes and inline nested structs
+	WireBorsh = "borsh"
+)
+
+// IsBorshWire reports whether the spec-compliant wire layout is selected
+func (o GeneratorOptions) IsBorshWire() bool {
+	return o.Wire == WireBorsh
+}
+
+// legacyMaxLength is the longest length the u16 prefixes of the legacy layout hold
+const legacyMaxLength = 1<<16 - 1
+
+// clampLengths lowers MaxStringLen and MaxSliceLen to what a length prefix of the layout holds,
+// so that marshaling rejects longer values rather than truncating their prefix
+func (o GeneratorOptions) clampLengths() GeneratorOptions {
+	if !o.IsBorshWire() {
+		o.MaxStringLen = min(o.MaxStringLen, legacyMaxLength)
+		o.MaxSliceLen = min(o.MaxSliceLen, legacyMaxLength)
+	}
+	return o
+}
+
+// Languages selectable with -lang=, a comma-separated list. Go code is always generated
+const (
+	LangGo         = "go"
+	LangTypeScript = "ts"
+	LangRust       = "rust"
+)
+
+// HasLang reports whether codecs in lang are written besides the Go code
+func (o GeneratorOptions) HasLang(lang string) bool {
+	return slices.Contains(o.Langs, lang)
+}
+
+// LengthPrefixSize returns the width in bytes of string, slice and bytes length prefixes
+func (o GeneratorOptions) LengthPrefixSize() int {
+	if o.IsBorshWire() {
+		return 4
+	}
+	return 2
 }
 
 func DefaultOptions() GeneratorOptions {
@@ -111,16 +166,17 @@ func DefaultOptions() GeneratorOptions {
 		FallbackTag:  "json",
 		IgnoreTag:    "-",
 		UsePooling:   true,
-		ZeroCopy:     false, // Default to safe copying
-		SafeMode:     true,  // Use safe zero-copy by default
 		MaxStringLen: 65535 * 200,
 		MaxSliceLen:  65535,
 		EncodeTag:    "enc",
 		PoolSize:  "MD",
+		Wire:         WireLegacy,
+		MaxDepth:     DefaultMaxDepth,
+		MaxAlloc:     DefaultMaxAlloc,
 	}
 }
 
-// FieldInfo with zero-copy information
+// FieldInfo describes a struct field and how it is encoded
 type FieldInfo struct {
 	Name                   string
 	TypeName               string
@@ -151,9 +207,11 @@ type FieldInfo struct {
 	CustomTypeName         string
 	CustomElementTypeName  string
 	ShouldIgnore           bool
-	CanZeroCopy            bool // NEW: Whether this field supports zero-copy
 	HasEncTag              bool // NEW: Whether field has "enc" or "encode" tag for deterministic encoding
 	EncType              	string
+	WireType               string // Borsh type selected in the tag, e.g. "u128" or "i128"
+	IsSet                  bool   // slice tagged "set": elements must be strictly ascending
+	MaxLen                 int    // max:"N" tag: most bytes or elements accepted when decoding, 0 for the package limit
 	EncOrder               int  // NEW: Sort order for deterministic encoding
 	SliceItem              int  // index of item if Type is Slice
 	ActualType             string
@@ -163,13 +221,34 @@ type FieldInfo struct {
 	HasElement   bool
 	Field        *FieldInfo
 	Index        int
+	// Pointer embeds a field promoted from an inline embed is reached through, outermost first
+	EmbeddedPointers []EmbeddedPointer
+}
+
+// Label returns the field name as an identifier, for labels in generated code
+func (fi *FieldInfo) Label() string {
+	return strings.ReplaceAll(fi.Name, ".", "_")
 }
 
 type StructInfo struct {
-	Name    string
-	Fields  []FieldInfo
-	Package string
-	Options GeneratorOptions
+	Name       string
+	TypeParams []string // type parameter names of a generic struct
+	Fields     []FieldInfo
+	Package    string
+	Options    GeneratorOptions
+	Schema     *borsh.Schema // nil when the struct cannot be described, see structSchema
+	// Discriminator starts the encoding of the struct, see discriminatorDirective
+	Discriminator []byte
+	// TypeID is written before the struct in fields typed by an interface, see typeIDDirective
+	TypeID uint64
+}
+
+// Receiver returns the receiver type of the generated methods, e.g. Envelope[T]
+func (s StructInfo) Receiver() string {
+	if len(s.TypeParams) == 0 {
+		return s.Name
+	}
+	return s.Name + "[" + strings.Join(s.TypeParams, ", ") + "]"
 }
 
 type Package struct {
@@ -182,13 +261,40 @@ type CodeGenerator struct {
 	options     GeneratorOptions
 	packages    []Package
 	rootPackage string
+	packageName string
+	pkg         *packages.Package
+	enums       []EnumInfo          // enums declared in the file being generated
+	enumMap     map[string]EnumInfo // all enums of the package, keyed by full type name
+	valueEnums  []ValueEnumInfo     // C-style enums declared in the file being generated
+	// C-style enums by full type name, nil for integer types that are not enums
+	valueEnumMap map[string]*ValueEnumInfo
+	// named types whose underlying type is being resolved, to stop at recursive types
+	resolving map[*types.TypeName]bool
+	// first type of the field being resolved that cannot be encoded
+	resolveErr error
+	quiet     bool // no progress output, for commands that print their result
+	base      GeneratorOptions // options directives start from
 	mu          sync.Mutex
 }
 
+// generatedImports are the packages the main template always imports
+var generatedImports = map[string]bool{
+	"bytes":           true,
+	"encoding/binary": true,
+	"encoding/json":   true,
+	"errors":          true,
+	"fmt":             true,
+	"math":            true,
+	"sync":            true,
+	runtimePackage:    true,
+}
+
+// runtimePackage is the import path of the borsh runtime the generated code is built on
+const runtimePackage = "github.com/mlayerprotocol/go-borshgen/borsh"
+
 var specialTypes = map[string]bool{
-	"time.Time":                   true,
-	"json.RawMessage":             true,
-	"github.com/google/uuid.UUID": true,
+	"json.RawMessage":  true,
+	googleUUIDTypeName: true,
 }
 
 // Template helper functions
@@ -231,41 +337,54 @@ var templateFuncs = template.FuncMap{
 	"isBasicElementType": func(field FieldInfo) bool {
 		return isBasicType(field.Element.UnderlyingType.String())
 	},
-	"dict": templateDict,
+	"dict":          templateDict,
+	"customDecoded": customDecoded,
+	"timeEncoder":   timeEncoder,
+	"schemaSource":  schemaSource,
+	"discriminatorSource": discriminatorSource,
 }
 
 // Complete template with all necessary functions
 const helperTemplate = templates.HelperTemplate
+const testTemplate = templates.TestTemplate
+const crossLangTestTemplate = templates.CrossLangTestTemplate
 
 // Complete template with all necessary functions
 const mainTemplate = templates.MainTemplate
 
-// canFieldZeroCopy determines if a field can support zero-copy reads
-func canFieldZeroCopy(fieldType string) bool {
-	zeroCopyTypes := map[string]bool{
-		"string":  true,
-		"[]byte":  true,
-		"uint64":  true,
-		"uint32":  true,
-		"int64":   true,
-		"int32":   true,
-		"int":     true,
-		"float64": true,
-		"float32": true,
-		"bool":    true,
+// structDirective finds the //go:generate borshgen directive of the struct typeSpec declared by genDecl
+// in file: on the declaration, on the type spec, or in the file comments for the first declaration
+func structDirective(file *ast.File, genDecl *ast.GenDecl, typeSpec *ast.TypeSpec, base GeneratorOptions) (bool, GeneratorOptions, error) {
+	// Try node.Doc first (most common)
+	if found, options, err := parseGenerateComment(genDecl.Doc, base); found {
+		return found, options, err
+	}
+
+	// Try typeSpec.Doc (sometimes comments are attached here)
+	if found, options, err := parseGenerateComment(typeSpec.Doc, base); found {
+		return found, options, err
 	}
 
-	return zeroCopyTypes[fieldType]
+	// Try file-level comments if this is the first/only declaration
+	if len(file.Decls) > 0 && file.Decls[0] == genDecl {
+		for _, commentGroup := range file.Comments {
+			if found, options, err := parseGenerateComment(commentGroup, base); found {
+				return found, options, err
+			}
+		}
+	}
+	return false, base, nil
 }
 
-// Enhanced parsing with zero-copy option detection
-func parseGenerateComment(commentGroup *ast.CommentGroup) (bool, GeneratorOptions) {
+// parseGenerateComment applies the options of the //go:generate borshgen directive in commentGroup to base.
+// An option with an invalid value is an error, so that a typo cannot change the layout
+func parseGenerateComment(commentGroup *ast.CommentGroup, base GeneratorOptions) (bool, GeneratorOptions, error) {
 
 	if commentGroup == nil {
-		return false, DefaultOptions()
+		return false, base, nil
 	}
 
-	options := DefaultOptions()
+	options := base
 	found := false
 
 	for _, comment := range commentGroup.List {
@@ -283,23 +402,117 @@ func parseGenerateComment(commentGroup *ast.CommentGroup) (bool, GeneratorOption
 					options.PrimaryTag = strings.TrimPrefix(option, "-tag=")
 				} else if strings.HasPrefix(option, "-fallback=") {
 					options.FallbackTag = strings.TrimPrefix(option, "-fallback=")
-				} else if option == "-zero-copy" {
-					options.ZeroCopy = false // TODD: not yet tested
-				} else if option == "-unsafe" {
-					options.SafeMode = false
 				} else if option == "-no-pool" {
 					options.UsePooling = false
 				} else if strings.HasPrefix(option, "-encode-tag=") {
 					options.EncodeTag = strings.TrimPrefix(option, "-encode-tag=")
 				} else if strings.HasPrefix(option, "-pool-size=") {
 					options.PoolSize = strings.ToUpper(strings.TrimPrefix(option, "-pool-size="))
+				} else if option == "-strict" {
+					options.Strict = true
+				} else if option == "-fuzz" {
+					options.Fuzz = true
+				} else if option == "-gen-tests" {
+					options.GenTests = true
+				} else if strings.HasPrefix(option, "-max-depth=") {
+					depth, err := parseMaxDepth(strings.TrimPrefix(option, "-max-depth="))
+					if err != nil {
+						return true, options, err
+					}
+					options.MaxDepth = depth
+				} else if strings.HasPrefix(option, "-max-alloc=") {
+					limit, err := parseMaxAlloc(strings.TrimPrefix(option, "-max-alloc="))
+					if err != nil {
+						return true, options, err
+					}
+					options.MaxAlloc = limit
+				} else if strings.HasPrefix(option, "-wire=") {
+					wire, err := parseWire(strings.TrimPrefix(option, "-wire="))
+					if err != nil {
+						return true, options, err
+					}
+					options.Wire = wire
+				} else if strings.HasPrefix(option, "-lang=") {
+					langs, err := ParseLangs(strings.TrimPrefix(option, "-lang="))
+					if err != nil {
+						return true, options, err
+					}
+					options.Langs = langs
 				}
 			}
 			break
 		}
 	}
 
-	return found, options
+	return found, options.clampLengths(), nil
+}
+
+// parseWire validates a -wire= option value
+func parseWire(wire string) (string, error) {
+	switch strings.ToLower(wire) {
+	case "", WireLegacy, "u16":
+		return WireLegacy, nil
+	case WireBorsh, "u32", "spec":
+		return WireBorsh, nil
+	}
+	return "", fmt.Errorf("unknown wire layout %q (expected %q or %q)", wire, WireLegacy, WireBorsh)
+}
+
+// ParseLangs validates a -lang= option value, a comma-separated list of languages.
+// Go is always generated, so it is accepted but not listed
+func ParseLangs(value string) ([]string, error) {
+	var langs []string
+	for _, lang := range strings.Split(value, ",") {
+		switch strings.ToLower(strings.TrimSpace(lang)) {
+		case "", LangGo:
+		case LangTypeScript, "typescript":
+			if !slices.Contains(langs, LangTypeScript) {
+				langs = append(langs, LangTypeScript)
+			}
+		case LangRust, "rs":
+			if !slices.Contains(langs, LangRust) {
+				langs = append(langs, LangRust)
+			}
+		default:
+			return nil, fmt.Errorf("unknown language %q (expected %q, %q or %q)", lang, LangGo, LangTypeScript, LangRust)
+		}
+	}
+	return langs, nil
+}
+
+// parseMaxDepth validates a -max-depth= option value
+func parseMaxDepth(value string) (int, error) {
+	depth, err := strconv.Atoi(value)
+	if err != nil || depth < 1 {
+		return 0, fmt.Errorf("invalid max depth %q (expected a positive integer)", value)
+	}
+	return depth, nil
+}
+
+// parseMaxAlloc validates a -max-alloc= option value
+func parseMaxAlloc(value string) (int, error) {
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		return 0, fmt.Errorf("invalid max alloc %q (expected a positive number of bytes)", value)
+	}
+	return limit, nil
+}
+
+// parseMaxTag returns the limit of a max:"N" field tag, or 0 when the field has none
+func parseMaxTag(name string, field *ast.Field) int {
+	if field.Tag == nil {
+		return 0
+	}
+	value, ok := reflect.StructTag(strings.Trim(field.Tag.Value, "`")).Lookup("max")
+	if !ok {
+		return 0
+	}
+	max, err := strconv.Atoi(value)
+	if err != nil || max < 1 {
+		printWarning(fmt.Sprintf("ignoring max:%q of field %s (expected a positive integer)", value, name))
+		return 0
+	}
+	return max
 }
 
 // isBasicType determines if a type is a basic Go type
@@ -378,7 +591,7 @@ func (cg *CodeGenerator) parseStructs(filename string) error {
 			packages.NeedImports |
 			packages.NeedTypes |
 			packages.NeedSyntax |
-			packages.NeedTypesInfo,
+			packages.NeedTypesInfo | packages.NeedDeps,
 		Dir: dir,
 	}
 
@@ -422,36 +635,45 @@ func (cg *CodeGenerator) parseStructs(filename string) error {
 	}
 
 	packageName := targetFile.Name.Name
+	cg.packageName = packageName
+	cg.pkg = pkg
 	cg.structMap = make(map[string]bool)
+	cg.valueEnumMap = make(map[string]*ValueEnumInfo)
+	if err := cg.collectEnums(pkg, targetFile); err != nil {
+		return err
+	}
+	if err := cg.collectValueEnums(pkg, targetFile); err != nil {
+		return err
+	}
 
 	// Use the package's type information (this includes all imports!)
 	info := pkg.TypesInfo
 
-	// Helper function to find generate comment in multiple locations
-	findGenerateComment := func(genDecl *ast.GenDecl, typeSpec *ast.TypeSpec) (bool, GeneratorOptions) {
-		// Try node.Doc first (most common)
-		if found, options := parseGenerateComment(genDecl.Doc); found {
-			cg.options = options
-			return found, options
-		}
+	// Options passed to the generator are the base every directive starts from
+	baseOptions := cg.options
+	cg.base = baseOptions
 
-		// Try typeSpec.Doc (sometimes comments are attached here)
-		if found, options := parseGenerateComment(typeSpec.Doc); found {
+	// Enum helpers in files without structs use the options of a file level directive
+	for _, commentGroup := range targetFile.Comments {
+		if found, options, err := parseGenerateComment(commentGroup, baseOptions); found {
+			if err != nil {
+				return positionError(pkg.Fset, commentGroup.Pos(), "%v", err)
+			}
 			cg.options = options
-			return found, options
+			break
 		}
+	}
 
-		// Try file-level comments if this is the first/only declaration
-		if len(targetFile.Decls) > 0 && targetFile.Decls[0] == genDecl {
-			for _, commentGroup := range targetFile.Comments {
-				if found, options := parseGenerateComment(commentGroup); found {
-					cg.options = options
-					return found, options
-				}
+	// Helper function to find generate comment in multiple locations
+	findGenerateComment := func(genDecl *ast.GenDecl, typeSpec *ast.TypeSpec) (bool, GeneratorOptions, error) {
+		if found, options, err := structDirective(targetFile, genDecl, typeSpec, baseOptions); found {
+			if err != nil {
+				return found, options, positionError(pkg.Fset, typeSpec.Pos(), "%s: %v", typeSpec.Name.Name, err)
 			}
+			cg.options = options
+			return found, options, nil
 		}
-
-		return false, cg.options
+		return false, cg.options, nil
 	}
 
 	// First pass: collect all struct names that should be generated
@@ -462,7 +684,7 @@ func (cg *CodeGenerator) parseStructs(filename string) error {
 				for _, spec := range node.Specs {
 					if typeSpec, ok := spec.(*ast.TypeSpec); ok {
 						if _, ok := typeSpec.Type.(*ast.StructType); ok {
-							if found, _ := findGenerateComment(node, typeSpec); found {
+							if found, _, _ := findGenerateComment(node, typeSpec); found {
 
 								cg.structMap[typeSpec.Name.Name] = true
 							}
@@ -475,21 +697,46 @@ func (cg *CodeGenerator) parseStructs(filename string) error {
 	})
 
 	// Second pass: extract struct information with options
+	var parseErr error
 	ast.Inspect(targetFile, func(n ast.Node) bool {
+		if parseErr != nil {
+			return false
+		}
 		switch node := n.(type) {
 		case *ast.GenDecl:
 			if node.Tok == token.TYPE {
 				for _, spec := range node.Specs {
 					if typeSpec, ok := spec.(*ast.TypeSpec); ok {
 						if structType, ok := typeSpec.Type.(*ast.StructType); ok {
-							if found, options := findGenerateComment(node, typeSpec); found {
+							if found, options, err := findGenerateComment(node, typeSpec); found {
+								if err != nil {
+									parseErr = err
+									return false
+								}
 								options.PackageName = packageName
 								cg.options = options
 
 								// Pass the package for enhanced type resolution
-								fmt.Printf("   ProcessingStruct: %v", typeSpec.Name.Name)
-								fmt.Println()
-								structInfo := cg.extractStructInfo(typeSpec.Name.Name, structType, options, info, pkg)
+								if !cg.quiet {
+									fmt.Printf("   ProcessingStruct: %v", typeSpec.Name.Name)
+									fmt.Println()
+								}
+								var structInfo StructInfo
+								structInfo, parseErr = cg.extractStructInfo(typeSpec.Name.Name, structType, options, info, pkg)
+								if parseErr != nil {
+									return false
+								}
+								structInfo.TypeParams = typeParamNames(typeSpec)
+								structInfo.Discriminator, parseErr = structDiscriminator(typeSpec.Name.Name, node.Doc, typeSpec.Doc)
+								if parseErr == nil && structInfo.Discriminator != nil && len(structInfo.TypeParams) > 0 {
+									parseErr = fmt.Errorf("%s: a generic struct cannot have a discriminator", typeSpec.Name.Name)
+								}
+								if parseErr == nil {
+									structInfo.TypeID, parseErr = structTypeID(pkg.Types.Path()+"."+typeSpec.Name.Name, typeSpec.Name.Name, node.Doc, typeSpec.Doc)
+								}
+								if parseErr != nil {
+									return false
+								}
 								cg.structs = append(cg.structs, structInfo)
 							}
 						}
@@ -500,7 +747,21 @@ func (cg *CodeGenerator) parseStructs(filename string) error {
 		return true
 	})
 
-	return nil
+	return parseErr
+}
+
+// typeParamNames returns the names of the type parameters declared by typeSpec
+func typeParamNames(typeSpec *ast.TypeSpec) []string {
+	if typeSpec.TypeParams == nil {
+		return nil
+	}
+	var names []string
+	for _, field := range typeSpec.TypeParams.List {
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return names
 }
 
 // parseStructsFallback is the original implementation as a fallback
@@ -537,7 +798,7 @@ func (cg *CodeGenerator) parseStructsFallback(filename string) error {
 }
 
 // Enhanced extractStructInfo with optional package information
-func (cg *CodeGenerator) extractStructInfo(structName string, structType *ast.StructType, options GeneratorOptions, typeInfo *types.Info, pkg ...*packages.Package) StructInfo {
+func (cg *CodeGenerator) extractStructInfo(structName string, structType *ast.StructType, options GeneratorOptions, typeInfo *types.Info, pkg ...*packages.Package) (StructInfo, error) {
 	structInfo := StructInfo{
 		Name:    structName,
 		Package: options.PackageName,
@@ -550,193 +811,273 @@ func (cg *CodeGenerator) extractStructInfo(structName string, structType *ast.St
 		pkgInfo = pkg[0]
 	}
 
-	for _, field := range structType.Fields.List {
-		if len(field.Names) == 0 {
-			if c, ok := field.Type.(*ast.Ident); ok {
-				field.Names = append(field.Names, c)
-			}
-		}
-		for _, name := range field.Names {
-			
-			actualType := ""
-			var resolvedTypeInfo *ResolvedTypeInfo
-
-			// Enhanced type extraction with package context
-			if typeInfo != nil {
-				if fieldType, ok := typeInfo.Types[field.Type]; ok {
-					underlying := fieldType.Type.Underlying()
-					actualType = strings.ReplaceAll(underlying.String(), options.PackageName+".", "")
-
-					// Extract detailed type information if we have package context
-					if pkgInfo != nil {
-						resolvedTypeInfo = cg.resolveTypeInfo(fieldType.Type, pkgInfo, nil)
-					}
+	var fset *token.FileSet
+	if pkgInfo != nil {
+		fset = pkgInfo.Fset
+	}
+	fields, err := cg.collectFields(structType.Fields.List, typeInfo, options)
+	if err != nil {
+		return structInfo, positionError(fset, structType.Pos(), "%s: %v", structName, err)
+	}
+	for _, sf := range fields {
+		field, name := sf.field, sf.name
+		
+		actualType := ""
+		var resolvedTypeInfo *ResolvedTypeInfo
+
+		// Enhanced type extraction with package context
+		if sf.typ != nil {
+			underlying := sf.typ.Underlying()
+			actualType = strings.ReplaceAll(underlying.String(), options.PackageName+".", "")
+
+			// Extract detailed type information if we have package context
+			if pkgInfo != nil {
+				cg.resolveErr = nil
+				resolvedTypeInfo = cg.resolveTypeInfo(sf.typ, pkgInfo, nil)
+				if cg.resolveErr != nil {
+					return structInfo, positionError(fset, sf.pos, "%s.%s: %v", structName, name, cg.resolveErr)
 				}
 			}
-			if resolvedTypeInfo != nil {
+		}
+		if resolvedTypeInfo != nil {
 
-				if resolvedTypeInfo.Element != nil {
-					actualType = resolvedTypeInfo.Element.TypeName
-				}
+			if resolvedTypeInfo.Element != nil {
+				actualType = resolvedTypeInfo.Element.TypeName
 			}
+		}
 
-			fieldInfo := cg.extractFieldInfo(name.Name, field, actualType, resolvedTypeInfo, options)
-			if fieldInfo.ShouldIgnore {
-				continue
+		fieldInfo := cg.extractFieldInfo(name, field, actualType, resolvedTypeInfo, options)
+		fieldInfo.EmbeddedPointers = sf.pointers
+		if sf.depth > 0 && fieldInfo.BinaryTag == strings.ToLower(name) {
+			// Promoted fields default to the tag of their own name
+			fieldInfo.BinaryTag = strings.ToLower(sf.goName)
+		}
+		if fieldInfo.ShouldIgnore {
+			continue
+		}
+		if resolvedTypeInfo == nil {
+			return structInfo, positionError(fset, sf.pos, "%s.%s: cannot resolve the field type, please define a custom encoder", structName, name)
+		}
+
+		// Create nested ResolvedTypeInfo structure from TypesTree
+		if resolvedTypeInfo.TypesTree != nil && len(*resolvedTypeInfo.TypesTree) > 0 {
+			result, hasWireType, err := cg.buildElementTree(*resolvedTypeInfo.TypesTree, &fieldInfo, true)
+			if err != nil {
+				return structInfo, positionError(fset, sf.pos, "%s.%s: %v", structName, name, err)
 			}
-			if resolvedTypeInfo == nil {
-				printError(fmt.Sprintf("Error resolving field: %s.%s. Please define a custom encoder", structName, name.Name))
-					panic("")
-				} else {
+			if fieldInfo.IsPointer {
+				result.IsPointer = true
 			}
-
-			// Create nested ResolvedTypeInfo structure from TypesTree
-			if resolvedTypeInfo.TypesTree != nil && len(*resolvedTypeInfo.TypesTree) > 0 {
-				var result *ResolvedTypeInfo
-
-				// convert TypesTree to Element Tree
-				// Start from the last element and work backwards to create nested structure
-				for i := len(*resolvedTypeInfo.TypesTree) - 1; i >= 0; i-- {
-					
-					current := &(*resolvedTypeInfo.TypesTree)[i]
-					if (strings.Contains(current.TypeName, "invalid") || strings.Contains(current.TypeName, "map")) && !fieldInfo.IsCustomFieldEncoder {
-						// printError(fmt.Sprintf("Error resolving field: %s.%s. Please define a custom encoder", structName, name.Name))
-						panic(fmt.Errorf("Error resolving field: %s.%s. Please define a custom encoder", structName, name.Name))
-					}
-					current.Field = &fieldInfo
-					if result != nil {
-						current.ElementType = cg.cleanPackagePath(result.UnderlyingType.String())
-
-					} 
-					if strings.HasPrefix(current.TypeName, "*") {
-						(current).assignCustomElementEncoder(current.TypeName, "")
-					} else if strings.HasPrefix(current.TypeName, "[]") {
-						
-						(current).assignCustomElementEncoder(current.TypeName, "")
-					} else {
-						
-						(current).assignCustomElementEncoder(current.TypeName, "")
-					}
-					if len(current.TypeName) == 0 {
-						current.TypeName = current.ElementType
-					}
-					if strings.HasPrefix(current.ElementType, "struct") {
-						current.IsStruct = true
-					}
-					if current.ElementType != current.UnderlyingType.String() {
-						current.ElementType = cg.cleanPackagePath(current.UnderlyingType.String())
-					}
-					current.Element = result
-					result = current
-
+			if fieldInfo.WireType != "" && !hasWireType {
+				if timeEncoders[fieldInfo.WireType] != "" {
+					return structInfo, positionError(fset, sf.pos, "%s.%s: %s requires a time.Time field", structName, name, fieldInfo.WireType)
 				}
-				if fieldInfo.IsPointer {
-					result.IsPointer = true
-				}
-				if result.IsPointer {
-					fieldInfo.IsPointer = result.IsPointer
-				}
-				fieldInfo.IsStruct = result.IsStruct
-				// Store the root of the nested structure
-				fieldInfo.Element = result
-				fieldInfo.ElementType = result.ElementType
+				return structInfo, positionError(fset, sf.pos, "%s.%s: %s requires a *big.Int, borsh.Uint128 or borsh.Int128 field", structName, name, fieldInfo.WireType)
+			}
+			if fieldInfo.IsSet && (!result.IsSlice || result.IsFixedArray || result.IsCustomElementEncoder) {
+				return structInfo, positionError(fset, sf.pos, "%s.%s: %s requires a slice field", structName, name, setModifier)
+			}
+			if result.IsPointer {
+				fieldInfo.IsPointer = result.IsPointer
+			}
+			fieldInfo.IsStruct = result.IsStruct
+			fieldInfo.IsMap = result.IsMap
+			// Store the root of the nested structure
+			fieldInfo.Element = result
+			fieldInfo.ElementType = result.ElementType
 
 
+		} else {
+			if !fieldInfo.IsCustomFieldEncoder {
+				return structInfo, positionError(fset, sf.pos, "%s.%s: cannot resolve the field type, please define a custom encoder", structName, name)
 			} else {
-				if !fieldInfo.IsCustomFieldEncoder {
-					printError(fmt.Sprintf("Error resolving field: %s.%s. Please define a custom encoder", structName, name.Name))
-					panic("")
-				} else {
-					fieldInfo.Element = &ResolvedTypeInfo{
-						ElementType: fieldInfo.ElementType,
-					}
+				fieldInfo.Element = &ResolvedTypeInfo{
+					ElementType: fieldInfo.ElementType,
 				}
 			}
+		}
 
-			if !fieldInfo.IsCustomElementEncoder && len(actualType) > 0 {
-
-				fieldInfo.ActualType = actualType
-				if strings.Contains(fieldInfo.TypeName, ".") && len(fieldInfo.KnownImportedType()) == 0 {
-					fieldInfo.CustomTypeName = fieldInfo.TypeName
-					fieldInfo.TypeName = actualType
-					if isBasicType(actualType) {
-						// fieldInfo.IsBasicType = true
-						// fieldInfo.Element = actualType
-						if fieldInfo.IsPointer {
-							fieldInfo.IsBasicPointerType = true
-						}
+		if !fieldInfo.IsCustomElementEncoder && len(actualType) > 0 {
+
+			fieldInfo.ActualType = actualType
+			if strings.Contains(fieldInfo.TypeName, ".") && len(fieldInfo.KnownImportedType()) == 0 {
+				fieldInfo.CustomTypeName = fieldInfo.TypeName
+				fieldInfo.TypeName = actualType
+				if isBasicType(actualType) {
+					// fieldInfo.IsBasicType = true
+					// fieldInfo.Element = actualType
+					if fieldInfo.IsPointer {
+						fieldInfo.IsBasicPointerType = true
 					}
 				}
-
 			}
-			if fieldInfo.IsPointer && fieldInfo.Element != nil {
-				// If it's a pointer but no element type is set, use the actual type
-
-				fieldInfo.PointerDeref = "*"
-				fieldInfo.PointerRef = "&"
 
-			}
+		}
+		if fieldInfo.IsPointer && fieldInfo.Element != nil {
+			// If it's a pointer but no element type is set, use the actual type
 
-			if resolvedTypeInfo != nil {
-				pkg := ""
-				ctype := ""
-				pksString := ""
-				if len(resolvedTypeInfo.FullTypeName) > 0 {
-					pksString = resolvedTypeInfo.FullTypeName
-					// pkg = resolvedTypeInfo.FullTypeName[0:strings.LastIndex(resolvedTypeInfo.FullTypeName, ".")]
-					// ctype = resolvedTypeInfo.FullTypeName[strings.LastIndex(resolvedTypeInfo.FullTypeName, "/")+1:]
-				} else if resolvedTypeInfo.UnderlyingType != nil && len(resolvedTypeInfo.UnderlyingType.String()) > 0 {
-					pksString = resolvedTypeInfo.UnderlyingType.String()
-					// pkg = resolvedTypeInfo.UnderlyingType[0:strings.LastIndex(resolvedTypeInfo.UnderlyingType, ".")]
-					// ctype = resolvedTypeInfo.UnderlyingType[strings.LastIndex(resolvedTypeInfo.UnderlyingType, "/")+1:]
-				} else {
-					if resolvedTypeInfo.Element != nil {
-						if len(resolvedTypeInfo.Element.FullTypeName) > 0 {
-							pksString = resolvedTypeInfo.FullTypeName
-						} else if resolvedTypeInfo.Element.UnderlyingType != nil && len(resolvedTypeInfo.Element.UnderlyingType.String()) > 0 {
-							pksString = resolvedTypeInfo.UnderlyingType.String()
-						}
-						// pkg = resolvedTypeInfo.Element.FullTypeName[0:strings.LastIndex(resolvedTypeInfo.Element.FullTypeName, ".")]
-						// ctype = resolvedTypeInfo.Element.FullTypeName[strings.LastIndex( resolvedTypeInfo.Element.FullTypeName, "/")+1:]
-					}
+			fieldInfo.PointerDeref = "*"
+			fieldInfo.PointerRef = "&"
 
-				}
-				pksString = strings.ReplaceAll(pksString, "[]", "")
-				pksString = strings.ReplaceAll(pksString, "*", "")
-				if len(pksString) > 0 && strings.Contains(pksString, ".") {
-					pkg = pksString[0:strings.LastIndex(pksString, ".")]
-					ctype = pksString[strings.LastIndex(pksString, "/")+1:]
-				}
+		}
 
+		if resolvedTypeInfo != nil && (containsMap(resolvedTypeInfo.UnderlyingType) || hasTypeArgs(resolvedTypeInfo.UnderlyingType)) {
+			// Map key and value types and type arguments are spelled out in the generated code
+			cg.addTypePackages(resolvedTypeInfo.UnderlyingType)
+		} else if resolvedTypeInfo != nil {
+			pkg := ""
+			ctype := ""
+			pksString := ""
+			if len(resolvedTypeInfo.FullTypeName) > 0 {
+				pksString = resolvedTypeInfo.FullTypeName
+				// pkg = resolvedTypeInfo.FullTypeName[0:strings.LastIndex(resolvedTypeInfo.FullTypeName, ".")]
+				// ctype = resolvedTypeInfo.FullTypeName[strings.LastIndex(resolvedTypeInfo.FullTypeName, "/")+1:]
+			} else if resolvedTypeInfo.UnderlyingType != nil && len(resolvedTypeInfo.UnderlyingType.String()) > 0 {
+				pksString = resolvedTypeInfo.UnderlyingType.String()
+				// pkg = resolvedTypeInfo.UnderlyingType[0:strings.LastIndex(resolvedTypeInfo.UnderlyingType, ".")]
+				// ctype = resolvedTypeInfo.UnderlyingType[strings.LastIndex(resolvedTypeInfo.UnderlyingType, "/")+1:]
+			} else {
 				if resolvedTypeInfo.Element != nil {
-					// fmt.Println(fmt.Sprintf("FOUUND: %+v ======\n%+v", resolvedTypeInfo.Element, fieldInfo))
+					if len(resolvedTypeInfo.Element.FullTypeName) > 0 {
+						pksString = resolvedTypeInfo.FullTypeName
+					} else if resolvedTypeInfo.Element.UnderlyingType != nil && len(resolvedTypeInfo.Element.UnderlyingType.String()) > 0 {
+						pksString = resolvedTypeInfo.UnderlyingType.String()
+					}
+					// pkg = resolvedTypeInfo.Element.FullTypeName[0:strings.LastIndex(resolvedTypeInfo.Element.FullTypeName, ".")]
+					// ctype = resolvedTypeInfo.Element.FullTypeName[strings.LastIndex( resolvedTypeInfo.Element.FullTypeName, "/")+1:]
 				}
 
-				if len(pkg) > 0 {
+			}
+			pksString = strings.ReplaceAll(pksString, "[]", "")
+			pksString = strings.ReplaceAll(pksString, "*", "")
+			if len(pksString) > 0 && strings.Contains(pksString, ".") {
+				pkg = pksString[0:strings.LastIndex(pksString, ".")]
+				ctype = pksString[strings.LastIndex(pksString, "/")+1:]
+			}
 
-					cg.mu.Lock()
+			if resolvedTypeInfo.Element != nil {
+				// fmt.Println(fmt.Sprintf("FOUUND: %+v ======\n%+v", resolvedTypeInfo.Element, fieldInfo))
+			}
 
-					if pkg != cg.rootPackage && !specialTypes[ctype] {
-						if !slices.ContainsFunc(cg.packages, func(p Package) bool {
-							return strings.EqualFold(p.Package, pkg)
-						}) {
-							cg.packages = append(cg.packages, Package{
-								Package:    pkg,
-								CustomType: ctype,
-							})
-						}
+			if len(pkg) > 0 {
+
+				cg.mu.Lock()
+
+				if pkg != cg.rootPackage && !specialTypes[ctype] && !generatedImports[pkg] {
+					if !slices.ContainsFunc(cg.packages, func(p Package) bool {
+						return strings.EqualFold(p.Package, pkg)
+					}) {
+						cg.packages = append(cg.packages, Package{
+							Package:    pkg,
+							CustomType: ctype,
+						})
 					}
-					cg.mu.Unlock()
 				}
+				cg.mu.Unlock()
 			}
+		}
 
-			if !fieldInfo.ShouldIgnore {
-				structInfo.Fields = append(structInfo.Fields, fieldInfo)
-			}
+		if !fieldInfo.ShouldIgnore {
+			structInfo.Fields = append(structInfo.Fields, fieldInfo)
 		}
 	}
 
-	return structInfo
+	return structInfo, nil
+}
+
+// buildElementTree links a TypesTree into the nested Element structure the templates walk.
+// root is false for trees that do not start at the field itself, such as map keys.
+// hasWireType reports whether a node is encoded with the wire type selected in the tag
+func (cg *CodeGenerator) buildElementTree(tree []ResolvedTypeInfo, fieldInfo *FieldInfo, root bool) (result *ResolvedTypeInfo, hasWireType bool, err error) {
+	// Start from the last element and work backwards to create nested structure
+	for i := len(tree) - 1; i >= 0; i-- {
+		current := &tree[i]
+		if strings.Contains(current.TypeName, "invalid") && !fieldInfo.IsCustomFieldEncoder {
+			return nil, false, fmt.Errorf("Please define a custom encoder")
+		}
+		current.Field = fieldInfo
+		if result != nil {
+			current.ElementType = cg.cleanPackagePath(result.UnderlyingType.String())
+		}
+		(current).assignCustomElementEncoder(current.TypeName, "")
+		if len(current.TypeName) == 0 {
+			current.TypeName = current.ElementType
+		}
+		if strings.HasPrefix(current.ElementType, "struct") {
+			current.IsStruct = true
+		}
+		if current.IsCustomElementEncoder {
+			// Written by the encoder rather than as a nested struct
+			current.IsStruct = false
+		}
+		if current.ElementType != current.UnderlyingType.String() {
+			current.ElementType = cg.cleanPackagePath(current.UnderlyingType.String())
+		}
+		if current.IsMap {
+			// Keys are resolved as a tree of their own, the value continues this one
+			key, _, err := cg.buildElementTree(*current.KeyTree, fieldInfo, false)
+			if err != nil {
+				return nil, false, err
+			}
+			current.Key = key
+		}
+		if ok, err := current.assignInt128Type(fieldInfo.WireType); err != nil {
+			return nil, false, err
+		} else if ok {
+			// 128-bit integers are encoded as a single scalar
+			result = nil
+			hasWireType = true
+			if root && i == 0 {
+				fieldInfo.IsPointer = false
+				fieldInfo.PointerDeref = ""
+				fieldInfo.PointerRef = ""
+				fieldInfo.IsBasicType = true
+			}
+		} else if current.assignTimeType(fieldInfo.WireType) {
+			// Times are written in place at the precision of the tag
+			result = nil
+			if timeEncoders[fieldInfo.WireType] != "" {
+				hasWireType = true
+			}
+			if root && i == 0 {
+				fieldInfo.IsBasicType = true
+				fieldInfo.IsStruct = false
+			}
+		} else if current.assignUUIDType() {
+			// UUIDs are written as their 16 bytes
+			result = nil
+			if root && i == 0 {
+				fieldInfo.IsBasicType = true
+			}
+		} else if current.assignEnumType(cg.enumMap) {
+			// Borsh enums are encoded by the generated enum helpers
+			result = nil
+			if root && i == 0 {
+				fieldInfo.IsInterface = true
+				fieldInfo.IsBasicType = true
+			}
+		} else if current.assignTypeParam() {
+			// The type argument is only known at run time
+			result = nil
+			if root && i == 0 {
+				fieldInfo.IsBasicType = true
+				fieldInfo.IsStruct = false
+			}
+		} else if current.assignAnyType() {
+			// The value is written with its type ID, which the Registry maps back to its type
+			result = nil
+			if root && i == 0 {
+				fieldInfo.IsInterface = true
+				fieldInfo.IsBasicType = true
+				fieldInfo.IsStruct = false
+			}
+		} else if _, err := cg.assignValueEnumType(current); err != nil {
+			return nil, false, err
+		}
+		current.Element = result
+		result = current
+	}
+	return result, hasWireType, nil
 }
 
 // ResolvedTypeInfo contains detailed information about a resolved type
@@ -760,12 +1101,19 @@ type ResolvedTypeInfo struct {
 	IsCustomElementEncoder bool
 	CustomElementEncoder   string
 	CustomTypeName         string
+	DecodedTypeName        string // type the custom element encoder decodes to when it is not TypeName
 	CustomFieldEncoder     string
 	IsCustomFieldEncoder   bool
 	Field                  *FieldInfo
 	IsFixedArray           bool
 	FixedArrayLength       int64
 	Index                  int
+	IsMap                  bool
+	IsSet                  bool                // map[T]struct{}, encoded as its keys only
+	Key                    *ResolvedTypeInfo   // key of a map, Element is its value
+	KeyTree                *[]ResolvedTypeInfo // TypesTree of the map key
+	KeyTypeName            string              // Go type of the map key
+	ValueTypeName          string              // Go type of the map value
 }
 
 // resolveTypeInfo extracts detailed type information
@@ -786,6 +1134,10 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 
 		case *types.Alias:
 		obj := typ.Obj()
+		if obj != nil && obj.Pkg() == nil {
+			// Predeclared aliases such as any
+			return cg.resolveTypeInfo(types.Unalias(typ), pkg, parentTypes)
+		}
 		if obj != nil && obj.Pkg() != nil {
 			info.PackagePath = obj.Pkg().Path()
 			info.PackageName = obj.Pkg().Name()
@@ -801,6 +1153,7 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 			}
 			this := ResolvedTypeInfo{
 				TypeName:      cg.cleanPackagePath(typ.String()),
+				FullTypeName:   info.FullTypeName,
 				IsBasicType:     isBasicType(cg.cleanPackagePath(typ.String())) || isBasicType(cg.cleanPackagePath(typ.Underlying().String())),
 				UnderlyingType: typ.Underlying(),
 			}
@@ -832,7 +1185,32 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 		}
 
 	case *types.Named:
-		
+		if _, ok := typ.Underlying().(*types.Struct); !ok {
+			// Structs are encoded through their own methods; other named types are
+			// expanded, so a type that contains itself must stop at the recursion point
+			if cg.resolving[typ.Obj()] {
+				return cg.resolveRecursiveType(typ, parentTypes)
+			}
+			if cg.resolving == nil {
+				cg.resolving = make(map[*types.TypeName]bool)
+			}
+			cg.resolving[typ.Obj()] = true
+			defer delete(cg.resolving, typ.Obj())
+		}
+		if m, ok := typ.Underlying().(*types.Map); ok {
+			// Named maps are encoded as their underlying map
+			return cg.resolveMapInfo(typ, m, pkg, parentTypes)
+		}
+		if isNamedSequence(typ) {
+			// Named slices and arrays are encoded as their underlying type
+			info := cg.resolveTypeInfo(typ.Underlying(), pkg, parentTypes)
+			info.TypeName = cg.typeExpr(typ)
+			info.FullTypeName = typ.Obj().Pkg().Path() + "." + typ.Obj().Name()
+			info.UnderlyingType = typ
+			(*info.TypesTree)[len(parentTypes)].TypeName = info.TypeName
+			(*info.TypesTree)[len(parentTypes)].FullTypeName = info.FullTypeName
+			return info
+		}
 		obj := typ.Obj()
 		if obj != nil && obj.Pkg() != nil {
 			info.PackagePath = obj.Pkg().Path()
@@ -849,9 +1227,15 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 			}
 			this := ResolvedTypeInfo{
 				TypeName:      cg.cleanPackagePath(typ.String()),
+				FullTypeName:   info.FullTypeName,
 				IsBasicType:     isBasicType(cg.cleanPackagePath(typ.String())) || isBasicType(cg.cleanPackagePath(typ.Underlying().String())),
 				UnderlyingType: typ.Underlying(),
 			}
+			if typ.TypeArgs().Len() > 0 {
+				// Instantiated generic types, e.g. Envelope[Transfer]
+				this.TypeName = cg.typeExpr(typ)
+				info.TypeName = this.TypeName
+			}
 			if this.UnderlyingType != nil {
 				this.ElementType = cg.cleanPackagePath(this.UnderlyingType.String())
 			}
@@ -862,7 +1246,7 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 				currentPath = append(currentPath, this)
 			}
 			// currentPath = append(currentPath, cleanPackagePath(typ.String())) // use the actual type string
-			if typ.Underlying() != nil && !isBasicType(typ.Underlying().String()) {
+			if typ.Underlying() != nil && !isBasicType(typ.Underlying().String()) && !cg.isEnumType(typ) && !types.IsInterface(typ) {
 				child := cg.resolveTypeInfo(typ.Underlying(), pkg, currentPath)
 				// currentPath = append(currentPath, *child)
 				currentPath = *child.TypesTree
@@ -900,6 +1284,9 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 			IsFixedArray:   false, // strings.HasPrefix(typ.Underlying().String(), "[") && !strings.HasPrefix(typ.Underlying().String(), "[]"),
 			UnderlyingType: typ.Underlying(),
 		}
+		if hasTypeArgs(typ) {
+			this.TypeName = cg.typeExpr(typ)
+		}
 		info.TypeName = this.TypeName
 		info.ElementType = cg.cleanPackagePath(typ.Elem().String())
 		
@@ -950,6 +1337,9 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 				IsFixedArray:     true,
 				FixedArrayLength: typ.Len(),
 			}
+		if hasTypeArgs(typ) {
+			this.TypeName = cg.typeExpr(typ)
+		}
 		info.TypeName = this.TypeName
 		info.ElementType = cg.cleanPackagePath(typ.Elem().String())
 		info.IsFixedArray =   true
@@ -997,6 +1387,25 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 		}
 		return info
 
+	case *types.Map:
+		return cg.resolveMapInfo(typ, typ, pkg, parentTypes)
+
+	case *types.Interface:
+		// Fields typed by an interface hold values of types only known at run time
+		info.TypeName = cg.cleanPackagePath(typ.String())
+		currentPath = append(currentPath, ResolvedTypeInfo{
+			TypeName:       info.TypeName,
+			UnderlyingType: typ,
+		})
+
+	case *types.TypeParam:
+		// Fields of a generic struct typed by its type parameters
+		info.TypeName = typ.Obj().Name()
+		currentPath = append(currentPath, ResolvedTypeInfo{
+			TypeName:       info.TypeName,
+			UnderlyingType: typ,
+		})
+
 	case *types.Pointer:
 		_, isStruct := typ.Elem().(*types.Struct)
 
@@ -1062,7 +1471,7 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 			}
 		}
 	default:
-		panic(fmt.Errorf("detected invalid type: %T", typ))
+		cg.failResolve(fmt.Errorf("unsupported type %s", typ))
 
 	}
 	
@@ -1071,6 +1480,177 @@ func (cg *CodeGenerator) resolveTypeInfo(t types.Type, pkg *packages.Package, pa
 	return info
 }
 
+// isNamedSequence reports whether t is a named slice or array type.
+// Byte sequences are left to the custom element encoders
+func isNamedSequence(t *types.Named) bool {
+	var elem types.Type
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		elem = u.Elem()
+	case *types.Array:
+		elem = u.Elem()
+	default:
+		return false
+	}
+	b, ok := elem.Underlying().(*types.Basic)
+	return t.Obj().Pkg() != nil && !(ok && b.Kind() == types.Byte)
+}
+
+// failResolve records err as the reason the field being resolved cannot be encoded.
+// Resolution carries on with a placeholder so that callers need not check every child
+func (cg *CodeGenerator) failResolve(err error) {
+	if cg.resolveErr == nil {
+		cg.resolveErr = err
+	}
+}
+
+// resolveRecursiveType resolves a named type met again while resolving its own underlying type.
+// It is encoded as a nested value through its MarshalBorsh, BinarySize and UnmarshalBorsh methods
+func (cg *CodeGenerator) resolveRecursiveType(typ *types.Named, parentTypes []ResolvedTypeInfo) *ResolvedTypeInfo {
+	methods := types.NewMethodSet(types.NewPointer(typ))
+	for _, name := range []string{"MarshalBorsh", "BinarySize", "UnmarshalBorsh"} {
+		if methods.Lookup(typ.Obj().Pkg(), name) == nil {
+			cg.failResolve(fmt.Errorf("recursive type %s must be a struct or implement MarshalBorsh, BinarySize and UnmarshalBorsh", typ.Obj().Name()))
+			break
+		}
+	}
+	this := ResolvedTypeInfo{
+		TypeName:       cg.typeExpr(typ),
+		FullTypeName:   typ.Obj().Pkg().Path() + "." + typ.Obj().Name(),
+		IsStruct:       true,
+		UnderlyingType: typ,
+	}
+	currentPath := append(append([]ResolvedTypeInfo{}, parentTypes...), this)
+	info := this
+	info.TypesTree = &currentPath
+	return &info
+}
+
+// resolveMapInfo resolves a map type t with underlying map m.
+// The key gets a TypesTree of its own while the value continues the current path
+func (cg *CodeGenerator) resolveMapInfo(t types.Type, m *types.Map, pkg *packages.Package, parentTypes []ResolvedTypeInfo) *ResolvedTypeInfo {
+	key := cg.resolveTypeInfo(m.Key(), pkg, nil)
+	if key == nil {
+		return nil
+	}
+	this := ResolvedTypeInfo{
+		TypeName:       cg.typeExpr(t),
+		IsMap:          true,
+		UnderlyingType: m,
+		KeyTree:        key.TypesTree,
+		KeyTypeName:    cg.typeExpr(m.Key()),
+		ValueTypeName:  cg.typeExpr(m.Elem()),
+	}
+	info := &ResolvedTypeInfo{
+		TypeName:       this.TypeName,
+		IsMap:          true,
+		UnderlyingType: t,
+		ElementType:    cg.cleanPackagePath(m.Elem().String()),
+	}
+	currentPath := append(append([]ResolvedTypeInfo{}, parentTypes...), this)
+	if isEmptyStruct(m.Elem()) {
+		// Sets have no value to encode
+		currentPath[len(currentPath)-1].IsSet = true
+		info.IsSet = true
+		info.TypesTree = &currentPath
+		return info
+	}
+	child := cg.resolveTypeInfo(m.Elem(), pkg, currentPath)
+	if child == nil {
+		return nil
+	}
+	currentPath = *child.TypesTree
+	info.Element = child
+	info.TypesTree = &currentPath
+	return info
+}
+
+// isEmptyStruct reports whether t is struct{} or a named type of it
+func isEmptyStruct(t types.Type) bool {
+	s, ok := t.Underlying().(*types.Struct)
+	return ok && s.NumFields() == 0
+}
+
+// containsMap reports whether t is or holds a map
+func containsMap(t types.Type) bool {
+	switch typ := t.(type) {
+	case *types.Map:
+		return true
+	case *types.Named:
+		_, ok := typ.Underlying().(*types.Map)
+		return ok
+	case *types.Pointer:
+		return containsMap(typ.Elem())
+	case *types.Slice:
+		return containsMap(typ.Elem())
+	case *types.Array:
+		return containsMap(typ.Elem())
+	}
+	return false
+}
+
+// hasTypeArgs reports whether t is or holds an instantiated generic type
+func hasTypeArgs(t types.Type) bool {
+	switch typ := t.(type) {
+	case *types.Named:
+		return typ.TypeArgs().Len() > 0
+	case *types.Map:
+		return hasTypeArgs(typ.Key()) || hasTypeArgs(typ.Elem())
+	case *types.Pointer:
+		return hasTypeArgs(typ.Elem())
+	case *types.Slice:
+		return hasTypeArgs(typ.Elem())
+	case *types.Array:
+		return hasTypeArgs(typ.Elem())
+	}
+	return false
+}
+
+// addTypePackages adds the packages of the named types in t to the imports of the generated file
+func (cg *CodeGenerator) addTypePackages(t types.Type) {
+	switch typ := t.(type) {
+	case *types.Named:
+		obj := typ.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() != cg.rootPackage && !generatedImports[obj.Pkg().Path()] {
+			cg.mu.Lock()
+			if !slices.ContainsFunc(cg.packages, func(p Package) bool {
+				return strings.EqualFold(p.Package, obj.Pkg().Path())
+			}) {
+				cg.packages = append(cg.packages, Package{
+					Package:    obj.Pkg().Path(),
+					CustomType: obj.Pkg().Name() + "." + obj.Name(),
+				})
+			}
+			cg.mu.Unlock()
+		}
+		if m, ok := typ.Underlying().(*types.Map); ok {
+			cg.addTypePackages(m)
+		}
+		for i := 0; i < typ.TypeArgs().Len(); i++ {
+			cg.addTypePackages(typ.TypeArgs().At(i))
+		}
+	case *types.Map:
+		cg.addTypePackages(typ.Key())
+		cg.addTypePackages(typ.Elem())
+	case *types.Pointer:
+		cg.addTypePackages(typ.Elem())
+	case *types.Slice:
+		cg.addTypePackages(typ.Elem())
+	case *types.Array:
+		cg.addTypePackages(typ.Elem())
+	}
+}
+
+// typeExpr returns t as a Go type expression valid in the generated package
+func (cg *CodeGenerator) typeExpr(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p.Path() == cg.rootPackage {
+			return ""
+		}
+		return p.Name()
+	})
+}
+
 func (cg *CodeGenerator) cleanPackagePath(s string) string {
 	s = strings.ReplaceAll(s, cg.options.PackageName+".", "")
 	lastBracket := strings.LastIndex(s, "]")
@@ -1094,9 +1674,9 @@ func (fi *FieldInfo) KnownImportedType() string {
 
 	// Add known types that you want to handle specially
 	knownTypes := map[string]string{
-		"time.Time":                   "struct",
-		"github.com/google/uuid.UUID": "[16]byte",
-		"encoding/json.RawMessage":    "[]byte",
+		"time.Time":                "struct",
+		googleUUIDTypeName:         "[16]byte",
+		"encoding/json.RawMessage": "[]byte",
 		// Add more as needed
 	}
 
@@ -1112,7 +1692,7 @@ func (fi *FieldInfo) GetMarshalCode(varName string) (string, bool) {
 	switch fi.Element.FullTypeName {
 	case "time.Time":
 		return fmt.Sprintf("binary.LittleEndian.PutUint64(buf[offset:], uint64(%s.Unix()))", varName), true
-	case "github.com/google/uuid.UUID", "encoding/json.RawMessage":
+	case googleUUIDTypeName, "encoding/json.RawMessage":
 		return fmt.Sprintf("copy(buf[offset:], %s[:])", varName), true
 
 	default:
@@ -1130,13 +1710,6 @@ func (resolvedType *ResolvedTypeInfo) assignCustomElementEncoder(_fieldType stri
 		resolvedType.CustomElementEncoder = "_DefaultByteArrayEncoder"
 		resolvedType.Element = &ResolvedTypeInfo{TypeName: "[]byte", IsBasicType: false}
 		resolvedType.IsCustomElementEncoder = true
-	case "time.Time":
-		resolvedType.TypeName = "uint64"
-		resolvedType.CustomTypeName = prefix + "time.Time"
-		resolvedType.CustomElementEncoder = "_CustomTimeTimeEncoder"
-		resolvedType.Element = &ResolvedTypeInfo{TypeName: "time.Time", IsBasicType: true}
-		resolvedType.IsCustomElementEncoder = true
-
 	case "json.RawMessage", "*json.RawMessage":
 
 		resolvedType.TypeName = "json.RawMessage"
@@ -1144,21 +1717,6 @@ func (resolvedType *ResolvedTypeInfo) assignCustomElementEncoder(_fieldType stri
 		resolvedType.CustomElementEncoder = "_DefaultJsonRawMessageEncoder"
 		resolvedType.Element = &ResolvedTypeInfo{TypeName: "[]byte", UnderlyingType: types.NewArray(types.Typ[types.Byte], 16), IsBasicType: false, Element: &ResolvedTypeInfo{TypeName: "byte", UnderlyingType: types.Typ[types.Byte], IsBasicType: true}}
 		resolvedType.IsCustomElementEncoder = true
-	case "uuid.UUID":
-		resolvedType.TypeName = "[16]byte"
-		resolvedType.CustomTypeName = prefix + "uuid.UUID"
-		resolvedType.CustomElementEncoder = "_CustomUuidUUIDEncoder"
-		resolvedType.Element = &ResolvedTypeInfo{
-			TypeName:       "[16]byte",
-			UnderlyingType: types.NewArray(types.Typ[types.Byte], 16),
-			IsBasicType:    false,
-			Element: &ResolvedTypeInfo{
-				TypeName:       "byte",
-				UnderlyingType: types.Typ[types.Byte],
-				IsBasicType:    true,
-			},
-		}
-		resolvedType.IsCustomElementEncoder = true
 	default:
 		return fmt.Errorf("unsupported custom encoder type: %s", resolvedType.CustomTypeName)
 	}
@@ -1167,6 +1725,184 @@ func (resolvedType *ResolvedTypeInfo) assignCustomElementEncoder(_fieldType stri
 
 }
 
+// customDecoded is the expression that converts v, decoded by the custom element encoder of shape, to its TypeName.
+// shape is a *ResolvedTypeInfo or a dict built from one
+func customDecoded(shape any, v string) string {
+	var typeName, decodedTypeName string
+	switch s := shape.(type) {
+	case *ResolvedTypeInfo:
+		typeName, decodedTypeName = s.TypeName, s.DecodedTypeName
+	case map[string]interface{}:
+		typeName, _ = s["TypeName"].(string)
+		decodedTypeName, _ = s["DecodedTypeName"].(string)
+	}
+	if decodedTypeName == "" {
+		return fmt.Sprintf("(%s).(%s)", v, typeName)
+	}
+	return fmt.Sprintf("%s((%s).(%s))", typeName, v, decodedTypeName)
+}
+
+// typeParamElementType is the element type of fields typed by a type parameter of a generic struct
+const typeParamElementType = "param"
+
+// assignTypeParam marks a type parameter node as a scalar encoded by the generated param helpers
+func (resolvedType *ResolvedTypeInfo) assignTypeParam() bool {
+	if _, ok := resolvedType.UnderlyingType.(*types.TypeParam); !ok {
+		return false
+	}
+	resolvedType.ElementType = typeParamElementType
+	resolvedType.IsBasicType = true
+	resolvedType.IsStruct = false
+	resolvedType.PointerDeref = ""
+	resolvedType.PointerRef = ""
+	if resolvedType.IsPointer {
+		// An option *T holds the value of the type argument, which may itself be a pointer
+		resolvedType.PointerDeref = "*"
+		resolvedType.PointerRef = "&"
+	}
+	return true
+}
+
+// timeEncoders maps the tag modifiers that select the precision of a time.Time field to their encoders
+var timeEncoders = map[string]string{
+	"unix":      "_CustomTimeUnixEncoder",      // i64 seconds
+	"unixmilli": "_CustomTimeUnixMilliEncoder", // i64 milliseconds
+	"unixnano":  "_CustomTimeUnixNanoEncoder",  // i64 nanoseconds
+}
+
+// Element types of the fixed-width scalars that are not Go basic types. time.Time nodes whose tag
+// selects a precision are timeElementType followed by the modifier, e.g. time_unixmilli
+const (
+	timeElementType = "time" // i64 seconds and u32 nanoseconds
+	uuidElementType = "uuid" // the 16 bytes of a UUID
+)
+
+// timeEncoder returns the encoder generated code writes and reads a time.Time of elementType with,
+// or "" when elementType is not a time
+func timeEncoder(elementType string) string {
+	if elementType == timeElementType {
+		return "_CustomTimeTimeEncoder"
+	}
+	if modifier, ok := strings.CutPrefix(elementType, timeElementType+"_"); ok {
+		return timeEncoders[modifier]
+	}
+	return ""
+}
+
+// assignTimeType marks a time.Time node as a scalar of the precision selected in the tag
+func (resolvedType *ResolvedTypeInfo) assignTimeType(wireType string) bool {
+	if resolvedType.FullTypeName != "time.Time" {
+		return false
+	}
+	resolvedType.ElementType = timeElementType
+	if timeEncoders[wireType] != "" {
+		resolvedType.ElementType += "_" + wireType
+	}
+	resolvedType.assignFixedScalar()
+	return true
+}
+
+// googleUUIDTypeName is the UUID of github.com/google/uuid
+const googleUUIDTypeName = "github.com/google/uuid.UUID"
+
+// isUUID reports whether the type fullName names, e.g. github.com/google/uuid.UUID, is a UUID:
+// the UUID of github.com/google/uuid, or a type of any other package named UUID that is a [16]byte
+func isUUID(fullName string, underlying types.Type) bool {
+	if fullName == googleUUIDTypeName {
+		return true
+	}
+	if !strings.HasSuffix(fullName, ".UUID") {
+		return false
+	}
+	array, ok := underlying.Underlying().(*types.Array)
+	if !ok || array.Len() != 16 {
+		return false
+	}
+	elem, ok := array.Elem().Underlying().(*types.Basic)
+	return ok && elem.Kind() == types.Byte
+}
+
+// assignUUIDType marks a UUID node as a 16-byte scalar
+func (resolvedType *ResolvedTypeInfo) assignUUIDType() bool {
+	if resolvedType.UnderlyingType == nil || !isUUID(resolvedType.FullTypeName, resolvedType.UnderlyingType) {
+		return false
+	}
+	resolvedType.ElementType = uuidElementType
+	resolvedType.assignFixedScalar()
+	return true
+}
+
+// assignFixedScalar marks a time or UUID node as a value written in place. An option such as
+// *time.Time keeps its pointer
+func (resolvedType *ResolvedTypeInfo) assignFixedScalar() {
+	resolvedType.IsBasicType = true
+	resolvedType.IsStruct = false
+	resolvedType.IsSlice = false
+	resolvedType.IsFixedArray = false
+	resolvedType.PointerDeref = ""
+	resolvedType.PointerRef = ""
+	if resolvedType.IsPointer {
+		resolvedType.PointerDeref = "*"
+		resolvedType.PointerRef = "&"
+	}
+}
+
+// setModifier is the tag modifier that makes a slice a set, e.g. `msg:"members,set"`
+const setModifier = "set"
+
+// 128-bit integer types and the element types the templates encode them with
+const (
+	bigIntTypeName  = "math/big.Int"
+	uint128TypeName = "github.com/mlayerprotocol/go-borshgen/borsh.Uint128"
+	int128TypeName  = "github.com/mlayerprotocol/go-borshgen/borsh.Int128"
+
+	u128ElementType    = "u128"    // *big.Int written as a Borsh u128
+	i128ElementType    = "i128"    // *big.Int written as a Borsh i128
+	uint128ElementType = "uint128" // borsh.Uint128, already in u128 layout
+	int128ElementType  = "int128"  // borsh.Int128, already in i128 layout
+)
+
+func isInt128ElementType(elementType string) bool {
+	switch elementType {
+	case u128ElementType, i128ElementType, uint128ElementType, int128ElementType:
+		return true
+	}
+	return false
+}
+
+// assignInt128Type marks big.Int, borsh.Uint128 and borsh.Int128 nodes as 16-byte scalars.
+// wireType is the Borsh type from the field tag and selects u128 or i128 for big.Int
+func (resolvedType *ResolvedTypeInfo) assignInt128Type(wireType string) (bool, error) {
+	switch resolvedType.FullTypeName {
+	case bigIntTypeName:
+		if !resolvedType.IsPointer {
+			return false, fmt.Errorf("big.Int must be used as *big.Int")
+		}
+		switch wireType {
+		case "", u128ElementType:
+			resolvedType.ElementType = u128ElementType
+		case i128ElementType:
+			resolvedType.ElementType = i128ElementType
+		default:
+			return false, fmt.Errorf("unsupported wire type %q for *big.Int", wireType)
+		}
+	case uint128TypeName:
+		resolvedType.ElementType = uint128ElementType
+	case int128TypeName:
+		resolvedType.ElementType = int128ElementType
+	default:
+		return false, nil
+	}
+	resolvedType.IsBasicType = true
+	resolvedType.IsStruct = false
+	resolvedType.IsSlice = false
+	resolvedType.IsFixedArray = false
+	resolvedType.IsPointer = false
+	resolvedType.PointerDeref = ""
+	resolvedType.PointerRef = ""
+	return true, nil
+}
+
 func getBaseFieldInfo(r *ResolvedTypeInfo) *ResolvedTypeInfo {
 	if r == nil {
 		return r
@@ -1190,7 +1926,22 @@ func (cg *CodeGenerator) extractFieldInfo(name string, field *ast.Field, actualT
 	fieldInfo.ShouldIgnore = shouldIgnore
 	fieldInfo.HasEncTag = hasEncTag
 	fieldInfo.EncType = encType
-
+	fieldInfo.MaxLen = parseMaxTag(name, field)
+	if !options.IsBorshWire() {
+		fieldInfo.MaxLen = min(fieldInfo.MaxLen, legacyMaxLength)
+	}
+
+	if customFieldEncoder == u128ElementType || customFieldEncoder == i128ElementType || timeEncoders[customFieldEncoder] != "" {
+		// Borsh integer width or time precision rather than an encoder
+		fieldInfo.WireType = customFieldEncoder
+		customFieldEncoder = ""
+	} else if customFieldEncoder == setModifier {
+		fieldInfo.IsSet = true
+		customFieldEncoder = ""
+	} else if customFieldEncoder == nestedModifier {
+		// The embedded struct is encoded as any other field
+		customFieldEncoder = ""
+	}
 	if len(customFieldEncoder) > 0 {
 		if !strings.HasPrefix(customFieldEncoder, "[]") && !strings.HasPrefix(customFieldEncoder, "[][]") {
 			fieldInfo.IsCustomType = true
@@ -1235,7 +1986,6 @@ func (cg *CodeGenerator) extractFieldInfo(name string, field *ast.Field, actualT
 
 		fieldInfo.IsBasicType = isBasicType(t.Name) || isBasicType(customFieldEncoder) || isBasicType(actualType)
 
-		fieldInfo.CanZeroCopy = canFieldZeroCopy(t.Name)
 		if cg.structMap[t.Name] || customFieldEncoder == "struct" || customFieldEncoder == "bin" {
 			fieldInfo.IsStruct = true
 		}
@@ -1253,7 +2003,7 @@ func (cg *CodeGenerator) extractFieldInfo(name string, field *ast.Field, actualT
 	case *ast.MapType:
 
 		fieldInfo.IsMap = true
-		fieldInfo.TypeName = "map[string]interface{}"
+		fieldInfo.TypeName = types.ExprString(t)
 	case *ast.InterfaceType:
 
 		fieldInfo.IsInterface = true
@@ -1295,23 +2045,27 @@ func (cg *CodeGenerator) getCode(outputFile string) (header *bytes.Buffer, main
 		Package string
 		Options GeneratorOptions
 	}{
-		Package: cg.structs[0].Package,
+		Package: cg.packageName,
 		Options: cg.options,
 	}); err != nil {
 		return nil, nil, fmt.Errorf("failed to execute helper template: %v", err)
 	}
 	tmpl := cg.initTemplate()
-	if len(cg.structs) == 0 {
+	if len(cg.structs) == 0 && len(cg.enums) == 0 && len(cg.valueEnums) == 0 {
 		return header, main, fmt.Errorf("empty structs")
 	}
 	data := struct {
 		Package  string
 		Structs  []StructInfo
-		Packages []Package
-		Options  GeneratorOptions
+		Enums      []EnumInfo
+		ValueEnums []ValueEnumInfo
+		Packages   []Package
+		Options    GeneratorOptions
 	}{
-		Package:  cg.structs[0].Package,
-		Structs:  cg.structs,
+		Package:    cg.packageName,
+		Structs:    cg.structs,
+		Enums:      cg.enums,
+		ValueEnums: cg.valueEnums,
 		Options:  cg.options,
 		Packages: cg.packages,
 	}
@@ -1330,6 +2084,8 @@ func (cg *CodeGenerator) initTemplate() *template.Template {
 	tmpl = template.Must(tmpl.Funcs(templateFuncs).Parse(templates.MarshalBorshTemplate))
 	tmpl = template.Must(tmpl.Funcs(templateFuncs).Parse(templates.UnmarshalTemplate))
 	tmpl = template.Must(tmpl.Funcs(templateFuncs).Parse(templates.UnmarshalBorshTemplate))
+	tmpl = template.Must(tmpl.Funcs(templateFuncs).Parse(templates.EnumTemplate))
+	tmpl = template.Must(tmpl.Funcs(templateFuncs).Parse(templates.ValueEnumTemplate))
 	tmpl = template.Must(tmpl.Funcs(templateFuncs).Parse(mainTemplate))
 	return tmpl
 }
@@ -1365,20 +2121,16 @@ func (cg *CodeGenerator) generateCode(outputFile string) (err error) {
 		Package string
 		Options GeneratorOptions
 	}{
-		Package: cg.structs[0].Package,
+		Package: cg.packageName,
 		Options: cg.options,
 	}); err != nil {
 		return fmt.Errorf("failed to execute helper template: %v", err)
 	}
 
-	// copy the custom encoder file
+	// The default encoders now live in the borsh runtime, so remove the copy earlier versions wrote
 	encoderFile := filepath.Join(dir, "borshgen_custom_encoder_"+fmt.Sprint(xxhash.Sum64String(filepath.Base(dir))%10000000000)+"_gen.go")
-	str := string(customEncodersBytes)
-	ce := strings.Replace(str, "package generator", "package "+cg.structs[0].Package, 1)
-	ce = "// Code generated by bingen. DO NOT EDIT." + "\n" + ce
-	err = os.WriteFile(encoderFile, []byte(ce), 0644)
-	if err != nil {
-		return fmt.Errorf("failed to copy custom encoders: %v", err)
+	if err := os.Remove(encoderFile); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove %s: %v", encoderFile, err)
 	}
 	file, err := os.Create(outputFile)
 	if err != nil {
@@ -1386,18 +2138,22 @@ func (cg *CodeGenerator) generateCode(outputFile string) (err error) {
 	}
 	defer file.Close()
 
-	if len(cg.structs) == 0 {
+	if len(cg.structs) == 0 && len(cg.enums) == 0 && len(cg.valueEnums) == 0 {
 		return fmt.Errorf("empty structs")
 	}
 	// fmt.Println("OPTIONS", cg.options)
 	data := struct {
 		Package  string
 		Structs  []StructInfo
-		Packages []Package
-		Options  GeneratorOptions
+		Enums      []EnumInfo
+		ValueEnums []ValueEnumInfo
+		Packages   []Package
+		Options    GeneratorOptions
 	}{
-		Package:  cg.structs[0].Package,
-		Structs:  cg.structs,
+		Package:    cg.packageName,
+		Structs:    cg.structs,
+		Enums:      cg.enums,
+		ValueEnums: cg.valueEnums,
 		Options:  cg.options,
 		Packages: cg.packages,
 	}
@@ -1407,6 +2163,102 @@ func (cg *CodeGenerator) generateCode(outputFile string) (err error) {
 	return err
 }
 
+// generateTests writes the tests of the structs generated with -fuzz or -gen-tests to testFile,
+// or removes testFile when there are none. Generic structs are skipped, as a test needs a concrete type.
+// The TypeScript and Rust tests go to a crosslang file next to it, built only with -tags crosslang
+func (cg *CodeGenerator) generateTests(testFile string) error {
+	crossLangFile := strings.TrimSuffix(testFile, "_borshgen_test.go") + "_borshgen_crosslang_test.go"
+	var structs []StructInfo
+	genTests := false
+	for _, s := range cg.structs {
+		if (s.Options.Fuzz || s.Options.GenTests) && len(s.TypeParams) == 0 {
+			structs = append(structs, s)
+			genTests = genTests || s.Options.GenTests
+		}
+	}
+	if len(structs) == 0 {
+		for _, file := range []string{testFile, crossLangFile} {
+			if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to remove %s: %v", file, err)
+			}
+		}
+		return nil
+	}
+
+	// Random values of enum fields are drawn from the variants of every enum of the package
+	var enums []EnumInfo
+	for _, enum := range cg.enumMap {
+		enums = append(enums, enum)
+	}
+	sort.Slice(enums, func(i, j int) bool { return enums[i].Name < enums[j].Name })
+	// and fields typed by other interfaces from the structs of the file that implement them
+	enums = append(enums, cg.interfaceVariants()...)
+
+	// Structs with a TypeScript class also get their fixtures checked by base_borshgen.test.ts
+	var typeScript []string
+	for _, s := range structs {
+		if s.Options.GenTests && s.Options.HasLang(LangTypeScript) {
+			typeScript = append(typeScript, s.Name)
+		}
+	}
+	// and structs with a Rust struct by a crate built with cargo
+	var rust []string
+	for _, s := range structs {
+		if s.Options.GenTests && s.Options.HasLang(LangRust) && len(s.TypeParams) == 0 {
+			rust = append(rust, s.Name)
+		}
+	}
+	base := filepath.Base(strings.TrimSuffix(testFile, "_borshgen_test.go"))
+
+	data := struct {
+		Package  string
+		Structs  []StructInfo
+		Enums    []EnumInfo
+		GenTests bool
+		TypeScript     []string
+		TypeScriptBase string
+		TypeScriptTest string
+		Rust           []string
+	}{
+		Package:  cg.packageName,
+		Structs:  structs,
+		Enums:    enums,
+		GenTests: genTests,
+		TypeScript:     typeScript,
+		TypeScriptBase: base + "_borshgen",
+		TypeScriptTest: tsTestName(base),
+		Rust:           rust,
+	}
+
+	tmpl, err := template.New("tests").Parse(testTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse test template: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute test template: %v", err)
+	}
+	if err := os.WriteFile(testFile, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	if len(typeScript) == 0 && len(rust) == 0 {
+		if err := os.Remove(crossLangFile); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove %s: %v", crossLangFile, err)
+		}
+		return nil
+	}
+	tmpl, err = template.New("crosslang").Parse(crossLangTestTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse cross-language test template: %v", err)
+	}
+	buf.Reset()
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute cross-language test template: %v", err)
+	}
+	return os.WriteFile(crossLangFile, buf.Bytes(), 0644)
+}
+
 func (cg *CodeGenerator) sortEncFields(fields []FieldInfo) {
 	// Create a separate slice of enc fields for sorting
 	var encFields []FieldInfo
@@ -1436,8 +2288,29 @@ func (cg *CodeGenerator) sortEncFields(fields []FieldInfo) {
 
 }
 
+// newOptions builds generator options from the positional arguments of the Generate* entry points
+func newOptions(primaryTag, fallbackTag, encodeTag string, ignoreTag string, usePooling bool, maxStringLen int) GeneratorOptions {
+	return GeneratorOptions{
+		PrimaryTag:   primaryTag,
+		FallbackTag:  fallbackTag,
+		IgnoreTag:    ignoreTag,
+		UsePooling:   usePooling,
+		MaxStringLen: maxStringLen,
+		MaxSliceLen:  65535,
+		EncodeTag:    encodeTag,
+		Wire:         WireLegacy,
+		MaxDepth:     DefaultMaxDepth,
+		MaxAlloc:     DefaultMaxAlloc,
+	}
+}
+
 // Generate is the main entry point for code generation
 func GenerateDir(path, primaryTag, fallbackTag, encodeTag string, ignoreTag string, usePooling bool, maxStringLen int) error {
+	return GenerateDirWithOptions(path, newOptions(primaryTag, fallbackTag, encodeTag, ignoreTag, usePooling, maxStringLen))
+}
+
+// GenerateDirWithOptions generates code for every file in path using options as the base configuration
+func GenerateDirWithOptions(path string, options GeneratorOptions) error {
 	info, err := os.Stat(path)
 	if err != nil {
 		return fmt.Errorf("failed to stat path: %w", err)
@@ -1463,7 +2336,7 @@ func GenerateDir(path, primaryTag, fallbackTag, encodeTag string, ignoreTag stri
 			fmt.Println()
 			tmp := strings.TrimSuffix(p, ".go") + "_" + hex.EncodeToString(hash) + "_tmp_gen.go"
 			defer os.Remove(tmp)
-			err := Generate(p, tmp, primaryTag, fallbackTag, encodeTag,  ignoreTag, usePooling, maxStringLen)
+			err := GenerateWithOptions(p, tmp, options)
 			if err != nil {
 				fmt.Printf("CodeGentWarning: %v", err)
 				if !strings.Contains(err.Error(), "no structs found") {
@@ -1480,8 +2353,99 @@ func GenerateDir(path, primaryTag, fallbackTag, encodeTag string, ignoreTag stri
 	})
 }
 
+// sharedOptions are the options the helpers of borshgen_common_<hash>_gen.go are generated with.
+// That file is shared by every file of a package, so all structs of the package must agree on them
+var sharedOptions = []struct {
+	flag  string
+	value func(GeneratorOptions) string
+}{
+	{"-wire", func(o GeneratorOptions) string { return o.Wire }},
+	{"-strict", func(o GeneratorOptions) string { return strconv.FormatBool(o.Strict) }},
+	{"-max-depth", func(o GeneratorOptions) string { return strconv.Itoa(o.MaxDepth) }},
+	{"-max-alloc", func(o GeneratorOptions) string { return strconv.Itoa(o.MaxAlloc) }},
+}
+
+// filePosition formats p as file:line:column with the base name of the file,
+// or returns an empty string when p is unknown
+func filePosition(fset *token.FileSet, p token.Pos) string {
+	if fset == nil || !p.IsValid() {
+		return ""
+	}
+	pos := fset.Position(p)
+	pos.Filename = filepath.Base(pos.Filename)
+	return pos.String()
+}
+
+// positionError formats an error prefixed with the position p when it is known
+func positionError(fset *token.FileSet, p token.Pos, format string, args ...any) error {
+	if pos := filePosition(fset, p); pos != "" {
+		return fmt.Errorf("%s: %s", pos, fmt.Sprintf(format, args...))
+	}
+	return fmt.Errorf(format, args...)
+}
+
+// checkSharedOptions fails when two structs of the package, in the file being generated or any
+// other file, are generated with different values of a shared option
+func (cg *CodeGenerator) checkSharedOptions() error {
+	type directive struct {
+		name, pos string
+		options   GeneratorOptions
+	}
+	var structs []directive
+	if cg.pkg == nil {
+		// Parsed without type information: only the structs of this file are known
+		for _, s := range cg.structs {
+			structs = append(structs, directive{s.Name, s.Name, s.Options})
+		}
+	} else {
+		for _, file := range cg.pkg.Syntax {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					typeSpec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+					if _, ok := typeSpec.Type.(*ast.StructType); !ok {
+						continue
+					}
+					if found, options, err := structDirective(file, genDecl, typeSpec, cg.base); found {
+						pos := filePosition(cg.pkg.Fset, typeSpec.Pos())
+						if err != nil {
+							return fmt.Errorf("%s: %s: %v", pos, typeSpec.Name.Name, err)
+						}
+						structs = append(structs, directive{typeSpec.Name.Name, pos, options})
+					}
+				}
+			}
+		}
+	}
+	for _, s := range structs {
+		for _, option := range sharedOptions {
+			if want, got := option.value(structs[0].options), option.value(s.options); got != want {
+				return fmt.Errorf("%s: struct %s is generated with %s=%s, but %s at %s with %s=%s; %s must be the same for every struct of a package",
+					s.pos, s.name, option.flag, got, structs[0].name, structs[0].pos, option.flag, want, option.flag)
+			}
+		}
+	}
+	return nil
+}
+
 // Generate is the main entry point for code generation
 func GenerateFile(path, primaryTag, fallbackTag, encodeTag string, ignoreTag string, usePooling bool, maxStringLen int) error {
+	return GenerateFileWithOptions(path, newOptions(primaryTag, fallbackTag, encodeTag, ignoreTag, usePooling, maxStringLen))
+}
+
+// GenerateFileWithOptions generates code for a single file using options as the base configuration
+func GenerateFileWithOptions(path string, options GeneratorOptions) error {
+	// Generated files are named after the directory, which a relative path such as that of $GOFILE leaves out
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve path: %w", err)
+	}
 	info, err := os.Stat(path)
 	if err != nil {
 		return fmt.Errorf("failed to stat path: %w", err)
@@ -1498,7 +2462,7 @@ func GenerateFile(path, primaryTag, fallbackTag, encodeTag string, ignoreTag str
 	fmt.Println()
 	tmp := strings.TrimSuffix(path, ".go") + "_" + hex.EncodeToString(hash) + "_tmp_gen.go"
 	defer os.Remove(tmp)
-	err = Generate(path, tmp, primaryTag, fallbackTag, encodeTag, ignoreTag,  usePooling, maxStringLen)
+	err = GenerateWithOptions(path, tmp, options)
 	if err != nil {
 
 		if !strings.Contains(err.Error(), "no structs found") {
@@ -1571,36 +2535,63 @@ func trimFile(inputFile, outputFile string) error {
 
 // Generate is the main entry point for code generation
 func Generate(inputFile, outputFile, primaryTag, fallbackTag, encodeTag string, ignoreTag string, usePooling bool, maxStringLen int,) error {
+	return GenerateWithOptions(inputFile, outputFile, newOptions(primaryTag, fallbackTag, encodeTag, ignoreTag, usePooling, maxStringLen))
+}
+
+// GenerateWithOptions generates code for inputFile using options as the base configuration.
+// Options set in //go:generate borshgen directives override the base per struct.
+func GenerateWithOptions(inputFile, outputFile string, options GeneratorOptions) error {
 	if len(outputFile) == 0 {
 		outputFile = strings.TrimSuffix(inputFile, ".go") + "_gen.go"
 	}
+	primaryTag := options.PrimaryTag
+	fallbackTag := options.FallbackTag
+	ignoreTag := options.IgnoreTag
+	usePooling := options.UsePooling
 
-	cg := &CodeGenerator{}
-	cg.options = GeneratorOptions{
-		PrimaryTag:   primaryTag,
-		FallbackTag:  fallbackTag,
-		IgnoreTag:    ignoreTag,
-		UsePooling:   usePooling,
-		MaxStringLen: maxStringLen,
-		MaxSliceLen:  65535,
-		ZeroCopy:     false,
-		SafeMode:     true,
-		EncodeTag:    encodeTag,
+	wire, err := parseWire(options.Wire)
+	if err != nil {
+		return err
 	}
+	options.Wire = wire
 
-	err := cg.parseStructs(inputFile)
+	cg := &CodeGenerator{}
+	cg.options = options
+
+	err = cg.parseStructs(inputFile)
 	if err != nil {
 		return fmt.Errorf("error parsing structs: %v", err)
 	}
 
-	if len(cg.structs) == 0 {
+	if len(cg.structs) == 0 && len(cg.enums) == 0 && len(cg.valueEnums) == 0 {
 		return fmt.Errorf("no structs found with //go:generate borshgen comment")
 	}
 
+	// Length prefix helpers are shared by the whole package, so every struct must agree on the layout
+	if err := cg.checkSharedOptions(); err != nil {
+		return err
+	}
+
+	cg.describeStructs()
+
 	err = cg.generateCode(outputFile)
 	if err != nil {
 		return fmt.Errorf("error generating code: %v", err)
 	}
+	base := strings.TrimSuffix(inputFile, ".go")
+	if err := cg.generateTests(base + "_borshgen_test.go"); err != nil {
+		return fmt.Errorf("error generating tests: %v", err)
+	}
+	if err := cg.generateTypeScript(base); err != nil {
+		return fmt.Errorf("error generating TypeScript: %v", err)
+	}
+	if err := cg.generateRust(base); err != nil {
+		return fmt.Errorf("error generating Rust: %v", err)
+	}
+	// Tests were written to a separate fuzz file before -gen-tests
+	if err := os.Remove(base + "_borshgen_fuzz_test.go"); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove %s_borshgen_fuzz_test.go: %v", base, err)
+	}
 
 	fmt.Printf("Generated binary encoding code in %s\n", outputFile)
 	fmt.Printf("Found %d struct(s): ", len(cg.structs))
@@ -1620,6 +2611,10 @@ func Generate(inputFile, outputFile, primaryTag, fallbackTag, encodeTag string,
 	}
 	fmt.Printf("  Ignore value: %s\n", ignoreTag)
 	fmt.Printf("  Buffer pooling: %t\n", usePooling)
+	fmt.Printf("  Wire layout: %s\n", cg.options.Wire)
+	fmt.Printf("  Strict decoding: %t\n", cg.options.Strict)
+	fmt.Printf("  Max nesting depth: %d\n", cg.options.MaxDepth)
+	fmt.Printf("  Max decode allocation: %d\n", cg.options.MaxAlloc)
 
 	// Show field tag usage
 	for _, s := range cg.structs {
@@ -1644,7 +2639,9 @@ func Generate(inputFile, outputFile, primaryTag, fallbackTag, encodeTag string,
 	return nil
 }
 
-// GenerateWithZeroCopy is an enhanced version that supports zero-copy options
+// GenerateWithZeroCopy generates the code of the structs of inputFile into its _gen.go file.
+//
+// Deprecated: zero-copy views are not generated, so zeroCopy and safeMode are ignored
 func GenerateWithZeroCopy(inputFile, primaryTag, fallbackTag, ignoreTag string, usePooling, zeroCopy, safeMode bool, maxStringLen int) error {
 	outputFile := strings.TrimSuffix(inputFile, ".go") + "_gen.go"
 
@@ -1656,8 +2653,9 @@ func GenerateWithZeroCopy(inputFile, primaryTag, fallbackTag, ignoreTag string,
 		UsePooling:   usePooling,
 		MaxStringLen: maxStringLen,
 		MaxSliceLen:  65535,
-		ZeroCopy:     zeroCopy,
-		SafeMode:     safeMode,
+		Wire:         WireLegacy,
+		MaxDepth:     DefaultMaxDepth,
+		MaxAlloc:     DefaultMaxAlloc,
 	}
 
 	err := cg.parseStructs(inputFile)
@@ -1692,10 +2690,6 @@ func GenerateWithZeroCopy(inputFile, primaryTag, fallbackTag, ignoreTag string,
 	}
 	fmt.Printf("  Ignore value: %s\n", ignoreTag)
 	fmt.Printf("  Buffer pooling: %t\n", usePooling)
-	fmt.Printf("  Zero-copy mode: %t\n", zeroCopy)
-	if zeroCopy {
-		fmt.Printf("  Safe mode: %t\n", safeMode)
-	}
 
 	return nil
 }
@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// discriminatorDirective starts the encoding of a struct with constant bytes that identify it, as
+// Anchor does for accounts, events and instruction data. The Anchor kinds hash the struct name, or
+// the name after =, and an instruction is named after its struct without an Args suffix:
+//
+//	//borshgen:discriminator anchor-account
+//	//borshgen:discriminator anchor-instruction=make_offer
+//	//borshgen:discriminator hex=0badf00d
+const discriminatorDirective = "//borshgen:discriminator"
+
+// anchorNamespaces are the prefixes Anchor hashes with the name of an account, event or instruction
+var anchorNamespaces = map[string]string{
+	"anchor-account":     "account",
+	"anchor-event":       "event",
+	"anchor-instruction": "global",
+}
+
+// discriminatorField names the discriminator in schemas and in the Rust and TypeScript types
+const discriminatorField = "discriminator"
+
+// anchorDiscriminatorSize is the number of bytes of the sha256 Anchor keeps
+const anchorDiscriminatorSize = 8
+
+// parseDiscriminator returns the bytes a discriminator directive on the struct name gives
+func parseDiscriminator(line, name string) ([]byte, error) {
+	arg := strings.TrimSpace(strings.TrimPrefix(line, discriminatorDirective))
+	kind, value, hasValue := strings.Cut(arg, "=")
+	if kind == "hex" {
+		b, err := hex.DecodeString(value)
+		if err != nil || len(b) == 0 {
+			return nil, fmt.Errorf("invalid discriminator directive %q: expected hex=<bytes>", line)
+		}
+		return b, nil
+	}
+	namespace, ok := anchorNamespaces[kind]
+	if !ok {
+		return nil, fmt.Errorf("invalid discriminator directive %q: expected anchor-account, anchor-event, anchor-instruction or hex=<bytes>", line)
+	}
+	if !hasValue {
+		value = name
+		if kind == "anchor-instruction" {
+			value = snakeCase(strings.TrimSuffix(name, "Args"))
+		}
+	}
+	if value == "" || strings.ContainsAny(value, " \t") {
+		return nil, fmt.Errorf("invalid discriminator directive %q: expected a name after =", line)
+	}
+	sum := sha256.Sum256([]byte(namespace + ":" + value))
+	return sum[:anchorDiscriminatorSize], nil
+}
+
+// structDiscriminator returns the discriminator the doc comments of the struct name give it,
+// nil when they have no discriminator directive
+func structDiscriminator(name string, docs ...*ast.CommentGroup) ([]byte, error) {
+	var discriminator []byte
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, comment := range doc.List {
+			line := strings.TrimSpace(comment.Text)
+			if line != discriminatorDirective && !strings.HasPrefix(line, discriminatorDirective+" ") {
+				continue
+			}
+			if discriminator != nil {
+				return nil, fmt.Errorf("%s has more than one discriminator directive", name)
+			}
+			b, err := parseDiscriminator(line, name)
+			if err != nil {
+				return nil, err
+			}
+			discriminator = b
+		}
+	}
+	return discriminator, nil
+}
+
+// discriminatorOf returns the discriminator of the struct obj, which may be declared in another
+// package, or nil when it has none
+func (cg *CodeGenerator) discriminatorOf(obj *types.TypeName) ([]byte, error) {
+	_, docs, _, ok := cg.declarationOf(obj)
+	if !ok {
+		return nil, nil
+	}
+	return structDiscriminator(obj.Name(), docs...)
+}
+
+// discriminatorSource writes a discriminator as a Go byte slice literal
+func discriminatorSource(discriminator []byte) string {
+	return "[]byte{" + discriminatorList(discriminator) + "}"
+}
+
+// discriminatorList lists the bytes of a discriminator as they are written in Go, Rust and
+// TypeScript array literals, e.g. "0x01, 0x02"
+func discriminatorList(discriminator []byte) string {
+	parts := make([]string, len(discriminator))
+	for i, b := range discriminator {
+		parts[i] = fmt.Sprintf("0x%02x", b)
+	}
+	return strings.Join(parts, ", ")
+}
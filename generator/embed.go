@@ -0,0 +1,206 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+)
+
+// Tag modifiers that choose how an embedded struct is encoded, e.g. `msg:",inline"`.
+// Embedded fields are nested unless tagged inline
+const (
+	nestedModifier = "nested" // encode the embedded struct as a field named after its type
+	inlineModifier = "inline" // flatten its fields into the embedding struct
+)
+
+// EmbeddedPointer is a pointer embed that an inlined field is reached through
+type EmbeddedPointer struct {
+	Path     string // selector from the struct, e.g. Base or Base.Meta
+	TypeName string // type the pointer points to
+}
+
+// structField is a field of a generated struct before its encoding is resolved
+type structField struct {
+	name     string // selector from the struct; inlined fields are prefixed with their embeds, e.g. Base.Name
+	goName   string // field name used by Go's promotion rules
+	depth    int    // number of inline embeds the field is promoted through
+	field    *ast.Field
+	pos      token.Pos  // the field, or for promoted fields the inline embed of the struct
+	typ      types.Type // nil when the type did not resolve
+	pointers []EmbeddedPointer
+}
+
+// collectFields lists the fields of a struct in declaration order, flattening inline embeds.
+// A promoted field that Go shadows by a field of the same name at a shallower depth would not be
+// encoded, so it is an error, as are fields of the same name at the same depth, which are ambiguous
+func (cg *CodeGenerator) collectFields(list []*ast.Field, typeInfo *types.Info, options GeneratorOptions) ([]structField, error) {
+	fieldTypes := make([]types.Type, len(list))
+	if typeInfo != nil {
+		for i, field := range list {
+			if tv, ok := typeInfo.Types[field.Type]; ok {
+				fieldTypes[i] = tv.Type
+			}
+		}
+	}
+	return cg.visibleFields(list, fieldTypes, options)
+}
+
+// visibleFields is collectFields for fields whose types are already resolved, such as those of structFieldList
+func (cg *CodeGenerator) visibleFields(list []*ast.Field, fieldTypes []types.Type, options GeneratorOptions) ([]structField, error) {
+	fields, err := cg.flattenFields(list, fieldTypes, "", 0, token.NoPos, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first field of each name at the shallowest depth of that name, which shadows those deeper
+	shallowest := make(map[string]structField)
+	for _, f := range fields {
+		if s, ok := shallowest[f.goName]; !ok || f.depth < s.depth {
+			shallowest[f.goName] = f
+		}
+	}
+	for _, f := range fields {
+		switch s := shallowest[f.goName]; {
+		case f.depth > s.depth:
+			return nil, fmt.Errorf("field %s is shadowed by %s and would not be encoded; rename one of them or embed it nested", f.name, s.name)
+		case f.name != s.name:
+			return nil, fmt.Errorf("ambiguous field %s: promoted as %s and %s", f.goName, s.name, f.name)
+		}
+	}
+	return fields, nil
+}
+
+// flattenFields lists the fields of list with their types, recursing into inline embeds.
+// Promoted fields are placed at embedPos, as those built by structFieldList have no position
+func (cg *CodeGenerator) flattenFields(list []*ast.Field, fieldTypes []types.Type, prefix string, depth int, embedPos token.Pos, pointers []EmbeddedPointer, options GeneratorOptions) ([]structField, error) {
+	var fields []structField
+	for i, field := range list {
+		pos := embedPos
+		if depth == 0 {
+			pos = field.Type.Pos()
+		}
+		_, ignore, modifier, _, _ := cg.extractFieldTag(field, options)
+		if ignore {
+			continue
+		}
+		if len(field.Names) > 0 {
+			if modifier == inlineModifier || modifier == nestedModifier {
+				return nil, fmt.Errorf("%s%s: %s requires an embedded field", prefix, field.Names[0].Name, modifier)
+			}
+			for _, name := range field.Names {
+				namePos := pos
+				if depth == 0 {
+					namePos = name.Pos()
+				}
+				fields = append(fields, structField{
+					name:     prefix + name.Name,
+					goName:   name.Name,
+					depth:    depth,
+					field:    field,
+					pos:      namePos,
+					typ:      fieldTypes[i],
+					pointers: pointers,
+				})
+			}
+			continue
+		}
+
+		ident := embeddedName(field.Type)
+		if ident == nil {
+			return nil, fmt.Errorf("unsupported embedded field %s", types.ExprString(field.Type))
+		}
+		if modifier != inlineModifier {
+			fields = append(fields, structField{
+				name:     prefix + ident.Name,
+				goName:   ident.Name,
+				depth:    depth,
+				field:    field,
+				pos:      pos,
+				typ:      fieldTypes[i],
+				pointers: pointers,
+			})
+			continue
+		}
+
+		if fieldTypes[i] == nil {
+			return nil, fmt.Errorf("%s%s: cannot resolve the embedded type", prefix, ident.Name)
+		}
+		st, elem, isPointer := embeddedStruct(fieldTypes[i])
+		if st == nil {
+			return nil, fmt.Errorf("%s%s: %s requires an embedded struct", prefix, ident.Name, inlineModifier)
+		}
+		path := prefix + ident.Name
+		inner := pointers
+		if isPointer {
+			inner = append(append([]EmbeddedPointer{}, pointers...), EmbeddedPointer{
+				Path:     path,
+				TypeName: cg.typeExpr(elem),
+			})
+		}
+		innerList, innerTypes, err := cg.structFieldList(st, path)
+		if err != nil {
+			return nil, err
+		}
+		promoted, err := cg.flattenFields(innerList, innerTypes, path+".", depth+1, pos, inner, options)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, promoted...)
+	}
+	return fields, nil
+}
+
+// structFieldList builds the AST fields of st, which may be declared in another package
+func (cg *CodeGenerator) structFieldList(st *types.Struct, path string) ([]*ast.Field, []types.Type, error) {
+	list := make([]*ast.Field, 0, st.NumFields())
+	fieldTypes := make([]types.Type, 0, st.NumFields())
+	for i := 0; i < st.NumFields(); i++ {
+		v := st.Field(i)
+		if !v.Exported() && v.Pkg() != nil && v.Pkg().Path() != cg.rootPackage {
+			return nil, nil, fmt.Errorf("%s: cannot inline unexported field %s", path, v.Name())
+		}
+		expr, err := parser.ParseExpr(cg.typeExpr(v.Type()))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s.%s: %v", path, v.Name(), err)
+		}
+		field := &ast.Field{Type: expr}
+		if !v.Embedded() {
+			field.Names = []*ast.Ident{ast.NewIdent(v.Name())}
+		}
+		if tag := st.Tag(i); tag != "" {
+			field.Tag = &ast.BasicLit{Kind: token.STRING, Value: "`" + tag + "`"}
+		}
+		list = append(list, field)
+		fieldTypes = append(fieldTypes, v.Type())
+	}
+	return list, fieldTypes, nil
+}
+
+// embeddedName returns the implicit field name of an embedded type expression
+func embeddedName(expr ast.Expr) *ast.Ident {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t
+	case *ast.StarExpr:
+		return embeddedName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel
+	case *ast.IndexExpr:
+		return embeddedName(t.X)
+	case *ast.IndexListExpr:
+		return embeddedName(t.X)
+	}
+	return nil
+}
+
+// embeddedStruct returns the struct of an embedded type T or *T, and T
+func embeddedStruct(t types.Type) (st *types.Struct, elem types.Type, isPointer bool) {
+	elem = t
+	if p, ok := t.(*types.Pointer); ok {
+		elem, isPointer = p.Elem(), true
+	}
+	st, _ = elem.Underlying().(*types.Struct)
+	return st, elem, isPointer
+}
@@ -0,0 +1,374 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// enumDirective marks a Borsh enum. On an interface it lists the variant structs,
+// on a named integer type it makes the type's constants a C-style enum with an optional width:
+//
+//	//borshgen:enum Instruction = Transfer | Mint | Burn
+//	//borshgen:enum u16
+const enumDirective = "//borshgen:enum"
+
+// enumElementType is the element type templates use for fields holding a Borsh enum
+const enumElementType = "enum"
+
+// maxEnumVariants is the number of variants a 1-byte discriminant can address
+const maxEnumVariants = 256
+
+// EnumInfo describes a Borsh enum declared with a //borshgen:enum directive
+type EnumInfo struct {
+	Name     string
+	Variants []EnumVariant
+}
+
+// EnumVariant is one variant of a Borsh enum. Index is its discriminant
+type EnumVariant struct {
+	Name      string
+	Index     int
+	IsPointer bool // only *Name implements the enum interface
+}
+
+// parseEnumDirective splits "//borshgen:enum Name = A | B" into the enum and variant names
+func parseEnumDirective(line string) (name string, variants []string, err error) {
+	body := strings.TrimSpace(strings.TrimPrefix(line, enumDirective))
+	eq := strings.Index(body, "=")
+	if eq < 0 {
+		return "", nil, fmt.Errorf("invalid enum directive %q: expected Name = Variant | Variant", line)
+	}
+	name = strings.TrimSpace(body[:eq])
+	if !token.IsIdentifier(name) {
+		return "", nil, fmt.Errorf("invalid enum name %q", name)
+	}
+	seen := make(map[string]bool)
+	for _, v := range strings.Split(body[eq+1:], "|") {
+		v = strings.TrimSpace(v)
+		if !token.IsIdentifier(v) {
+			return "", nil, fmt.Errorf("invalid variant %q for enum %s", v, name)
+		}
+		if seen[v] {
+			return "", nil, fmt.Errorf("duplicate variant %s for enum %s", v, name)
+		}
+		seen[v] = true
+		variants = append(variants, v)
+	}
+	if len(variants) > maxEnumVariants {
+		return "", nil, fmt.Errorf("enum %s has %d variants, at most %d fit in a 1-byte discriminant", name, len(variants), maxEnumVariants)
+	}
+	return name, variants, nil
+}
+
+// resolveEnum checks the directive against the package types and records how each variant implements the interface
+func resolveEnum(pkg *packages.Package, name string, variantNames []string) (EnumInfo, error) {
+	enum := EnumInfo{Name: name}
+	obj := pkg.Types.Scope().Lookup(name)
+	if obj == nil {
+		return enum, fmt.Errorf("enum %s: type not found in package %s", name, pkg.Name)
+	}
+	iface, ok := obj.Type().Underlying().(*types.Interface)
+	if !ok {
+		return enum, fmt.Errorf("enum %s: %s is not an interface", name, obj.Type())
+	}
+	for i, variantName := range variantNames {
+		vobj := pkg.Types.Scope().Lookup(variantName)
+		if vobj == nil {
+			return enum, fmt.Errorf("enum %s: variant %s not found in package %s", name, variantName, pkg.Name)
+		}
+		if _, ok := vobj.Type().Underlying().(*types.Struct); !ok {
+			return enum, fmt.Errorf("enum %s: variant %s is not a struct", name, variantName)
+		}
+		variant := EnumVariant{Name: variantName, Index: i}
+		if !types.Implements(vobj.Type(), iface) {
+			if !types.Implements(types.NewPointer(vobj.Type()), iface) {
+				return enum, fmt.Errorf("enum %s: variant %s does not implement %s", name, variantName, name)
+			}
+			variant.IsPointer = true
+		}
+		enum.Variants = append(enum.Variants, variant)
+	}
+	return enum, nil
+}
+
+// collectEnums reads the enum directives of every file in pkg.
+// All enums are known for field resolution, only those declared in targetFile are generated
+func (cg *CodeGenerator) collectEnums(pkg *packages.Package, targetFile *ast.File) error {
+	cg.enumMap = make(map[string]EnumInfo)
+	cg.enums = nil
+	for _, file := range pkg.Syntax {
+		for _, commentGroup := range file.Comments {
+			for _, comment := range commentGroup.List {
+				line := strings.TrimSpace(comment.Text)
+				if !strings.HasPrefix(line, enumDirective+" ") || !strings.Contains(line, "=") {
+					continue
+				}
+				name, variants, err := parseEnumDirective(line)
+				if err != nil {
+					return err
+				}
+				enum, err := resolveEnum(pkg, name, variants)
+				if err != nil {
+					return err
+				}
+				fullName := pkg.PkgPath + "." + name
+				if _, ok := cg.enumMap[fullName]; ok {
+					return fmt.Errorf("enum %s is declared more than once", name)
+				}
+				cg.enumMap[fullName] = enum
+				if file == targetFile {
+					cg.enums = append(cg.enums, enum)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// isEnumType reports whether t is an interface declared as a Borsh enum
+func (cg *CodeGenerator) isEnumType(t *types.Named) bool {
+	obj := t.Obj()
+	if obj == nil || obj.Pkg() == nil {
+		return false
+	}
+	_, ok := cg.enumMap[obj.Pkg().Path()+"."+obj.Name()]
+	return ok
+}
+
+// assignEnumType marks a Borsh enum node as a scalar encoded by the generated enum helpers
+func (resolvedType *ResolvedTypeInfo) assignEnumType(enums map[string]EnumInfo) bool {
+	enum, ok := enums[resolvedType.FullTypeName]
+	if !ok {
+		return false
+	}
+	resolvedType.TypeName = enum.Name
+	resolvedType.ElementType = enumElementType
+	resolvedType.IsBasicType = true
+	resolvedType.IsStruct = false
+	resolvedType.IsSlice = false
+	resolvedType.IsFixedArray = false
+	resolvedType.IsPointer = false
+	resolvedType.PointerDeref = ""
+	resolvedType.PointerRef = ""
+	return true
+}
+
+// Widths a C-style enum can be encoded with. Borsh uses u8
+var valueEnumWidths = map[string]uint64{
+	"u8":  1<<8 - 1,
+	"u16": 1<<16 - 1,
+	"u32": 1<<32 - 1,
+}
+
+// ValueEnumInfo describes a C-style enum: a named integer type marked with //borshgen:enum
+// whose declared constants are the only valid values
+type ValueEnumInfo struct {
+	Name      string
+	Width     string // "u8", "u16" or "u32"
+	Constants []EnumConstant
+	HasString bool // String is already declared, e.g. by stringer
+}
+
+// EnumConstant is a named value of a C-style enum
+type EnumConstant struct {
+	Name  string
+	Value uint64
+}
+
+// ElementType is the element type templates use for fields of this enum
+func (e *ValueEnumInfo) ElementType() string {
+	return enumElementType + "_" + e.Width
+}
+
+// valueEnumWidth returns the width given to a //borshgen:enum directive on a type declaration.
+// ok is false when docs do not mark the type
+func valueEnumWidth(docs ...*ast.CommentGroup) (width string, ok bool, err error) {
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, comment := range doc.List {
+			line := strings.TrimSpace(comment.Text)
+			if line != enumDirective && !strings.HasPrefix(line, enumDirective+" ") {
+				continue
+			}
+			args := strings.Fields(strings.TrimPrefix(line, enumDirective))
+			switch {
+			case len(args) == 0:
+				return "u8", true, nil
+			case len(args) == 1 && valueEnumWidths[args[0]] > 0:
+				return args[0], true, nil
+			case strings.Contains(line, "="):
+				// interface enum, see collectEnums
+				continue
+			}
+			return "", false, fmt.Errorf("invalid enum directive %q: expected an optional width of u8, u16 or u32", line)
+		}
+	}
+	return "", false, nil
+}
+
+// findPackage returns pkg or the package among its dependencies with the given path
+func findPackage(pkg *packages.Package, path string) *packages.Package {
+	visited := make(map[string]bool)
+	var walk func(p *packages.Package) *packages.Package
+	walk = func(p *packages.Package) *packages.Package {
+		if p == nil || visited[p.PkgPath] {
+			return nil
+		}
+		visited[p.PkgPath] = true
+		if p.PkgPath == path {
+			return p
+		}
+		for _, imp := range p.Imports {
+			if found := walk(imp); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	return walk(pkg)
+}
+
+// lookupValueEnum returns the C-style enum with the given full type name, or nil if the type is not one
+func (cg *CodeGenerator) lookupValueEnum(fullTypeName string) (*ValueEnumInfo, error) {
+	if cached, ok := cg.valueEnumMap[fullTypeName]; ok {
+		return cached, nil
+	}
+	dot := strings.LastIndex(fullTypeName, ".")
+	if dot < 0 || cg.pkg == nil {
+		return nil, nil
+	}
+	declPkg := findPackage(cg.pkg, fullTypeName[:dot])
+	if declPkg == nil || declPkg.Types == nil {
+		return nil, nil
+	}
+	enum, err := buildValueEnum(declPkg, fullTypeName[dot+1:])
+	if err != nil {
+		return nil, err
+	}
+	cg.valueEnumMap[fullTypeName] = enum
+	return enum, nil
+}
+
+// buildValueEnum reads the directive and the constants of the named type in pkg.
+// It returns nil if the type is not marked as an enum
+func buildValueEnum(pkg *packages.Package, name string) (*ValueEnumInfo, error) {
+	var (
+		width  string
+		marked bool
+		err    error
+	)
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if typeSpec.Name.Name != name {
+					continue
+				}
+				docs := []*ast.CommentGroup{typeSpec.Doc}
+				if len(genDecl.Specs) == 1 {
+					docs = append(docs, genDecl.Doc)
+				}
+				if width, marked, err = valueEnumWidth(docs...); err != nil {
+					return nil, fmt.Errorf("enum %s: %v", name, err)
+				}
+			}
+		}
+	}
+	if !marked {
+		return nil, nil
+	}
+
+	obj, ok := pkg.Types.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("enum %s: type not found in package %s", name, pkg.Name)
+	}
+	basic, ok := obj.Type().Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsInteger == 0 {
+		return nil, fmt.Errorf("enum %s: %s is not an integer type", name, obj.Type().Underlying())
+	}
+
+	// Constants of the type in declaration order; aliases of an earlier value are skipped
+	var consts []*types.Const
+	for _, constName := range pkg.Types.Scope().Names() {
+		if c, ok := pkg.Types.Scope().Lookup(constName).(*types.Const); ok && types.Identical(c.Type(), obj.Type()) {
+			consts = append(consts, c)
+		}
+	}
+	sort.Slice(consts, func(i, j int) bool { return consts[i].Pos() < consts[j].Pos() })
+
+	enum := &ValueEnumInfo{Name: name, Width: width}
+	seen := make(map[uint64]bool)
+	for _, c := range consts {
+		if constant.Sign(c.Val()) < 0 {
+			return nil, fmt.Errorf("enum %s: constant %s = %s is negative, but Borsh enum discriminants are unsigned", name, c.Name(), c.Val())
+		}
+		value, exact := constant.Uint64Val(c.Val())
+		if !exact || value > valueEnumWidths[width] {
+			return nil, fmt.Errorf("enum %s: constant %s = %s does not fit in %s", name, c.Name(), c.Val(), width)
+		}
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		enum.Constants = append(enum.Constants, EnumConstant{Name: c.Name(), Value: value})
+	}
+	if len(enum.Constants) == 0 {
+		return nil, fmt.Errorf("enum %s: no constants of type %s declared", name, name)
+	}
+
+	// A String method from another generator is kept; ours is regenerated on every run
+	if fn, _, _ := types.LookupFieldOrMethod(obj.Type(), false, pkg.Types, "String"); fn != nil {
+		file := pkg.Fset.Position(fn.Pos()).Filename
+		enum.HasString = !strings.Contains(filepath.Base(file), "_borshgen_")
+	}
+	return enum, nil
+}
+
+// collectValueEnums builds the C-style enums declared in targetFile
+func (cg *CodeGenerator) collectValueEnums(pkg *packages.Package, targetFile *ast.File) error {
+	cg.valueEnums = nil
+	for _, decl := range targetFile.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			enum, err := cg.lookupValueEnum(pkg.PkgPath + "." + typeSpec.Name.Name)
+			if err != nil {
+				return err
+			}
+			if enum != nil {
+				cg.valueEnums = append(cg.valueEnums, *enum)
+			}
+		}
+	}
+	return nil
+}
+
+// assignValueEnumType marks a C-style enum node so templates encode it with the enum width and validate it
+func (cg *CodeGenerator) assignValueEnumType(resolvedType *ResolvedTypeInfo) (bool, error) {
+	if resolvedType.FullTypeName == "" || !resolvedType.IsBasicType {
+		return false, nil
+	}
+	enum, err := cg.lookupValueEnum(resolvedType.FullTypeName)
+	if err != nil || enum == nil {
+		return false, err
+	}
+	resolvedType.ElementType = enum.ElementType()
+	return true, nil
+}
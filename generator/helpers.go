@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -36,35 +37,35 @@ func MarshalBasicTypeFieldTemplate(f FieldInfo) string {
 	case "string":
 		return fmt.Sprintf(`
 		// Basictype Unmarshalling
-	if offset+2 > len(data) {
+	if offset+LengthPrefixSize > len(data) {
 		return  nil, fmt.Errorf("buffer too short for %s length")
 	}
-	length := binary.LittleEndian.Uint16(data[offset:offset+2])
-	offset += 2
-	if offset+int(length) > len(data) {
+	length := readLength(data[offset:])
+	offset += LengthPrefixSize
+	if offset+length > len(data) {
 		return nil, fmt.Errorf("buffer too short for %s data")
 	}
-	val := %s(string(data[offset:offset+int(length)]))
-	offset += int(length)
+	val := %s(string(data[offset:offset+length]))
+	offset += length
 	s.%s = %sval
 `, name, name, ctype, name, prefix)
 
 	case "[]byte":
 		return fmt.Sprintf(`
-	if offset+2 > len(data) {
+	if offset+LengthPrefixSize > len(data) {
 		return fmt.Errorf("buffer too short for %s length")
 	}
-	length := binary.LittleEndian.Uint16(data[offset:offset+2])
-	offset += 2
-	if offset+int(length) > len(data) {
+	length := readLength(data[offset:])
+	offset += LengthPrefixSize
+	if offset+length > len(data) {
 		return nil, fmt.Errorf("buffer too short for %s data")
 	}
-	val := %s(data[offset:offset+int(length)]) // Zero-copy assignment
-	offset += int(length)
+	val := %s(data[offset:offset+length]) // Zero-copy assignment
+	offset += length
 	s.%s = %sval
 `, name, name, ctype, name, prefix)
 
-	case "uint64", "int64", "int":
+	case "uint64", "int64", "int", "uint":
 		return fmt.Sprintf(`
 	if offset+8 > len(data) {
 		return  nil, fmt.Errorf("buffer too short for %s")
@@ -147,6 +148,12 @@ func UnmarshalBasicTypeFieldTemplate(f map[string]interface {}) string {
 		}
 	}
 	
+	// path is a Go expression for the FieldPath of errors, e.g. borsh.Index("Paths", i)
+	path := strconv.Quote(name)
+	if b, ok := f["Path"].(string); ok && b != "" {
+		path = b
+	}
+
 	typeName := ""
 	if f["TypeName"] != nil {
 		if  b, ok := f["TypeName"].(string); ok && b != "" {
@@ -159,6 +166,11 @@ func UnmarshalBasicTypeFieldTemplate(f map[string]interface {}) string {
 			elementType = b
 		}
 	}
+	// max is the limit set by the max:"N" tag of a top-level field, 0 for the package limit
+	max := 0
+	if b, ok := f["Max"].(int); ok {
+		max = b
+	}
 	varName := ""
 	if f["Var"] != nil {
 		if b, ok := f["Var"].(string); ok && b != "" {
@@ -200,107 +212,143 @@ func UnmarshalBasicTypeFieldTemplate(f map[string]interface {}) string {
 	case "string":
 		return fmt.Sprintf(`
 		// Basictype Unmarshalling
-	if offset+2 > len(data) {
-		return fmt.Errorf("buffer too short for %s length")
+	__s, err := readString(r, %s)
+	if err != nil {
+		return r.FieldError(%s, err)
 	}
-	length := binary.LittleEndian.Uint16(data[offset:offset+2])
-	offset += 2
-	if offset+int(length) > len(data) {
-		return fmt.Errorf("buffer too short for %s data")
-	}
-	__m := %s(string(data[offset:offset+int(length)]))
+	__m := %s(__s)
 	%s = %s(__m)
-	offset += int(length)
-`, name, name,  ctype, varName, prefix)
+`, lengthLimit(max, "MaxStringLen"), path, ctype, varName, prefix)
 
 	case "[]byte":
 		return fmt.Sprintf(`
-	if offset+2 > len(data) {
-		return fmt.Errorf("buffer too short for %s length")
-	}
-	length := binary.LittleEndian.Uint16(data[offset:offset+2])
-	offset += 2
-	if offset+int(length) > len(data) {
-		return fmt.Errorf("buffer too short for %s data")
+	__b, err := readByteSlice(r, %s)
+	if err != nil {
+		return r.FieldError(%s, err)
 	}
-	__m := %s(data[offset:offset+int(length)]) // Zero-copy assignment
+	__m := %s(__b)
 	%s = %s(__m)
-	offset += int(length)
 
-`, name, name, ctype, varName, prefix)
+`, lengthLimit(max, "MaxSliceLen"), path, ctype, varName, prefix)
 
-	case "uint64", "int64", "int":
+	case enumElementType:
 		return fmt.Sprintf(`
-	if offset+8 > len(data) {
-		return fmt.Errorf("buffer too short for %s")
+	%s, err = readEnum%s(r, depth+1)
+	if err != nil {
+		return r.FieldError(%s, err)
 	}
-	__m := %s(binary.LittleEndian.Uint64(data[offset:offset+8]))
-	%s = %s(__m)
-	offset += 8
-	
-`, name,  ctype, varName, prefix)
+`, varName, typeName, path)
 
-	case "uint32", "int32":
+	case typeParamElementType:
+		if isPointer {
+			return fmt.Sprintf(`
+	%s = new(%s)
+	err = readParam(r, %s, depth+1)
+	if err != nil {
+		return r.FieldError(%s, err)
+	}
+`, varName, typeName, varName, path)
+		}
 		return fmt.Sprintf(`
-	if offset+4 > len(data) {
-		return fmt.Errorf("buffer too short for %s")
+	err = readParam(r, &%s, depth+1)
+	if err != nil {
+		return r.FieldError(%s, err)
 	}
-	__m := %s(binary.LittleEndian.Uint32(data[offset:offset+4]))
-	%s = %s(__m)
-	offset += 4
-`, name,   ctype, varName, prefix)
+`, varName, path)
 
-	case "uint16", "int16":
+	case anyElementType:
+		if isPointer {
+			return fmt.Sprintf(`
+	%s = new(%s)
+	err = readAny(r, %s, depth+1)
+	if err != nil {
+		return r.FieldError(%s, err)
+	}
+`, varName, typeName, varName, path)
+		}
 		return fmt.Sprintf(`
-	if offset+2 > len(data) {
-		return fmt.Errorf("buffer too short for %s")
+	err = readAny(r, &%s, depth+1)
+	if err != nil {
+		return r.FieldError(%s, err)
+	}
+`, varName, path)
+
+	case enumElementType + "_u8", enumElementType + "_u16", enumElementType + "_u32":
+		read := "r.ReadByte()"
+		switch t {
+		case enumElementType + "_u16":
+			read = "r.ReadUint16()"
+		case enumElementType + "_u32":
+			read = "r.ReadUint32()"
+		}
+		return fmt.Sprintf(`
+	__v, err := %s
+	if err != nil {
+		return r.FieldError(%s, err)
+	}
+	__m := %s(__v)
+	if !__m.Valid() {
+		return r.FieldError(%s, fmt.Errorf("invalid %s value %%d", __m))
 	}
-	__m := %s(binary.LittleEndian.Uint16(data[offset:offset+2]))
 	%s = %s(__m)
-	offset += 2
-	
-`, name,  ctype, varName, prefix)
+`, read, path, ctype, path, name, varName, prefix)
 
-	case "uint8", "int8", "byte":
+	case "u128", "i128":
+		read := "r.ReadU128()"
+		if t == "i128" {
+			read = "r.ReadI128()"
+		}
 		return fmt.Sprintf(`
-	if offset+1 > len(data) {
-		return fmt.Errorf("buffer too short for %s")
+	%s, err = %s
+	if err != nil {
+		return r.FieldError(%s, err)
 	}
-	__m := %s(data[offset])
-	%s = %s(__m)
-	offset++
-	
-`, name,  ctype, varName, prefix)
-	case "float32":
+`, varName, read, path)
+
+	case timeElementType, timeElementType + "_unix", timeElementType + "_unixmilli", timeElementType + "_unixnano":
 		return fmt.Sprintf(`
-	if offset+4 > len(data) {
-		return fmt.Errorf("buffer too short for %s")
+	__v, err := %s.Read(r)
+	if err != nil {
+		return r.FieldError(%s, err)
 	}
-	__m := %s(math.Float32frombits(binary.LittleEndian.Uint32(data[offset:offset+4])))
-	%s = %s(__m)
-	offset += 4
-`, name,  ctype, varName,prefix)
+	%s = %s__v
+`, timeEncoder(t), path, varName, prefix)
 
-	case "float64":
+	case "uint128", "int128", uuidElementType:
 		return fmt.Sprintf(`
-	if offset+8 > len(data) {
-		return fmt.Errorf("buffer too short for %s")
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError(%s, err)
 	}
-	__m := %s(math.Float64frombits(binary.LittleEndian.Uint64(data[offset:offset+8])))
+	__m := %s{}
+	copy(__m[:], __b)
 	%s = %s(__m)
-	offset += 8
-	
-`, name,  ctype, varName, prefix )
-
-	case "bool":
+`, path, ctype, varName, prefix)
+
+	case "uint64", "int64", "int", "uint", "uint32", "int32", "uint16", "int16", "uint8", "int8", "byte", "float32", "float64", "bool":
+		read := "r.ReadUint64()"
+		switch t {
+		case "uint32", "int32":
+			read = "r.ReadUint32()"
+		case "uint16", "int16":
+			read = "r.ReadUint16()"
+		case "uint8", "int8", "byte":
+			read = "r.ReadByte()"
+		case "float32":
+			read = "r.ReadFloat32()"
+		case "float64":
+			read = "r.ReadFloat64()"
+		case "bool":
+			read = "r.ReadBool()"
+		}
 		return fmt.Sprintf(`
-	if offset >= len(data) {
-		return fmt.Errorf("buffer too short for %s")
+	__v, err := %s
+	if err != nil {
+		return r.FieldError(%s, err)
 	}
-	__m := %s(data[offset] != 0)
+	__m := %s(__v)
 	%s = %s(__m)
-	offset++
-`, name,  ctype, varName, prefix)
+`, read, path, ctype, varName, prefix)
 
 	default:
 		return fmt.Sprintf("// unsupported type: %s-%s\n", typeName, elementType)
@@ -309,6 +357,15 @@ func UnmarshalBasicTypeFieldTemplate(f map[string]interface {}) string {
 
 
 
+// lengthLimit returns the Go expression for the limit on a decoded length:
+// max when a max:"N" tag sets it, otherwise the package constant def
+func lengthLimit(max int, def string) string {
+	if max > 0 {
+		return strconv.Itoa(max)
+	}
+	return def
+}
+
 // Helper functions for appending data
 func AppendUint16(buf []byte, v uint16) []byte {
 	return append(buf, byte(v), byte(v>>8))
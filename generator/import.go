@@ -0,0 +1,747 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/templates"
+)
+
+// importPrimitives maps the Borsh primitives to the Go types written as them
+var importPrimitives = map[string]string{
+	"bool": "bool",
+	"u8":   "uint8", "u16": "uint16", "u32": "uint32", "u64": "uint64",
+	"i8": "int8", "i16": "int16", "i32": "int32", "i64": "int64",
+	"f32": "float32", "f64": "float64",
+	"u128": "borsh.Uint128", "i128": "borsh.Int128",
+}
+
+// valueEnumWidthsByTag is the C-style enum width of each tag width in bytes
+var valueEnumWidthsByTag = map[uint8]string{1: "u8", 2: "u16", 4: "u32"}
+
+// goInitialisms are the words written in capitals in Go names, e.g. owner_id as OwnerID
+var goInitialisms = map[string]bool{
+	"id": true, "api": true, "url": true, "uri": true, "uuid": true, "json": true,
+	"http": true, "ip": true, "rpc": true, "sql": true, "utf8": true, "ascii": true,
+}
+
+// schemaImporter converts the definitions of Borsh schemas into Go declarations
+type schemaImporter struct {
+	defs    map[string]borsh.Definition
+	aliases map[string]string   // declarations that name another, e.g. Anchor type aliases
+	docs    map[string][]string // doc lines by declaration, and by declaration.field for fields
+	roots   []string            // declarations imported even when nothing refers to them
+	skipped map[string]bool     // declarations left out, e.g. zero-copy Anchor accounts
+	// discriminators of Anchor accounts, events and instructions, by declaration
+	discriminators map[string]importedDiscriminator
+	nested         map[string]bool // declarations a field or an enum refers to
+
+	names  map[string]string // Go name of each declaration
+	taken  map[string]bool   // Go names in use
+	byDecl map[string]*importedType
+	// Types are the Go types in the order they are first referred to
+	Types   []*importedType
+	imports map[string]bool
+}
+
+// importedType is a Go type declared by borshgen import
+type importedType struct {
+	Name string
+	Doc  []string
+	// Kind is "struct", "enum" for an interface of variant structs, or "value" for a C-style enum
+	Kind       string
+	Fields     []importedField
+	Variants   []string
+	Width      string
+	Underlying string
+	Constants  []EnumConstant
+	// Discriminator is the argument of the discriminator directive of a struct, e.g. anchor-account
+	Discriminator string
+
+	decl string
+}
+
+// importedDiscriminator is the discriminator Anchor gives an account, event or instruction: the hash
+// of kind, the namespace of an Anchor directive kind, and name, unless bytes from the IDL differ
+type importedDiscriminator struct {
+	kind, name string
+	bytes      []byte
+}
+
+// importedField is a field of an imported struct
+type importedField struct {
+	Name string
+	Type string
+	Tag  string
+	Doc  []string
+}
+
+func newSchemaImporter() *schemaImporter {
+	return &schemaImporter{
+		defs:    make(map[string]borsh.Definition),
+		aliases: make(map[string]string),
+		skipped: make(map[string]bool),
+
+		discriminators: make(map[string]importedDiscriminator),
+		nested:         make(map[string]bool),
+		docs:           make(map[string][]string),
+		names:          make(map[string]string),
+		taken:          make(map[string]bool),
+		byDecl:         make(map[string]*importedType),
+		imports:        make(map[string]bool),
+	}
+}
+
+// ImportOptions configures borshgen import
+type ImportOptions struct {
+	Output  string // Go file to write, by default the schema file with a .go extension
+	Package string // package of the Go file, by default that of the files next to it
+}
+
+// Import writes the Go declarations of the types of a schema file, and generates their codecs on
+// the borsh wire with options. The file holds the JSON of a Borsh schema container as Rust's
+// BorshSchemaContainer and borshgen schema write it, an array of them, or an Anchor IDL
+func Import(schemaFile string, importOptions ImportOptions, options GeneratorOptions) error {
+	data, err := os.ReadFile(schemaFile)
+	if err != nil {
+		return err
+	}
+	im, err := parseImport(data)
+	if err != nil {
+		return fmt.Errorf("%s: %v", schemaFile, err)
+	}
+	for _, root := range im.roots {
+		if im.skipped[root] {
+			continue
+		}
+		if _, err := im.named(root); err != nil {
+			return fmt.Errorf("%s: %v", schemaFile, err)
+		}
+	}
+	if len(im.Types) == 0 {
+		return fmt.Errorf("%s: no structs or enums to import", schemaFile)
+	}
+	if err := im.setDiscriminators(); err != nil {
+		return fmt.Errorf("%s: %v", schemaFile, err)
+	}
+
+	output := importOptions.Output
+	if output == "" {
+		base := strings.SplitN(filepath.Base(schemaFile), ".", 2)[0]
+		output = filepath.Join(filepath.Dir(schemaFile), strings.ReplaceAll(base, "-", "_")+".go")
+	}
+	pkg := importOptions.Package
+	if pkg == "" {
+		if pkg, err = packageOf(filepath.Dir(output), output); err != nil {
+			return err
+		}
+	}
+	options.Wire = WireBorsh
+
+	source, err := filepath.Rel(filepath.Dir(output), schemaFile)
+	if err != nil {
+		source = schemaFile
+	}
+	source = filepath.ToSlash(source)
+	flags := importFlags(options)
+	command := source + " -o=" + filepath.Base(output)
+	if importOptions.Package != "" {
+		command += " -package=" + pkg
+	}
+	if options.PrimaryTag != "msg" {
+		command += " -tag=" + options.PrimaryTag
+	}
+
+	imports := make([]string, 0, len(im.imports))
+	for path := range im.imports {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("import").Parse(templates.ImportTemplate))
+	if err := tmpl.Execute(&buf, map[string]any{
+		"Source":    filepath.Base(schemaFile),
+		"Command":   command + flags,
+		"Package":   pkg,
+		"Imports":   imports,
+		"Directive": "-tag=" + options.PrimaryTag + " -wire=borsh" + flags,
+		"Types":     im.Types,
+	}); err != nil {
+		return fmt.Errorf("failed to execute import template: %v", err)
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format imported code: %v\n%s", err, buf.Bytes())
+	}
+	if err := os.WriteFile(output, code, 0644); err != nil {
+		return err
+	}
+	return GenerateFileWithOptions(output, options)
+}
+
+// importFlags returns the options of an import that the imported structs are generated with
+func importFlags(options GeneratorOptions) string {
+	var flags string
+	if options.Strict {
+		flags += " -strict"
+	}
+	if options.Fuzz {
+		flags += " -fuzz"
+	}
+	if options.GenTests {
+		flags += " -gen-tests"
+	}
+	if len(options.Langs) > 0 {
+		flags += " -lang=" + strings.Join(options.Langs, ",")
+	}
+	return flags
+}
+
+// packageOf returns the package of the Go files in dir other than output, or a package named
+// after dir when there are none
+func packageOf(dir, output string) (string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || filepath.Clean(file) == filepath.Clean(output) {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+		if err == nil {
+			return f.Name.Name, nil
+		}
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, filepath.Base(abs))
+	if name == "" || !token.IsIdentifier(name) || token.IsKeyword(name) {
+		return "", fmt.Errorf("cannot name a package after %s, set -package=", dir)
+	}
+	return name, nil
+}
+
+// parseImport reads a schema container, an array of them, or an Anchor IDL
+func parseImport(data []byte) (*schemaImporter, error) {
+	im := newSchemaImporter()
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var schemas []borsh.Schema
+		if err := json.Unmarshal(data, &schemas); err != nil {
+			return nil, err
+		}
+		for _, schema := range schemas {
+			if err := im.addSchema(schema); err != nil {
+				return nil, err
+			}
+		}
+		return im, nil
+	}
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, err
+	}
+	if _, ok := keys["definitions"]; ok {
+		var schema borsh.Schema
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return nil, err
+		}
+		return im, im.addSchema(schema)
+	}
+	if _, ok := keys["instructions"]; ok {
+		return im, im.addAnchorIDL(data)
+	}
+	return nil, fmt.Errorf("expected a Borsh schema container with definitions, or an Anchor IDL with instructions")
+}
+
+// addSchema adds the definitions of a schema container, which must agree with those already added
+func (im *schemaImporter) addSchema(schema borsh.Schema) error {
+	decls := make([]string, 0, len(schema.Definitions))
+	for decl := range schema.Definitions {
+		decls = append(decls, decl)
+	}
+	sort.Strings(decls)
+	for _, decl := range decls {
+		if err := im.define(decl, schema.Definitions[decl]); err != nil {
+			return err
+		}
+	}
+	im.roots = append(im.roots, schema.Declaration)
+	return nil
+}
+
+// define adds the definition of decl. A declaration defined twice must be defined the same way
+func (im *schemaImporter) define(decl string, def borsh.Definition) error {
+	if prev, ok := im.defs[decl]; ok {
+		a, _ := json.Marshal(prev)
+		b, _ := json.Marshal(def)
+		if !bytes.Equal(a, b) {
+			return fmt.Errorf("conflicting definitions of %s: %s and %s", decl, a, b)
+		}
+		return nil
+	}
+	im.defs[decl] = def
+	return nil
+}
+
+// resolve follows aliases to the declaration they name
+func (im *schemaImporter) resolve(decl string) string {
+	for i := 0; i < len(im.aliases); i++ {
+		target, ok := im.aliases[decl]
+		if !ok {
+			break
+		}
+		decl = target
+	}
+	return decl
+}
+
+// goType returns the Go type of a declaration and the tag modifier and max:"N" limit it needs.
+// Options are only Go pointers in struct fields, since elsewhere pointers are not written with a flag
+func (im *schemaImporter) goType(decl string, field bool) (typ, modifier string, max uint64, err error) {
+	decl = im.resolve(decl)
+	def, ok := im.defs[decl]
+	if !ok {
+		if _, ok := importPrimitives[decl]; ok {
+			def = borsh.PrimitiveDef(primitiveSizes[decl])
+		} else {
+			return "", "", 0, fmt.Errorf("%s is not defined", decl)
+		}
+	}
+	switch {
+	case def.Primitive != nil:
+		typ, ok := importPrimitives[decl]
+		if !ok || primitiveSizes[decl] != *def.Primitive {
+			return "", "", 0, fmt.Errorf("primitive %s of %d bytes has no Go type", decl, *def.Primitive)
+		}
+		if strings.HasPrefix(typ, "borsh.") {
+			im.imports[runtimePackage] = true
+		}
+		return typ, "", 0, nil
+	case def.Sequence != nil:
+		return im.sequence(decl, *def.Sequence, field)
+	case def.Enum != nil:
+		if some, ok := optionOf(*def.Enum); ok {
+			if !field {
+				return "", "", 0, fmt.Errorf("%s: options are only supported as struct fields, borshgen writes pointers elsewhere without a flag", decl)
+			}
+			typ, modifier, max, err := im.goType(some, false)
+			if err != nil {
+				return "", "", 0, err
+			}
+			if strings.HasPrefix(typ, "*") {
+				return "", "", 0, fmt.Errorf("%s: nested options are not supported", decl)
+			}
+			return "*" + typ, modifier, max, nil
+		}
+	case def.Struct == nil && def.Tuple == nil:
+		return "", "", 0, fmt.Errorf("%s has an empty definition", decl)
+	case isTime(decl, def):
+		modifier := timeModifier(decl)
+		if modifier != "" && !field {
+			return "", "", 0, fmt.Errorf("%s: time precisions are only supported as struct fields", decl)
+		}
+		im.imports["time"] = true
+		return "time.Time", modifier, 0, nil
+	}
+	im.nested[decl] = true
+	name, err := im.named(decl)
+	return name, "", 0, err
+}
+
+// optionOf reports whether an enum is an Option, and returns the declaration of its value
+func optionOf(enum borsh.Enum) (string, bool) {
+	v := enum.Variants
+	if enum.TagWidth != 1 || len(v) != 2 || v[0].Discriminant != 0 || v[0].Name != "None" ||
+		v[0].Declaration != borsh.UnitDeclaration || v[1].Discriminant != 1 || v[1].Name != "Some" {
+		return "", false
+	}
+	return v[1].Declaration, true
+}
+
+// sequence returns the Go type of a sequence: a string, byte slice, time, slice, array, map or set
+func (im *schemaImporter) sequence(decl string, seq borsh.Sequence, field bool) (string, string, uint64, error) {
+	var max uint64
+	if seq.LengthWidth == 4 && seq.LengthRange.End < math.MaxUint32 && field {
+		max = seq.LengthRange.End
+	}
+	fixed := seq.LengthRange.Start == seq.LengthRange.End
+	head := decl
+	if i := strings.Index(decl, "<"); i >= 0 {
+		head = decl[:i]
+	}
+	head = head[strings.LastIndex(head, "::")+1:]
+	set := head == "BTreeSet" || head == "HashSet"
+	switch {
+	case seq.LengthWidth == 4 && seq.Elements == "u8" && decl == borsh.StringDeclaration:
+		return "string", "", max, nil
+	case seq.LengthWidth == 4 && seq.Elements == "u8" && !set:
+		return "[]byte", "", max, nil
+	case seq.LengthWidth == 0:
+		if !fixed {
+			return "", "", 0, fmt.Errorf("%s: a sequence without a length prefix must have a fixed length", decl)
+		}
+		elem, err := im.element(decl, seq.Elements)
+		if err != nil {
+			return "", "", 0, err
+		}
+		if elem == "uint8" {
+			elem = "byte"
+		}
+		return fmt.Sprintf("[%d]%s", seq.LengthRange.Start, elem), "", 0, nil
+	case seq.LengthWidth != 4:
+		return "", "", 0, fmt.Errorf("%s: length prefixes of %d bytes are not supported, borshgen writes u32", decl, seq.LengthWidth)
+	}
+
+	elements := im.resolve(seq.Elements)
+	if tuple := im.defs[elements].Tuple; tuple != nil && len(tuple.Elements) == 2 && (head == "BTreeMap" || head == "HashMap") {
+		key, err := im.element(decl, tuple.Elements[0])
+		if err != nil {
+			return "", "", 0, err
+		}
+		if !im.comparable(tuple.Elements[0], make(map[string]bool)) {
+			return "", "", 0, fmt.Errorf("%s: the key %s is not comparable in Go", decl, key)
+		}
+		value, err := im.element(decl, tuple.Elements[1])
+		if err != nil {
+			return "", "", 0, err
+		}
+		return "map[" + key + "]" + value, "", max, nil
+	}
+	elem, err := im.element(decl, seq.Elements)
+	if err != nil {
+		return "", "", 0, err
+	}
+	if set {
+		if im.comparable(seq.Elements, make(map[string]bool)) {
+			return "map[" + elem + "]struct{}", "", max, nil
+		}
+		// A slice tagged set is written as a set too
+		if !field {
+			return "", "", 0, fmt.Errorf("%s: the element %s is not comparable in Go", decl, elem)
+		}
+		return "[]" + elem, setModifier, max, nil
+	}
+	return "[]" + elem, "", max, nil
+}
+
+// element returns the Go type of the elements of a composite, which take no tag modifier
+func (im *schemaImporter) element(decl, elem string) (string, error) {
+	typ, modifier, _, err := im.goType(elem, false)
+	if err == nil && modifier != "" {
+		err = fmt.Errorf("%s: elements of %s are not supported", decl, elem)
+	}
+	return typ, err
+}
+
+// isTime reports whether decl is a time.Time as borshgen schema defines it
+func isTime(decl string, def borsh.Definition) bool {
+	if decl != timeDeclaration && timeModifier(decl) == "" {
+		return false
+	}
+	a, _ := json.Marshal(def)
+	b, _ := json.Marshal(timeDef(timeModifier(decl)))
+	return bytes.Equal(a, b)
+}
+
+// timeModifier returns the precision modifier of a time declaration written by borshgen schema
+func timeModifier(decl string) string {
+	for modifier, d := range timeDeclarations {
+		if d == decl {
+			return modifier
+		}
+	}
+	return ""
+}
+
+// comparable reports whether the Go type of a declaration can be a map key
+func (im *schemaImporter) comparable(decl string, seen map[string]bool) bool {
+	decl = im.resolve(decl)
+	if seen[decl] {
+		return true
+	}
+	seen[decl] = true
+	def := im.defs[decl]
+	switch {
+	case def.Sequence != nil:
+		if def.Sequence.LengthWidth == 0 {
+			return im.comparable(def.Sequence.Elements, seen)
+		}
+		return decl == borsh.StringDeclaration
+	case def.Tuple != nil:
+		for _, e := range def.Tuple.Elements {
+			if !im.comparable(e, seen) {
+				return false
+			}
+		}
+	case def.Struct != nil:
+		for _, f := range def.Struct.Fields {
+			if !im.comparable(f.Declaration, seen) {
+				return false
+			}
+		}
+	case def.Enum != nil:
+		if some, ok := optionOf(*def.Enum); ok {
+			return im.comparable(some, seen)
+		}
+	}
+	return true
+}
+
+// named returns the Go type declared for a struct, tuple or enum, declaring it on first use
+func (im *schemaImporter) named(decl string) (string, error) {
+	decl = im.resolve(decl)
+	if t, ok := im.byDecl[decl]; ok {
+		return t.Name, nil
+	}
+	def, ok := im.defs[decl]
+	if !ok {
+		return "", fmt.Errorf("%s is not defined", decl)
+	}
+	if def.Struct == nil && def.Tuple == nil && def.Enum == nil {
+		typ, _, _, err := im.goType(decl, false)
+		return typ, err
+	}
+	t := &importedType{Name: im.goName(decl), decl: decl, Doc: im.docs[decl], Kind: "struct"}
+	im.byDecl[decl] = t
+	im.Types = append(im.Types, t)
+	var err error
+	switch {
+	case def.Struct != nil:
+		err = im.structFields(t, def.Struct.Fields)
+	case def.Tuple != nil:
+		fields := make([]borsh.Field, len(def.Tuple.Elements))
+		for i, e := range def.Tuple.Elements {
+			fields[i] = borsh.Field{Name: fmt.Sprint(i), Declaration: e}
+		}
+		err = im.structFields(t, fields)
+	default:
+		err = im.enum(t, *def.Enum)
+	}
+	if len(t.Doc) == 0 {
+		t.Doc = []string{fmt.Sprintf("%s is %s", t.Name, describe(t.Kind, decl))}
+	}
+	return t.Name, err
+}
+
+func describe(kind, decl string) string {
+	switch kind {
+	case "enum":
+		return "the Borsh enum " + decl
+	case "value":
+		return "the C-style enum " + decl
+	}
+	return "the struct " + decl
+}
+
+// structFields adds the fields of a struct, in the order they are written
+func (im *schemaImporter) structFields(t *importedType, fields []borsh.Field) error {
+	seen := make(map[string]bool)
+	for _, f := range fields {
+		name := goFieldName(f.Name)
+		if seen[name] {
+			return fmt.Errorf("%s: fields %s collide as %s", t.decl, f.Name, name)
+		}
+		seen[name] = true
+		typ, modifier, max, err := im.goType(f.Declaration, true)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %v", t.decl, f.Name, err)
+		}
+		tag := fmt.Sprintf(`msg:"%s"`, f.Name)
+		if modifier != "" {
+			tag = fmt.Sprintf(`msg:"%s,%s"`, f.Name, modifier)
+		}
+		if max > 0 {
+			tag += fmt.Sprintf(` max:"%d"`, max)
+		}
+		t.Fields = append(t.Fields, importedField{Name: name, Type: typ, Tag: tag, Doc: im.docs[t.decl+"."+f.Name]})
+	}
+	return nil
+}
+
+// enum declares a C-style enum when every variant is a unit, and an interface of variant structs
+// otherwise, which borshgen numbers in order with a u8 tag
+func (im *schemaImporter) enum(t *importedType, enum borsh.Enum) error {
+	unit := true
+	for _, v := range enum.Variants {
+		def := im.defs[im.resolve(v.Declaration)]
+		if v.Declaration != borsh.UnitDeclaration && (def.Struct == nil || len(def.Struct.Fields) > 0) {
+			unit = false
+		}
+	}
+	if width, ok := valueEnumWidthsByTag[enum.TagWidth]; ok && unit && !enum.TagSigned && len(enum.Variants) > 0 {
+		t.Kind, t.Width, t.Underlying = "value", width, "uint"+strings.TrimPrefix(width, "u")
+		for _, v := range enum.Variants {
+			if v.Discriminant < 0 || uint64(v.Discriminant) > valueEnumWidths[width] {
+				return fmt.Errorf("%s: discriminant %d of %s does not fit in a %s", t.decl, v.Discriminant, v.Name, width)
+			}
+			name := goTypeName(v.Name)
+			if !strings.HasPrefix(name, t.Name) {
+				name = t.Name + name
+			}
+			if im.taken[name] {
+				return fmt.Errorf("%s: constant %s collides with another declaration", t.decl, name)
+			}
+			im.taken[name] = true
+			t.Constants = append(t.Constants, EnumConstant{Name: name, Value: uint64(v.Discriminant)})
+		}
+		return nil
+	}
+
+	t.Kind = "enum"
+	if enum.TagWidth != 1 || enum.TagSigned {
+		return fmt.Errorf("%s: enums with struct variants have a u8 tag in borshgen", t.decl)
+	}
+	if len(enum.Variants) > maxEnumVariants {
+		return fmt.Errorf("%s has %d variants, at most %d fit in a 1-byte discriminant", t.decl, len(enum.Variants), maxEnumVariants)
+	}
+	for i, v := range enum.Variants {
+		if v.Discriminant != int64(i) {
+			return fmt.Errorf("%s: variant %s has discriminant %d, borshgen numbers variants in order from 0", t.decl, v.Name, v.Discriminant)
+		}
+		decl := v.Declaration
+		if decl == borsh.UnitDeclaration {
+			// A unit variant is a struct without fields
+			decl = t.decl + goTypeName(v.Name)
+			if err := im.define(decl, borsh.StructDef()); err != nil {
+				return err
+			}
+		}
+		if im.defs[im.resolve(decl)].Struct == nil {
+			return fmt.Errorf("%s: variant %s is a %s, borshgen variants are structs", t.decl, v.Name, decl)
+		}
+		im.nested[im.resolve(decl)] = true
+		name, err := im.named(decl)
+		if err != nil {
+			return err
+		}
+		t.Variants = append(t.Variants, name)
+	}
+	return nil
+}
+
+// setDiscriminators gives the structs of Anchor accounts, events and instructions their discriminator
+// directive. A struct another type holds gets none, since it would write its discriminator there too
+func (im *schemaImporter) setDiscriminators() error {
+	for _, t := range im.Types {
+		d, ok := im.discriminators[t.decl]
+		if !ok || t.Kind != "struct" {
+			continue
+		}
+		if im.nested[t.decl] {
+			printWarning(fmt.Sprintf("not giving %s a discriminator, since other types hold it", t.Name))
+			continue
+		}
+		directive := d.kind
+		if d.name != t.Name && (d.kind != "anchor-instruction" || d.name != snakeCase(strings.TrimSuffix(t.Name, "Args"))) {
+			directive += "=" + d.name
+		}
+		hashed, err := parseDiscriminator(discriminatorDirective+" "+directive, t.Name)
+		if err != nil {
+			return fmt.Errorf("%s: %v", t.decl, err)
+		}
+		if d.bytes != nil && !bytes.Equal(d.bytes, hashed) {
+			directive = "hex=" + hex.EncodeToString(d.bytes)
+		}
+		t.Discriminator = directive
+	}
+	return nil
+}
+
+// goName names the Go type of a declaration after its last path segment, e.g. Header for
+// common::Header, with its type arguments, e.g. EnvelopeTransfer for Envelope<Transfer>.
+// A name already taken is made of every path segment instead
+func (im *schemaImporter) goName(decl string) string {
+	if name, ok := im.names[decl]; ok {
+		return name
+	}
+	head, args := decl, ""
+	if i := strings.IndexAny(decl, "<(["); i >= 0 {
+		head, args = decl[:i], decl[i:]
+	}
+	var short, full strings.Builder
+	if strings.HasPrefix(decl, "(") {
+		short.WriteString("Tuple")
+		full.WriteString("Tuple")
+	}
+	segments := identifiers(head)
+	for i, s := range segments {
+		if i == len(segments)-1 {
+			short.WriteString(goTypeName(s))
+		}
+		full.WriteString(goTypeName(s))
+	}
+	for _, s := range identifiers(args) {
+		short.WriteString(goTypeName(s))
+		full.WriteString(goTypeName(s))
+	}
+	name := short.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "T" + name
+	}
+	if im.taken[name] {
+		name = full.String()
+		if !unicode.IsLetter([]rune(name)[0]) {
+			name = "T" + name
+		}
+		for i, base := 2, name; im.taken[name]; i++ {
+			name = fmt.Sprint(base, i)
+		}
+	}
+	im.taken[name] = true
+	im.names[decl] = name
+	return name
+}
+
+// identifiers splits a declaration into its identifiers, e.g. [common Header] for common::Header
+func identifiers(decl string) []string {
+	return strings.FieldsFunc(decl, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+}
+
+// goTypeName converts a schema name in snake_case or camelCase to an exported Go name,
+// e.g. owner_id to OwnerID
+func goTypeName(name string) string {
+	var sb strings.Builder
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' || r == ' ' }) {
+		if goInitialisms[strings.ToLower(word)] {
+			sb.WriteString(strings.ToUpper(word))
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	return sb.String()
+}
+
+// goFieldName names the Go field of a schema field. Unnamed fields are F0, F1, ...
+func goFieldName(name string) string {
+	field := goTypeName(name)
+	if field == "" || !unicode.IsLetter([]rune(field)[0]) {
+		field = "F" + field
+	}
+	return field
+}
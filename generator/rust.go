@@ -0,0 +1,647 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/mlayerprotocol/go-borshgen/templates"
+)
+
+// rustRuntimeFile is the runtime every generated Rust module uses, written next to it
+const rustRuntimeFile = "borshgen.rs"
+
+// rustPrimitives maps Go basic types to Rust. Go's int is written as a uint64 and read as an
+// int64, which have the same bytes
+var rustPrimitives = map[types.BasicKind]string{
+	types.Bool:    "bool",
+	types.Int8:    "i8",
+	types.Int16:   "i16",
+	types.Int32:   "i32",
+	types.Int64:   "i64",
+	types.Int:     "i64",
+	types.Uint8:   "u8",
+	types.Uint16:  "u16",
+	types.Uint32:  "u32",
+	types.Uint64:  "u64",
+	types.Uint:    "u64",
+	types.Float32: "f32",
+	types.Float64: "f64",
+}
+
+// rustKeywords are the Rust keywords a field name can collide with
+var rustKeywords = map[string]bool{
+	"as": true, "async": true, "await": true, "break": true, "const": true, "continue": true,
+	"dyn": true, "else": true, "enum": true, "extern": true, "false": true, "fn": true, "for": true,
+	"if": true, "impl": true, "in": true, "let": true, "loop": true, "match": true, "mod": true,
+	"move": true, "mut": true, "pub": true, "ref": true, "return": true, "static": true,
+	"struct": true, "trait": true, "true": true, "type": true, "unsafe": true, "use": true,
+	"where": true, "while": true, "abstract": true, "become": true, "box": true, "do": true,
+	"final": true, "macro": true, "override": true, "priv": true, "try": true, "typeof": true,
+	"unsized": true, "virtual": true, "yield": true,
+}
+
+// rsValue is how a Go type is held in Rust
+type rsValue struct {
+	Type string
+	// plain reports whether the borsh derive writes the value as the Go code does. Other values
+	// are written through the runtime's Go trait with #[borsh(serialize_with = ...)]
+	plain  bool
+	params bool // the type mentions a type parameter of the struct
+}
+
+// rsField is a field of a generated Rust struct
+type rsField struct {
+	Name  string
+	Type  string
+	Attrs string // #[borsh(...)] attribute, empty when the derive writes the field as Go does
+}
+
+// rsStruct is the Rust struct of a Go struct
+type rsStruct struct {
+	Name       string
+	GoName     string
+	TypeParams []string
+	Fields     []rsField
+	Key        bool // used as a map key or set element, so it derives Ord
+	// Discriminator lists the bytes of the discriminator of the struct, e.g. "0x01, 0x02",
+	// written by a leading field of a type that serializes them
+	Discriminator    string
+	DiscriminatorLen int
+	structs          []*types.TypeName
+}
+
+// Generics returns the type parameters of the struct, e.g. <T>, or "" when it has none
+func (s *rsStruct) Generics() string {
+	if len(s.TypeParams) == 0 {
+		return ""
+	}
+	return "<" + strings.Join(s.TypeParams, ", ") + ">"
+}
+
+// rsEnum is a Borsh enum or a C-style enum declared in a generated Rust module
+type rsEnum struct {
+	Name     string
+	GoName   string
+	Repr     string    // integer type of a C-style enum, empty for a Borsh enum
+	Variants []rsConst // constants of a C-style enum or variants of a Borsh enum
+}
+
+type rsConst struct {
+	Name  string
+	Type  string // variant struct of a Borsh enum
+	Value uint64
+	Attrs string
+}
+
+// rustBuilder builds the Rust structs of the structs of a file and of every type they refer to,
+// following the same field order, tags and modifiers as the generated Go code
+type rustBuilder struct {
+	cg      *CodeGenerator
+	options GeneratorOptions
+	names   map[string]*types.TypeName
+	structs map[*types.TypeName]string
+	enums   map[*types.TypeName]string
+	pending []*types.TypeName
+	keys    map[*types.TypeName]bool // structs used as map keys or set elements
+
+	Structs []*rsStruct
+	Enums   []*rsEnum
+	// named non-struct types being resolved, to stop at types that contain themselves
+	resolving map[*types.TypeName]bool
+	// current is the struct being built, whose optional fields of structs that contain it are boxed
+	current *types.TypeName
+	// wire holds the options of the package of current, in whose layout its fields are written
+	wire GeneratorOptions
+	maps bool
+	sets bool
+}
+
+// generateRust writes the Rust structs of the structs generated with -lang=rust to
+// base_borshgen.rs, with borshgen.rs next to it. Stale files are removed when no struct has one
+func (cg *CodeGenerator) generateRust(base string) error {
+	rsFile := base + "_borshgen.rs"
+	var structs []StructInfo
+	for _, s := range cg.structs {
+		if s.Options.HasLang(LangRust) {
+			structs = append(structs, s)
+		}
+	}
+	if len(structs) == 0 {
+		if err := os.Remove(rsFile); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove %s: %v", rsFile, err)
+		}
+		return nil
+	}
+	if cg.pkg == nil || cg.pkg.Types == nil {
+		return fmt.Errorf("package types are not loaded")
+	}
+
+	b := &rustBuilder{
+		cg:        cg,
+		options:   structs[0].Options,
+		names:     make(map[string]*types.TypeName),
+		structs:   make(map[*types.TypeName]string),
+		enums:     make(map[*types.TypeName]string),
+		keys:      make(map[*types.TypeName]bool),
+		resolving: make(map[*types.TypeName]bool),
+	}
+	// Names the generated module uses itself
+	for _, name := range []string{"borsh", "borshgen", "BorshSerialize", "BorshDeserialize", "BTreeMap", "BTreeSet"} {
+		b.names[name] = nil
+	}
+	for _, s := range structs {
+		obj, ok := cg.pkg.Types.Scope().Lookup(s.Name).(*types.TypeName)
+		if !ok {
+			return fmt.Errorf("%s: type not found in package %s", s.Name, cg.pkg.Name)
+		}
+		b.structOf(obj)
+	}
+	built := make(map[*types.TypeName]*rsStruct)
+	for len(b.pending) > 0 {
+		obj := b.pending[0]
+		b.pending = b.pending[1:]
+		s, err := b.build(obj)
+		if err != nil {
+			return fmt.Errorf("%s: %v", obj.Name(), err)
+		}
+		built[obj] = s
+		b.Structs = append(b.Structs, s)
+	}
+	// Structs held by a map key or set element also need Ord
+	var mark func(obj *types.TypeName)
+	mark = func(obj *types.TypeName) {
+		s := built[obj]
+		if s == nil || s.Key {
+			return
+		}
+		s.Key = true
+		for _, dep := range s.structs {
+			mark(dep)
+		}
+	}
+	for obj := range b.keys {
+		mark(obj)
+	}
+
+	wire := "Legacy"
+	if b.options.IsBorshWire() {
+		wire = "Borsh"
+	}
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("rust").Parse(templates.RustTemplate))
+	if err := tmpl.Execute(&buf, map[string]any{
+		"Source":  filepath.Base(base) + ".go",
+		"Wire":    wire,
+		"Maps":    b.maps,
+		"Sets":    b.sets,
+		"Enums":   b.Enums,
+		"Structs": b.Structs,
+	}); err != nil {
+		return fmt.Errorf("failed to execute Rust template: %v", err)
+	}
+	if err := os.WriteFile(rsFile, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	runtime := filepath.Join(filepath.Dir(rsFile), rustRuntimeFile)
+	return os.WriteFile(runtime, []byte(templates.RustRuntime), 0644)
+}
+
+// rustField converts a Go field name to a Rust field name, e.g. ID to id and UserName to user_name
+func rustField(name string) string {
+	field := snakeCase(name)
+	switch {
+	case field == "self" || field == "super" || field == "crate":
+		field += "_"
+	case rustKeywords[field]:
+		field = "r#" + field
+	}
+	return field
+}
+
+// snakeCase converts a Go name to snake_case, e.g. MakeOffer to make_offer and URLPath to url_path
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// A capital starts a word after a lower case letter or digit, or before one
+			// that ends an initialism, as in URLPath
+			if i > 0 && (!unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) && runes[i-1] != '_' {
+				sb.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// rustVariant names the variant of a C-style enum constant, without the type name it starts with
+func rustVariant(enum, constant string) string {
+	name := strings.TrimPrefix(constant, enum)
+	if name == "" || !unicode.IsUpper([]rune(name)[0]) {
+		return constant
+	}
+	return name
+}
+
+// declare reserves a Rust name for obj. A name taken by another type is prefixed with the
+// package name of obj, e.g. CommonHeader
+func (b *rustBuilder) declare(obj *types.TypeName) string {
+	name := obj.Name()
+	for i := 0; ; i++ {
+		if prev, ok := b.names[name]; !ok || prev == obj {
+			b.names[name] = obj
+			return name
+		}
+		prefix := tsTestName(obj.Pkg().Name())
+		if i > 0 {
+			prefix += fmt.Sprint(i + 1)
+		}
+		name = prefix + obj.Name()
+	}
+}
+
+// structOf returns the Rust struct of a Go struct, declaring it on first use
+func (b *rustBuilder) structOf(obj *types.TypeName) string {
+	if name, ok := b.structs[obj]; ok {
+		return name
+	}
+	name := b.declare(obj)
+	b.structs[obj] = name
+	b.pending = append(b.pending, obj)
+	return name
+}
+
+// optionsOf returns the options of the directive of a struct, those of the file being generated
+// when it has none
+func (b *rustBuilder) optionsOf(obj *types.TypeName) GeneratorOptions {
+	if _, options, ok := b.cg.directiveOf(obj); ok {
+		return options
+	}
+	return b.options
+}
+
+// attrs returns the #[borsh(...)] attribute of a value the derive does not write as Go does
+// in the layout of options
+func (b *rustBuilder) attrs(v rsValue, params []string, options GeneratorOptions) string {
+	if v.plain {
+		return ""
+	}
+	wire := "legacy"
+	if options.IsBorshWire() {
+		wire = "borsh"
+	}
+	attrs := fmt.Sprintf(`serialize_with = "borshgen::serialize_%s", deserialize_with = "borshgen::deserialize_%s"`, wire, wire)
+	if v.params {
+		// The derive cannot tell which bounds the functions need
+		bounds := make([]string, len(params))
+		for i, p := range params {
+			bounds[i] = fmt.Sprintf("%s: borshgen::Go<borshgen::%s>", p, strings.Title(wire))
+		}
+		attrs += fmt.Sprintf(`, bound(serialize = %q, deserialize = %q)`, strings.Join(bounds, ", "), strings.Join(bounds, ", "))
+	}
+	return "#[borsh(" + attrs + ")]"
+}
+
+// build builds the struct of obj with its fields in encoding order
+func (b *rustBuilder) build(obj *types.TypeName) (*rsStruct, error) {
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("not a named struct type")
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct", named)
+	}
+	s := &rsStruct{Name: b.structs[obj], GoName: obj.Pkg().Name() + "." + obj.Name()}
+	discriminator, err := b.cg.discriminatorOf(obj)
+	if err != nil {
+		return nil, err
+	}
+	s.Discriminator = discriminatorList(discriminator)
+	s.DiscriminatorLen = len(discriminator)
+	// A struct of another package writes its fields in the layout of its own package
+	b.current, b.wire = obj, b.optionsOf(obj)
+	params := make(map[*types.TypeParam]rsValue)
+	for i := 0; i < named.TypeParams().Len(); i++ {
+		p := named.TypeParams().At(i)
+		s.TypeParams = append(s.TypeParams, p.Obj().Name())
+		// Type arguments are written as nested structs
+		params[p] = rsValue{Type: p.Obj().Name(), plain: b.wire.IsBorshWire(), params: true}
+	}
+
+	list, fieldTypes, err := b.cg.structFieldList(st, obj.Name())
+	if err != nil {
+		return nil, err
+	}
+	fields, err := b.cg.visibleFields(list, fieldTypes, b.wire)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		_, _, modifier, _, _ := b.cg.extractFieldTag(f.field, b.wire)
+		v, err := b.field(f.typ, modifier, params)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", obj.Name(), f.name, err)
+		}
+		s.Fields = append(s.Fields, rsField{Name: rustField(f.goName), Type: v.Type, Attrs: b.attrs(v, s.TypeParams, b.wire)})
+	}
+	for dep := range b.structs {
+		if dep != obj && b.refers(st, dep) {
+			s.structs = append(s.structs, dep)
+		}
+	}
+	return s, nil
+}
+
+// refers reports whether a field of st holds a value of the struct dep
+func (b *rustBuilder) refers(st *types.Struct, dep *types.TypeName) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		if reaches(st.Field(i).Type(), dep, make(map[types.Type]bool)) {
+			return true
+		}
+	}
+	return false
+}
+
+// reaches reports whether a value of type t can hold a value of the named type target
+func reaches(t types.Type, target *types.TypeName, seen map[types.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch t := types.Unalias(t).(type) {
+	case *types.Named:
+		if t.Origin().Obj() == target {
+			return true
+		}
+		if args := t.TypeArgs(); args != nil {
+			for i := 0; i < args.Len(); i++ {
+				if reaches(args.At(i), target, seen) {
+					return true
+				}
+			}
+		}
+		return reaches(t.Underlying(), target, seen)
+	case *types.Pointer:
+		return reaches(t.Elem(), target, seen)
+	case *types.Slice:
+		return reaches(t.Elem(), target, seen)
+	case *types.Array:
+		return reaches(t.Elem(), target, seen)
+	case *types.Map:
+		return reaches(t.Key(), target, seen) || reaches(t.Elem(), target, seen)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if reaches(t.Field(i).Type(), target, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// field resolves the type of a struct field, where a pointer is an option and the tag modifier
+// may replace the encoding of the type
+func (b *rustBuilder) field(t types.Type, modifier string, params map[*types.TypeParam]rsValue) (rsValue, error) {
+	elem, pointer := t, false
+	if p, ok := types.Unalias(t).(*types.Pointer); ok && !isBigInt(p.Elem()) {
+		elem, pointer = p.Elem(), true
+	}
+	var (
+		v   rsValue
+		err error
+	)
+	switch {
+	case modifier == "" || modifier == setModifier || modifier == nestedModifier ||
+		modifier == u128ElementType || modifier == i128ElementType || timeEncoders[modifier] != "" ||
+		strings.HasPrefix(modifier, "[]"):
+		v, err = b.value(elem, modifier, params)
+	case isBasicType(modifier):
+		// The field is converted to the basic type named in the tag
+		v, err = b.value(types.Universe.Lookup(modifier).Type(), "", nil)
+	default:
+		// Written by a custom field encoder, whose output Rust holds as bytes
+		v = b.opaque()
+	}
+	if err != nil || !pointer {
+		return v, err
+	}
+	if named, ok := types.Unalias(elem).(*types.Named); ok && b.current != nil && reaches(named, b.current, make(map[types.Type]bool)) {
+		v.Type = "Box<" + v.Type + ">"
+	}
+	v.Type = "Option<" + v.Type + ">"
+	return v, nil
+}
+
+// opaque is the output of a custom encoder or a json.RawMessage, written with a length prefix
+func (b *rustBuilder) opaque() rsValue {
+	return rsValue{Type: "Vec<u8>", plain: b.wire.IsBorshWire()}
+}
+
+// value resolves a type that is not a field. Pointers are written as the value they point to
+func (b *rustBuilder) value(t types.Type, modifier string, params map[*types.TypeParam]rsValue) (rsValue, error) {
+	borshWire := b.wire.IsBorshWire()
+	switch t := types.Unalias(t).(type) {
+	case *types.TypeParam:
+		v, ok := params[t]
+		if !ok {
+			return rsValue{}, fmt.Errorf("unbound type parameter %s", t)
+		}
+		return v, nil
+	case *types.Pointer:
+		return b.value(t.Elem(), modifier, params)
+	case *types.Named:
+		return b.named(t, modifier, params)
+	case *types.Basic:
+		if t.Kind() == types.String {
+			return rsValue{Type: "String", plain: borshWire}, nil
+		}
+		p, ok := rustPrimitives[t.Kind()]
+		if !ok {
+			return rsValue{}, fmt.Errorf("unsupported type %s", t)
+		}
+		// borsh rejects NaN, which Go writes
+		return rsValue{Type: p, plain: p != "f32" && p != "f64"}, nil
+	case *types.Slice:
+		if basic, ok := t.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte && modifier != setModifier {
+			return rsValue{Type: "Vec<u8>", plain: borshWire}, nil
+		}
+		elem, err := b.value(t.Elem(), modifier, params)
+		if err != nil {
+			return rsValue{}, err
+		}
+		// A slice tagged set keeps its order, which Go checks
+		return rsValue{Type: "Vec<" + elem.Type + ">", plain: borshWire && elem.plain, params: elem.params}, nil
+	case *types.Array:
+		if basic, ok := t.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte {
+			return rsValue{Type: fmt.Sprintf("[u8; %d]", t.Len()), plain: true}, nil
+		}
+		elem, err := b.value(t.Elem(), modifier, params)
+		if err != nil {
+			return rsValue{}, err
+		}
+		return rsValue{Type: fmt.Sprintf("[%s; %d]", elem.Type, t.Len()), plain: elem.plain, params: elem.params}, nil
+	case *types.Map:
+		key, err := b.value(t.Key(), "", params)
+		if err != nil {
+			return rsValue{}, err
+		}
+		if named, ok := types.Unalias(t.Key()).(*types.Named); ok {
+			if obj, ok := b.structOrigin(named); ok {
+				b.keys[obj] = true
+			}
+		}
+		if isEmptyStruct(t.Elem()) {
+			b.sets = true
+			return rsValue{Type: "BTreeSet<" + key.Type + ">", plain: borshWire && key.plain, params: key.params}, nil
+		}
+		value, err := b.value(t.Elem(), modifier, params)
+		if err != nil {
+			return rsValue{}, err
+		}
+		b.maps = true
+		return rsValue{
+			Type:   "BTreeMap<" + key.Type + ", " + value.Type + ">",
+			plain:  borshWire && key.plain && value.plain,
+			params: key.params || value.params,
+		}, nil
+	}
+	return rsValue{}, fmt.Errorf("unsupported type %s", t)
+}
+
+// structOrigin returns the generic or plain struct declaration of a named type
+func (b *rustBuilder) structOrigin(t *types.Named) (*types.TypeName, bool) {
+	if _, ok := t.Underlying().(*types.Struct); !ok {
+		return nil, false
+	}
+	return t.Origin().Obj(), true
+}
+
+// named resolves a named type: a Borsh enum, a type with a custom element encoder,
+// a C-style enum, a struct, or otherwise its underlying type
+func (b *rustBuilder) named(t *types.Named, modifier string, params map[*types.TypeParam]rsValue) (rsValue, error) {
+	obj := t.Obj()
+	var path string
+	if obj.Pkg() != nil {
+		path = obj.Pkg().Path()
+	}
+	fullName := path + "." + obj.Name()
+	if enum, ok := b.cg.enumMap[fullName]; ok {
+		name, err := b.enum(obj, enum)
+		if err != nil {
+			return rsValue{}, err
+		}
+		// The enum writes its variants in the layout of its own struct
+		return rsValue{Type: name, plain: true}, nil
+	}
+
+	switch {
+	case fullName == "time.Time":
+		if timeEncoders[modifier] != "" {
+			return rsValue{Type: "borshgen::TimeUnix", plain: true}, nil
+		}
+		return rsValue{Type: "borshgen::Time", plain: true}, nil
+	case fullName == bigIntTypeName:
+		if modifier == i128ElementType {
+			return rsValue{Type: "i128", plain: true}, nil
+		}
+		return rsValue{Type: "u128", plain: true}, nil
+	case fullName == runtimePackage+".Uint128":
+		return rsValue{Type: "u128", plain: true}, nil
+	case fullName == runtimePackage+".Int128":
+		return rsValue{Type: "i128", plain: true}, nil
+	case fullName == "encoding/json.RawMessage":
+		return b.opaque(), nil
+	case isUUID(fullName, t.Underlying()):
+		return rsValue{Type: "borshgen::Uuid", plain: true}, nil
+	}
+
+	valueEnum, err := b.cg.lookupValueEnum(fullName)
+	if err != nil {
+		return rsValue{}, err
+	}
+	if valueEnum != nil {
+		return rsValue{Type: b.valueEnum(obj, valueEnum), plain: true}, nil
+	}
+	if _, ok := t.Underlying().(*types.Struct); ok {
+		return b.structValue(t, params)
+	}
+	if b.resolving[obj] {
+		return rsValue{}, fmt.Errorf("%s contains itself and is encoded by its own methods", obj.Name())
+	}
+	b.resolving[obj] = true
+	defer delete(b.resolving, obj)
+	return b.value(t.Underlying(), modifier, params)
+}
+
+// structValue resolves a struct, which the legacy wire writes with a length prefix
+func (b *rustBuilder) structValue(t *types.Named, params map[*types.TypeParam]rsValue) (rsValue, error) {
+	name := b.structOf(t.Origin().Obj())
+	v := rsValue{Type: name, plain: b.wire.IsBorshWire()}
+	args := t.TypeArgs()
+	if args == nil || args.Len() == 0 {
+		return v, nil
+	}
+	typeArgs := make([]string, args.Len())
+	for i := 0; i < args.Len(); i++ {
+		arg, err := b.value(args.At(i), "", params)
+		if err != nil {
+			return rsValue{}, err
+		}
+		typeArgs[i] = arg.Type
+		v.params = v.params || arg.params
+	}
+	v.Type += "<" + strings.Join(typeArgs, ", ") + ">"
+	return v, nil
+}
+
+// enum declares a Borsh enum with a variant for each of its structs
+func (b *rustBuilder) enum(obj *types.TypeName, enum EnumInfo) (string, error) {
+	if name, ok := b.enums[obj]; ok {
+		return name, nil
+	}
+	name := b.declare(obj)
+	b.enums[obj] = name
+	e := &rsEnum{Name: name, GoName: obj.Pkg().Name() + "." + obj.Name()}
+	b.Enums = append(b.Enums, e)
+	for i, v := range enum.Variants {
+		variant, ok := obj.Pkg().Scope().Lookup(v.Name).(*types.TypeName)
+		if !ok {
+			return "", fmt.Errorf("enum %s: variant %s not found", enum.Name, v.Name)
+		}
+		if v.Index != i {
+			return "", fmt.Errorf("enum %s: variant %s has index %d, Rust enums number variants in order", enum.Name, v.Name, v.Index)
+		}
+		typ := b.structOf(variant)
+		// The variant is a nested struct, in the layout of the package of the enum
+		options := b.optionsOf(variant)
+		e.Variants = append(e.Variants, rsConst{Name: v.Name, Type: typ, Value: uint64(v.Index), Attrs: b.attrs(rsValue{plain: options.IsBorshWire()}, nil, options)})
+	}
+	return name, nil
+}
+
+// valueEnum declares a C-style enum with a variant for each of its constants
+func (b *rustBuilder) valueEnum(obj *types.TypeName, enum *ValueEnumInfo) string {
+	if name, ok := b.enums[obj]; ok {
+		return name
+	}
+	name := b.declare(obj)
+	b.enums[obj] = name
+	e := &rsEnum{Name: name, GoName: obj.Pkg().Name() + "." + obj.Name(), Repr: enum.Width}
+	constants := append([]EnumConstant(nil), enum.Constants...)
+	sort.SliceStable(constants, func(i, j int) bool { return constants[i].Value < constants[j].Value })
+	for _, c := range constants {
+		e.Variants = append(e.Variants, rsConst{Name: rustVariant(obj.Name(), c.Name), Value: c.Value})
+	}
+	b.Enums = append(b.Enums, e)
+	return name
+}
@@ -0,0 +1,556 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+// Declarations of the Go types Borsh has no name for: times and UUIDs, which are written in place,
+// and the values of custom element encoders, length-prefixed bytes
+const (
+	timeDeclaration  = "Time" // i64 seconds and u32 nanoseconds
+	uuidDeclaration  = "Uuid" // 16 bytes
+	bytesDeclaration = "Vec<u8>"
+)
+
+// timeDeclarations names the time.Time encodings selected by the precision modifiers, all an i64
+var timeDeclarations = map[string]string{
+	"unix":      "TimeUnix",
+	"unixmilli": "TimeUnixMilli",
+	"unixnano":  "TimeUnixNano",
+}
+
+// timeUnits names the i64 of each precision modifier
+var timeUnits = map[string]string{
+	"unix":      "seconds",
+	"unixmilli": "millis",
+	"unixnano":  "nanos",
+}
+
+// timeDef defines a time.Time of the precision selected by modifier as a struct of its fields
+func timeDef(modifier string) borsh.Definition {
+	if unit := timeUnits[modifier]; unit != "" {
+		return borsh.StructDef(borsh.Field{Name: unit, Declaration: "i64"})
+	}
+	return borsh.StructDef(borsh.Field{Name: "seconds", Declaration: "i64"}, borsh.Field{Name: "nanos", Declaration: "u32"})
+}
+
+// primitiveDeclarations maps Go basic types to the Borsh primitives they are written as
+var primitiveDeclarations = map[types.BasicKind]string{
+	types.Bool:    "bool",
+	types.Int8:    "i8",
+	types.Int16:   "i16",
+	types.Int32:   "i32",
+	types.Int64:   "i64",
+	types.Int:     "i64",
+	types.Uint8:   "u8",
+	types.Uint16:  "u16",
+	types.Uint32:  "u32",
+	types.Uint64:  "u64",
+	types.Uint:    "u64",
+	types.Float32: "f32",
+	types.Float64: "f64",
+}
+
+// primitiveSizes is the width in bytes of each Borsh primitive
+var primitiveSizes = map[string]uint8{
+	"bool": 1, "u8": 1, "i8": 1,
+	"u16": 2, "i16": 2,
+	"u32": 4, "i32": 4, "f32": 4,
+	"u64": 8, "i64": 8, "f64": 8,
+	"u128": 16, "i128": 16,
+	borsh.UnitDeclaration: 0,
+}
+
+// valueEnumTagWidths is the tag width in bytes of each C-style enum width
+var valueEnumTagWidths = map[string]uint8{"u8": 1, "u16": 2, "u32": 4}
+
+// schemaBuilder describes a struct and every type it refers to from the go/types model,
+// following the same field order, tags and modifiers as the generated code
+type schemaBuilder struct {
+	cg      *CodeGenerator
+	options GeneratorOptions
+	schema  *borsh.Schema
+	// type each struct and enum declaration was made for, to catch two types with the same name
+	declared map[string]types.Type
+	// named non-struct types being described, to stop at types that contain themselves
+	resolving map[*types.TypeName]bool
+}
+
+// structSchema describes the Borsh encoding of s as a BorshSchemaContainer
+func (cg *CodeGenerator) structSchema(s StructInfo) (*borsh.Schema, error) {
+	if !s.Options.IsBorshWire() {
+		return nil, fmt.Errorf("%s: schemas describe the borsh wire layout, not %s", s.Name, s.Options.Wire)
+	}
+	if len(s.TypeParams) > 0 {
+		return nil, fmt.Errorf("%s: a generic struct has a schema for each instantiation only", s.Name)
+	}
+	if cg.pkg == nil || cg.pkg.Types == nil {
+		return nil, fmt.Errorf("%s: package types are not loaded", s.Name)
+	}
+	obj, ok := cg.pkg.Types.Scope().Lookup(s.Name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("%s: type not found in package %s", s.Name, cg.pkg.Name)
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("%s: not a named struct type", s.Name)
+	}
+	b := &schemaBuilder{
+		cg:        cg,
+		options:   s.Options,
+		schema:    &borsh.Schema{Definitions: make(map[string]borsh.Definition)},
+		declared:  make(map[string]types.Type),
+		resolving: make(map[*types.TypeName]bool),
+	}
+	decl, err := b.declareStruct(named, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", s.Name, err)
+	}
+	b.schema.Declaration = decl
+	return b.schema, nil
+}
+
+// describeStructs sets the schema of every struct that can be described.
+// Those that cannot, e.g. because a field is encoded by its own methods, get no BorshSchema method
+func (cg *CodeGenerator) describeStructs() {
+	for i, s := range cg.structs {
+		if !s.Options.IsBorshWire() || len(s.TypeParams) > 0 {
+			continue
+		}
+		schema, err := cg.structSchema(s)
+		if err != nil {
+			printWarning(fmt.Sprintf("no BorshSchema for %v", err))
+			continue
+		}
+		cg.structs[i].Schema = schema
+	}
+}
+
+// define adds the definition of decl unless it is already defined
+func (b *schemaBuilder) define(decl string, def borsh.Definition) string {
+	if _, ok := b.schema.Definitions[decl]; !ok {
+		b.schema.Definitions[decl] = def
+	}
+	return decl
+}
+
+// reserve records that decl is made for t before its definition is built, so that types referring
+// to themselves stop there. A type named like one of another package is declared with its package
+// path, e.g. common::Header. It returns the declaration and whether it was already made for t
+func (b *schemaBuilder) reserve(decl string, t *types.Named) (string, bool) {
+	prev, ok := b.declared[decl]
+	switch {
+	case !ok:
+		b.declared[decl] = t
+		return decl, false
+	case sameDeclaration(prev, t):
+		return decl, true
+	case t.Obj().Pkg() != nil && !strings.Contains(decl, "::"):
+		return b.reserve(t.Obj().Pkg().Name()+"::"+decl, t)
+	}
+	// Same package name as well: fall back to the full path
+	return b.reserve(strings.ReplaceAll(t.Obj().Pkg().Path(), "/", "::")+"::"+t.Obj().Name(), t)
+}
+
+// sameDeclaration reports whether a and b are written the same way: the same type,
+// or instantiations of one generic type whose arguments differ only by pointers
+func sameDeclaration(a, b types.Type) bool {
+	if types.Identical(a, b) {
+		return true
+	}
+	na, ok1 := a.(*types.Named)
+	nb, ok2 := b.(*types.Named)
+	if !ok1 || !ok2 || na.Origin() != nb.Origin() || na.TypeArgs().Len() != nb.TypeArgs().Len() {
+		return false
+	}
+	for i := 0; i < na.TypeArgs().Len(); i++ {
+		if !sameDeclaration(elemOf(na.TypeArgs().At(i)), elemOf(nb.TypeArgs().At(i))) {
+			return false
+		}
+	}
+	return true
+}
+
+// elemOf returns the type a pointer points to, or t itself
+func elemOf(t types.Type) types.Type {
+	if p, ok := t.(*types.Pointer); ok {
+		return p.Elem()
+	}
+	return t
+}
+
+func (b *schemaBuilder) primitive(decl string) string {
+	return b.define(decl, borsh.PrimitiveDef(primitiveSizes[decl]))
+}
+
+// bytes declares a length-prefixed byte string
+func (b *schemaBuilder) bytes(decl string) string {
+	b.primitive("u8")
+	return b.define(decl, borsh.VecDef("u8"))
+}
+
+// isBigInt reports whether t is big.Int, which a *big.Int field writes as a 16-byte integer, with nil as zero
+func isBigInt(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path()+"."+named.Obj().Name() == bigIntTypeName
+}
+
+// declareStruct defines a named struct with its fields in encoding order.
+// subst maps the type parameters of an enclosing generic struct to their arguments
+func (b *schemaBuilder) declareStruct(t *types.Named, subst map[*types.TypeParam]types.Type) (string, error) {
+	decl := t.Obj().Name()
+	origin := t.Origin()
+	inner := make(map[*types.TypeParam]types.Type)
+	if args := t.TypeArgs(); args != nil && args.Len() > 0 {
+		names := make([]string, args.Len())
+		for i := 0; i < args.Len(); i++ {
+			arg := args.At(i)
+			if p, ok := arg.(*types.TypeParam); ok {
+				if arg, ok = subst[p]; !ok {
+					return "", fmt.Errorf("%s: unbound type parameter %s", t, p)
+				}
+			}
+			// A pointer type argument is written as the value it points to
+			if p, ok := arg.(*types.Pointer); ok {
+				arg = p.Elem()
+			}
+			name, err := b.declare(arg, "", nil)
+			if err != nil {
+				return "", err
+			}
+			names[i] = name
+			inner[origin.TypeParams().At(i)] = arg
+		}
+		decl += "<" + strings.Join(names, ", ") + ">"
+	}
+	decl, done := b.reserve(decl, t)
+	if done {
+		return decl, nil
+	}
+
+	st, ok := origin.Underlying().(*types.Struct)
+	if !ok {
+		return "", fmt.Errorf("%s is not a struct", t)
+	}
+	list, fieldTypes, err := b.cg.structFieldList(st, t.Obj().Name())
+	if err != nil {
+		return "", err
+	}
+	fields, err := b.cg.visibleFields(list, fieldTypes, b.options)
+	if err != nil {
+		return "", err
+	}
+	var defs []borsh.Field
+	discriminator, err := b.cg.discriminatorOf(t.Obj())
+	if err != nil {
+		return "", err
+	}
+	if discriminator != nil {
+		// The constant bytes are described as an array, which Rust declares for them too
+		n := uint64(len(discriminator))
+		b.primitive("u8")
+		decl := b.define(borsh.ArrayDeclaration(n, "u8"), borsh.ArrayDef(n, "u8"))
+		defs = append(defs, borsh.Field{Name: discriminatorField, Declaration: decl})
+	}
+	for _, f := range fields {
+		tag, _, modifier, _, _ := b.cg.extractFieldTag(f.field, b.options)
+		if tag == "" {
+			tag = strings.ToLower(f.goName)
+		}
+		fieldDecl, err := b.declareField(f.typ, modifier, inner)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %v", t.Obj().Name(), f.name, err)
+		}
+		defs = append(defs, borsh.Field{Name: tag, Declaration: fieldDecl})
+	}
+	b.schema.Definitions[decl] = borsh.StructDef(defs...)
+	return decl, nil
+}
+
+// declareField declares the type of a struct field, where a pointer is an option
+// and the tag modifier may replace the encoding of the type
+func (b *schemaBuilder) declareField(t types.Type, modifier string, subst map[*types.TypeParam]types.Type) (string, error) {
+	switch {
+	case modifier == "" || modifier == setModifier || modifier == nestedModifier ||
+		modifier == u128ElementType || modifier == i128ElementType || timeEncoders[modifier] != "" ||
+		strings.HasPrefix(modifier, "[]"):
+	case isBasicType(modifier):
+		// The field is converted to the basic type named in the tag
+		return b.declare(types.Universe.Lookup(modifier).Type(), "", nil)
+	default:
+		// Written by a custom field encoder as length-prefixed bytes
+		return b.bytes(bytesDeclaration), nil
+	}
+	if p, ok := types.Unalias(t).(*types.Pointer); ok && !isBigInt(p.Elem()) {
+		some, err := b.declare(p.Elem(), modifier, subst)
+		if err != nil {
+			return "", err
+		}
+		b.primitive(borsh.UnitDeclaration)
+		return b.define(borsh.OptionDeclaration(some), borsh.OptionDef(some)), nil
+	}
+	return b.declare(t, modifier, subst)
+}
+
+// declare declares the type of a value that is not a field. Pointers are written as the value they point to
+func (b *schemaBuilder) declare(t types.Type, modifier string, subst map[*types.TypeParam]types.Type) (string, error) {
+	switch t := types.Unalias(t).(type) {
+	case *types.TypeParam:
+		arg, ok := subst[t]
+		if !ok {
+			return "", fmt.Errorf("unbound type parameter %s", t)
+		}
+		if p, ok := arg.(*types.Pointer); ok {
+			arg = p.Elem()
+		}
+		return b.declare(arg, modifier, nil)
+	case *types.Pointer:
+		return b.declare(t.Elem(), modifier, subst)
+	case *types.Named:
+		return b.declareNamed(t, modifier, subst)
+	case *types.Basic:
+		if t.Kind() == types.String {
+			return b.bytes(borsh.StringDeclaration), nil
+		}
+		decl, ok := primitiveDeclarations[t.Kind()]
+		if !ok {
+			return "", fmt.Errorf("unsupported type %s", t)
+		}
+		return b.primitive(decl), nil
+	case *types.Slice:
+		if basic, ok := t.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte && modifier != setModifier {
+			break
+		}
+		elem, err := b.declare(t.Elem(), modifier, subst)
+		if err != nil {
+			return "", err
+		}
+		if modifier == setModifier {
+			return b.define(borsh.SetDeclaration(elem), borsh.VecDef(elem)), nil
+		}
+		return b.define(borsh.VecDeclaration(elem), borsh.VecDef(elem)), nil
+	case *types.Array:
+		elem, err := b.declare(t.Elem(), modifier, subst)
+		if err != nil {
+			return "", err
+		}
+		n := uint64(t.Len())
+		return b.define(borsh.ArrayDeclaration(n, elem), borsh.ArrayDef(n, elem)), nil
+	case *types.Map:
+		key, err := b.declare(t.Key(), "", subst)
+		if err != nil {
+			return "", err
+		}
+		if isEmptyStruct(t.Elem()) {
+			return b.define(borsh.SetDeclaration(key), borsh.VecDef(key)), nil
+		}
+		value, err := b.declare(t.Elem(), modifier, subst)
+		if err != nil {
+			return "", err
+		}
+		entry := b.define(borsh.TupleDeclaration(key, value), borsh.TupleDef(key, value))
+		return b.define(borsh.MapDeclaration(key, value), borsh.VecDef(entry)), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", t)
+	}
+	// Byte slices are written as one length-prefixed run of bytes
+	return b.bytes(bytesDeclaration), nil
+}
+
+// declareNamed declares a named type: a Borsh enum, a type with a custom element encoder,
+// a C-style enum, a struct, or otherwise its underlying type
+func (b *schemaBuilder) declareNamed(t *types.Named, modifier string, subst map[*types.TypeParam]types.Type) (string, error) {
+	obj := t.Obj()
+	var path string
+	if obj.Pkg() != nil {
+		path = obj.Pkg().Path()
+	}
+	fullName := path + "." + obj.Name()
+	if enum, ok := b.cg.enumMap[fullName]; ok {
+		return b.declareEnum(t, enum)
+	}
+
+	switch {
+	case fullName == "time.Time":
+		b.primitive("i64")
+		if decl := timeDeclarations[modifier]; decl != "" {
+			return b.define(decl, timeDef(modifier)), nil
+		}
+		b.primitive("u32")
+		return b.define(timeDeclaration, timeDef("")), nil
+	case fullName == bigIntTypeName:
+		if modifier == i128ElementType {
+			return b.primitive(i128ElementType), nil
+		}
+		return b.primitive(u128ElementType), nil
+	case fullName == runtimePackage+".Uint128":
+		return b.primitive(u128ElementType), nil
+	case fullName == runtimePackage+".Int128":
+		return b.primitive(i128ElementType), nil
+	case fullName == "encoding/json.RawMessage":
+		return b.bytes(bytesDeclaration), nil
+	case isUUID(fullName, t.Underlying()):
+		b.primitive("u8")
+		return b.define(uuidDeclaration, borsh.ArrayDef(16, "u8")), nil
+	}
+
+	valueEnum, err := b.cg.lookupValueEnum(fullName)
+	if err != nil {
+		return "", err
+	}
+	if valueEnum != nil {
+		return b.declareValueEnum(t, valueEnum)
+	}
+	if _, ok := t.Underlying().(*types.Struct); ok {
+		return b.declareStruct(t, subst)
+	}
+	if b.resolving[obj] {
+		return "", fmt.Errorf("%s contains itself and is encoded by its own methods", obj.Name())
+	}
+	b.resolving[obj] = true
+	defer delete(b.resolving, obj)
+	return b.declare(t.Underlying(), modifier, subst)
+}
+
+// declareEnum defines a Borsh enum as a u8 tag followed by the variant struct it selects
+func (b *schemaBuilder) declareEnum(t *types.Named, enum EnumInfo) (string, error) {
+	decl, done := b.reserve(enum.Name, t)
+	if done {
+		return decl, nil
+	}
+	variants := make([]borsh.Variant, len(enum.Variants))
+	for i, v := range enum.Variants {
+		obj, ok := t.Obj().Pkg().Scope().Lookup(v.Name).(*types.TypeName)
+		if !ok {
+			return "", fmt.Errorf("enum %s: variant %s not found", enum.Name, v.Name)
+		}
+		variantDecl, err := b.declareStruct(obj.Type().(*types.Named), nil)
+		if err != nil {
+			return "", err
+		}
+		variants[i] = borsh.Variant{Discriminant: int64(v.Index), Name: v.Name, Declaration: variantDecl}
+	}
+	b.schema.Definitions[decl] = borsh.EnumDef(1, variants...)
+	return decl, nil
+}
+
+// declareValueEnum defines a C-style enum as a tag of its width selecting one of its constants,
+// each declared as a struct without fields like the unit variants of a Rust enum
+func (b *schemaBuilder) declareValueEnum(t *types.Named, enum *ValueEnumInfo) (string, error) {
+	decl, done := b.reserve(enum.Name, t)
+	if done {
+		return decl, nil
+	}
+	variants := make([]borsh.Variant, len(enum.Constants))
+	for i, c := range enum.Constants {
+		// Constants are declared with the package of their enum when it is
+		constDecl := strings.TrimSuffix(decl, enum.Name) + c.Name
+		b.define(constDecl, borsh.StructDef())
+		variants[i] = borsh.Variant{Discriminant: int64(c.Value), Name: c.Name, Declaration: constDecl}
+	}
+	b.schema.Definitions[decl] = borsh.EnumDef(valueEnumTagWidths[enum.Width], variants...)
+	return decl, nil
+}
+
+// schemaSource is the Go expression that builds schema, for the generated BorshSchema methods
+func schemaSource(schema *borsh.Schema) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "&borsh.Schema{\n\t\tDeclaration: %q,\n\t\tDefinitions: map[string]borsh.Definition{\n", schema.Declaration)
+	decls := make([]string, 0, len(schema.Definitions))
+	for decl := range schema.Definitions {
+		decls = append(decls, decl)
+	}
+	sort.Strings(decls)
+	for _, decl := range decls {
+		fmt.Fprintf(&sb, "\t\t\t%q: %s,\n", decl, definitionSource(schema.Definitions[decl]))
+	}
+	sb.WriteString("\t\t},\n\t}")
+	return sb.String()
+}
+
+// definitionSource is the Go expression that builds def with the borsh constructors
+func definitionSource(def borsh.Definition) string {
+	switch {
+	case def.Primitive != nil:
+		return fmt.Sprintf("borsh.PrimitiveDef(%d)", *def.Primitive)
+	case def.Sequence != nil:
+		s := def.Sequence
+		return fmt.Sprintf("borsh.SequenceDef(%d, %d, %d, %q)", s.LengthWidth, s.LengthRange.Start, s.LengthRange.End, s.Elements)
+	case def.Tuple != nil:
+		elements := make([]string, len(def.Tuple.Elements))
+		for i, e := range def.Tuple.Elements {
+			elements[i] = fmt.Sprintf("%q", e)
+		}
+		return "borsh.TupleDef(" + strings.Join(elements, ", ") + ")"
+	case def.Enum != nil:
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "borsh.EnumDef(%d", def.Enum.TagWidth)
+		for _, v := range def.Enum.Variants {
+			fmt.Fprintf(&sb, ",\n\t\t\t\tborsh.Variant{Discriminant: %d, Name: %q, Declaration: %q}", v.Discriminant, v.Name, v.Declaration)
+		}
+		sb.WriteString(")")
+		return sb.String()
+	case def.Struct != nil:
+		var sb strings.Builder
+		sb.WriteString("borsh.StructDef(")
+		for _, f := range def.Struct.Fields {
+			fmt.Fprintf(&sb, "\n\t\t\t\tborsh.Field{Name: %q, Declaration: %q},", f.Name, f.Declaration)
+		}
+		sb.WriteString(")")
+		return sb.String()
+	}
+	return "borsh.Definition{}"
+}
+
+// Schemas describes the structs generated from path, a .go file or a directory of them,
+// with options as the base configuration. Structs that cannot be described, such as those
+// using the legacy wire layout, are left out and reported in skipped by name
+func Schemas(path string, options GeneratorOptions) (schemas []*borsh.Schema, skipped map[string]error, err error) {
+	wire, err := parseWire(options.Wire)
+	if err != nil {
+		return nil, nil, err
+	}
+	options.Wire = wire
+	skipped = make(map[string]error)
+
+	var files []string
+	if strings.HasSuffix(path, ".go") {
+		files = []string{path}
+	} else {
+		err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.HasSuffix(p, ".go") && !strings.HasSuffix(p, "_gen.go") && !strings.HasSuffix(p, "test.go") {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, file := range files {
+		cg := &CodeGenerator{options: options, quiet: true}
+		if err := cg.parseStructs(file); err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", file, err)
+		}
+		for _, s := range cg.structs {
+			schema, err := cg.structSchema(s)
+			if err != nil {
+				skipped[s.Name] = err
+				continue
+			}
+			schemas = append(schemas, schema)
+		}
+	}
+	return schemas, skipped, nil
+}
@@ -0,0 +1,159 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cespare/xxhash"
+)
+
+// typeIDDirective gives a struct the type ID written before it in fields typed by an interface,
+// in place of the xxhash of its package path and name. Fixing the ID keeps data readable when
+// the struct is renamed or moved:
+//
+//	//borshgen:typeid 0x5f3a
+const typeIDDirective = "//borshgen:typeid"
+
+// anyElementType is the element type templates use for fields typed by an interface that is not
+// a Borsh enum, such as any. The value is written after its type ID
+const anyElementType = "any"
+
+// structTypeID returns the type ID the doc comments of the struct give it, or the xxhash of its
+// qualified name, e.g. github.com/acme/shapes.Circle
+func structTypeID(qualifiedName, name string, docs ...*ast.CommentGroup) (uint64, error) {
+	var (
+		id    uint64
+		found bool
+	)
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, comment := range doc.List {
+			line := strings.TrimSpace(comment.Text)
+			if line != typeIDDirective && !strings.HasPrefix(line, typeIDDirective+" ") {
+				continue
+			}
+			if found {
+				return 0, fmt.Errorf("%s has more than one typeid directive", name)
+			}
+			v, err := strconv.ParseUint(strings.TrimSpace(strings.TrimPrefix(line, typeIDDirective)), 0, 64)
+			if err != nil || v == 0 {
+				return 0, fmt.Errorf("invalid typeid directive %q: expected a non-zero 64-bit integer", line)
+			}
+			id, found = v, true
+		}
+	}
+	if !found {
+		id = xxhash.Sum64String(qualifiedName)
+	}
+	return id, nil
+}
+
+// assignAnyType marks an interface node that is not a Borsh enum as a scalar encoded by the
+// generated any helpers
+func (resolvedType *ResolvedTypeInfo) assignAnyType() bool {
+	if !types.IsInterface(resolvedType.UnderlyingType) {
+		return false
+	}
+	resolvedType.ElementType = anyElementType
+	resolvedType.IsBasicType = true
+	resolvedType.IsStruct = false
+	resolvedType.PointerDeref = ""
+	resolvedType.PointerRef = ""
+	if resolvedType.IsPointer {
+		resolvedType.PointerDeref = "*"
+		resolvedType.PointerRef = "&"
+	}
+	return true
+}
+
+// interfaceVariants returns, for each interface other than a Borsh enum that fields of the structs
+// of this file hold, the structs of the file that implement it, as the variants the generated tests fill those fields
+// with. Structs that hold such an interface themselves are left out, so random values stay finite.
+// Interfaces of other packages are skipped, the tests do not import them
+func (cg *CodeGenerator) interfaceVariants() []EnumInfo {
+	if cg.pkg == nil || cg.pkg.Types == nil {
+		return nil
+	}
+	scope := cg.pkg.Types.Scope()
+	qualifier := types.RelativeTo(cg.pkg.Types)
+	holdsInterface := func(name string) map[string]*types.Interface {
+		found := make(map[string]*types.Interface)
+		obj := scope.Lookup(name)
+		if obj == nil {
+			return found
+		}
+		st, ok := obj.Type().Underlying().(*types.Struct)
+		if !ok {
+			return found
+		}
+		var walk func(t types.Type)
+		walk = func(t types.Type) {
+			switch t := types.Unalias(t).(type) {
+			case *types.Pointer:
+				walk(t.Elem())
+			case *types.Slice:
+				walk(t.Elem())
+			case *types.Array:
+				walk(t.Elem())
+			case *types.Map:
+				walk(t.Key())
+				walk(t.Elem())
+			case *types.Named:
+				if iface, ok := t.Underlying().(*types.Interface); ok && !cg.isEnumType(t) {
+					found[types.TypeString(t, qualifier)] = iface
+				}
+			case *types.Interface:
+				if t.Empty() {
+					found["any"] = t
+				} else {
+					found[types.TypeString(t, qualifier)] = t
+				}
+			}
+		}
+		for i := 0; i < st.NumFields(); i++ {
+			walk(st.Field(i).Type())
+		}
+		return found
+	}
+
+	var candidates []*types.Named
+	interfaces := make(map[string]*types.Interface)
+	for _, s := range cg.structs {
+		held := holdsInterface(s.Name)
+		for name, iface := range held {
+			interfaces[name] = iface
+		}
+		if len(held) == 0 && len(s.TypeParams) == 0 {
+			if named, ok := scope.Lookup(s.Name).Type().(*types.Named); ok {
+				candidates = append(candidates, named)
+			}
+		}
+	}
+
+	var variants []EnumInfo
+	for name, iface := range interfaces {
+		if strings.Contains(name, ".") {
+			continue
+		}
+		info := EnumInfo{Name: name}
+		for _, c := range candidates {
+			switch {
+			case types.Implements(c, iface):
+				info.Variants = append(info.Variants, EnumVariant{Name: c.Obj().Name()})
+			case types.Implements(types.NewPointer(c), iface):
+				info.Variants = append(info.Variants, EnumVariant{Name: c.Obj().Name(), IsPointer: true})
+			}
+		}
+		if len(info.Variants) > 0 {
+			variants = append(variants, info)
+		}
+	}
+	sort.Slice(variants, func(i, j int) bool { return variants[i].Name < variants[j].Name })
+	return variants
+}
@@ -0,0 +1,725 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/mlayerprotocol/go-borshgen/templates"
+)
+
+// tsRuntimeFile is the runtime every generated TypeScript file imports, written next to it
+const tsRuntimeFile = "borshgen.ts"
+
+// tsPrimitives maps Go basic types to their TypeScript type and runtime codec
+var tsPrimitives = map[types.BasicKind][2]string{
+	types.Bool:    {"boolean", "bool"},
+	types.Int8:    {"number", "i8"},
+	types.Int16:   {"number", "i16"},
+	types.Int32:   {"number", "i32"},
+	types.Int64:   {"bigint", "i64"},
+	types.Int:     {"bigint", "i64"},
+	types.Uint8:   {"number", "u8"},
+	types.Uint16:  {"number", "u16"},
+	types.Uint32:  {"number", "u32"},
+	types.Uint64:  {"bigint", "u64"},
+	types.Uint:    {"bigint", "u64"},
+	types.Float32: {"number", "f32"},
+	types.Float64: {"number", "f64"},
+}
+
+// tsZeros is the zero value of each TypeScript type of a primitive
+var tsZeros = map[string]string{"boolean": "false", "number": "0", "bigint": "0n", "string": `""`}
+
+// tsTimes are the time.Time codecs selected by the precision modifiers, and Go's zero time in each
+var tsTimes = map[string][2]string{
+	"":          {"borsh.time", "new borsh.Timestamp()"},
+	"unix":      {"borsh.timeUnix", "borsh.zeroTimeUnix"},
+	"unixmilli": {"borsh.timeUnixMilli", "borsh.zeroTimeUnixMilli"},
+	"unixnano":  {"borsh.timeUnixNano", "borsh.zeroTimeUnixNano"},
+}
+
+// tsValue is how a Go type is held and encoded in TypeScript
+type tsValue struct {
+	Type  string // TypeScript type
+	Zero  string // TypeScript expression of the Go zero value, empty when there is none
+	Codec string // expression of its borsh.Codec
+	class string // for structs, the expression of the class codec Codec wraps as a nested value
+}
+
+// tsField is a property of a generated TypeScript class
+type tsField struct {
+	Name string
+	tsValue
+}
+
+// tsClass is the TypeScript class of a Go struct
+type tsClass struct {
+	Name       string
+	GoName     string // package-qualified Go type, e.g. wire.Outer
+	TypeParams []string
+	Fields     []tsField
+	Encoded    []string // properties written by encode(), in the order Encode() writes them
+	// Discriminator lists the bytes of the discriminator of the struct, e.g. "0x01, 0x02"
+	Discriminator string
+}
+
+// CodecParams lists the codec parameters of a generic class, e.g. "codecT: borsh.Codec<T>"
+func (c *tsClass) CodecParams() string {
+	params := make([]string, len(c.TypeParams))
+	for i, p := range c.TypeParams {
+		params[i] = "codec" + p + ": borsh.Codec<" + p + ">"
+	}
+	return strings.Join(params, ", ")
+}
+
+// CodecArgs lists the codec parameters of a generic class as arguments
+func (c *tsClass) CodecArgs() string {
+	args := make([]string, len(c.TypeParams))
+	for i, p := range c.TypeParams {
+		args[i] = "codec" + p
+	}
+	return strings.Join(args, ", ")
+}
+
+// Generic returns the class name with its type parameters, e.g. Envelope<T>
+func (c *tsClass) Generic() string {
+	if len(c.TypeParams) == 0 {
+		return c.Name
+	}
+	return c.Name + "<" + strings.Join(c.TypeParams, ", ") + ">"
+}
+
+// tsEnum is a Borsh enum or a C-style enum declared in a generated TypeScript file
+type tsEnum struct {
+	Name      string
+	GoName    string
+	Width     int       // tag width of a C-style enum, 0 for a Borsh enum
+	Constants []tsConst // constants of a C-style enum
+	Variants  []tsConst // variant classes of a Borsh enum by discriminant
+}
+
+type tsConst struct {
+	Name  string
+	Value uint64
+}
+
+// Values lists the values of the constants of a C-style enum
+func (e *tsEnum) Values() string {
+	values := make([]string, len(e.Constants))
+	for i, c := range e.Constants {
+		values[i] = e.Name + "." + c.Name
+	}
+	return strings.Join(values, ", ")
+}
+
+// Union is the TypeScript type of a Borsh enum, the union of its variant classes
+func (e *tsEnum) Union() string {
+	names := make([]string, len(e.Variants))
+	for i, v := range e.Variants {
+		names[i] = v.Name
+	}
+	return strings.Join(names, " | ")
+}
+
+// tsImport is a class imported from the TypeScript file of another Go file
+type tsImport struct {
+	Path  string
+	Names []string // e.g. Header or Header as CommonHeader
+}
+
+// tsBuilder builds the TypeScript classes of the structs of a file and of every type they refer to,
+// following the same field order, tags and modifiers as the generated Go code
+type tsBuilder struct {
+	cg      *CodeGenerator
+	options GeneratorOptions
+	file    string // absolute path of the Go file being generated
+	// TypeScript names in use, and the type each class or enum was declared for
+	names   map[string]*types.TypeName
+	classes map[*types.TypeName]string
+	enums   map[*types.TypeName]string
+	pending []*types.TypeName // structs whose class is not built yet
+
+	Classes []*tsClass
+	Enums   []*tsEnum
+	imports map[string][]string
+	// named non-struct types being resolved, to stop at types that contain themselves
+	resolving map[*types.TypeName]bool
+}
+
+// generateTypeScript writes the TypeScript classes of the structs generated with -lang=ts to
+// base_borshgen.ts, with borshgen.ts next to it. Structs also generated with -gen-tests get
+// base_borshgen.test.ts, which checks the classes against the fixtures the Go tests write.
+// Stale files are removed when no struct has a TypeScript class
+func (cg *CodeGenerator) generateTypeScript(base string) error {
+	tsFile, testFile := base+"_borshgen.ts", base+"_borshgen.test.ts"
+	var structs []StructInfo
+	for _, s := range cg.structs {
+		if s.Options.HasLang(LangTypeScript) {
+			structs = append(structs, s)
+		}
+	}
+	if len(structs) == 0 {
+		for _, file := range []string{tsFile, testFile} {
+			if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to remove %s: %v", file, err)
+			}
+		}
+		return nil
+	}
+	if cg.pkg == nil || cg.pkg.Types == nil {
+		return fmt.Errorf("package types are not loaded")
+	}
+
+	file, err := filepath.Abs(base + ".go")
+	if err != nil {
+		return err
+	}
+	b := &tsBuilder{
+		cg:        cg,
+		options:   structs[0].Options,
+		file:      file,
+		names:     make(map[string]*types.TypeName),
+		classes:   make(map[*types.TypeName]string),
+		enums:     make(map[*types.TypeName]string),
+		imports:   make(map[string][]string),
+		resolving: make(map[*types.TypeName]bool),
+	}
+	// Names of the generated file itself
+	b.names["borsh"], b.names["wire"] = nil, nil
+	var tested []string
+	for _, s := range structs {
+		obj, ok := cg.pkg.Types.Scope().Lookup(s.Name).(*types.TypeName)
+		if !ok {
+			return fmt.Errorf("%s: type not found in package %s", s.Name, cg.pkg.Name)
+		}
+		b.local(obj)
+		if s.Options.GenTests && len(s.TypeParams) == 0 {
+			tested = append(tested, b.classes[obj])
+		}
+	}
+	for len(b.pending) > 0 {
+		obj := b.pending[0]
+		b.pending = b.pending[1:]
+		class, err := b.class(obj)
+		if err != nil {
+			return fmt.Errorf("%s: %v", obj.Name(), err)
+		}
+		b.Classes = append(b.Classes, class)
+	}
+
+	name := filepath.Base(base)
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("ts").Parse(templates.TypeScriptTemplate))
+	if err := tmpl.Execute(&buf, map[string]any{
+		"Source":  name + ".go",
+		"Options": b.options,
+		"Imports": b.sortedImports(),
+		"Enums":   b.Enums,
+		"Classes": b.Classes,
+	}); err != nil {
+		return fmt.Errorf("failed to execute TypeScript template: %v", err)
+	}
+	if err := os.WriteFile(tsFile, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	runtime := filepath.Join(filepath.Dir(tsFile), tsRuntimeFile)
+	if err := os.WriteFile(runtime, []byte(templates.TypeScriptRuntime), 0644); err != nil {
+		return err
+	}
+
+	if len(tested) == 0 {
+		if err := os.Remove(testFile); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove %s: %v", testFile, err)
+		}
+		return nil
+	}
+	buf.Reset()
+	tmpl = template.Must(template.New("tstest").Parse(templates.TypeScriptTestTemplate))
+	if err := tmpl.Execute(&buf, map[string]any{
+		"Base":    name + "_borshgen",
+		"Test":    tsTestName(name),
+		"Classes": tested,
+	}); err != nil {
+		return fmt.Errorf("failed to execute TypeScript test template: %v", err)
+	}
+	return os.WriteFile(testFile, buf.Bytes(), 0644)
+}
+
+// tsTestName is the suffix of the Go test that writes the fixtures of a file, e.g. Wire for wire.go
+func tsTestName(name string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// tsProperty converts a Go field name to a TypeScript property name, e.g. ID to id and UserName
+// to userName. Names of the methods of generated classes get an underscore
+func tsProperty(name string) string {
+	runes := []rune(name)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	// The last capital of an initialism starts the next word, as in URLPath
+	if n > 1 && n < len(runes) {
+		n--
+	}
+	for i := 0; i < n || i == 0 && len(runes) > 0; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	prop := string(runes)
+	switch prop {
+	case "serialize", "encode", "constructor":
+		prop += "_"
+	}
+	return prop
+}
+
+// declare reserves a TypeScript name for obj. A name taken by another type is prefixed with the
+// package name of obj, e.g. CommonHeader
+func (b *tsBuilder) declare(obj *types.TypeName) string {
+	name := obj.Name()
+	for i := 0; ; i++ {
+		if prev, ok := b.names[name]; !ok || prev == obj {
+			b.names[name] = obj
+			return name
+		}
+		prefix := tsTestName(obj.Pkg().Name())
+		if i > 0 {
+			prefix += fmt.Sprint(i + 1)
+		}
+		name = prefix + obj.Name()
+	}
+}
+
+// local declares the class of a struct of the file being generated
+func (b *tsBuilder) local(obj *types.TypeName) string {
+	if name, ok := b.classes[obj]; ok {
+		return name
+	}
+	name := b.declare(obj)
+	b.classes[obj] = name
+	b.pending = append(b.pending, obj)
+	return name
+}
+
+// classOf returns the TypeScript class of a struct. Structs of other files with a TypeScript
+// class of their own are imported from it, others get a class in this file
+func (b *tsBuilder) classOf(obj *types.TypeName) string {
+	if name, ok := b.classes[obj]; ok {
+		return name
+	}
+	path := b.tsFileOf(obj)
+	if path == "" {
+		return b.local(obj)
+	}
+	name := b.declare(obj)
+	b.classes[obj] = name
+	spec := obj.Name()
+	if name != obj.Name() {
+		spec += " as " + name
+	}
+	b.imports[path] = append(b.imports[path], spec)
+	return name
+}
+
+// tsFileOf returns the import path of the TypeScript file generated for the file declaring obj,
+// or "" when obj is declared in this file or has no directive with -lang=ts
+func (b *tsBuilder) tsFileOf(obj *types.TypeName) string {
+	filename, options, ok := b.cg.directiveOf(obj)
+	if !ok || filename == b.file || !options.HasLang(LangTypeScript) {
+		return ""
+	}
+	rel, err := filepath.Rel(filepath.Dir(b.file), strings.TrimSuffix(filename, ".go")+"_borshgen.ts")
+	if err != nil {
+		return ""
+	}
+	rel = filepath.ToSlash(rel)
+	if !strings.HasPrefix(rel, "../") {
+		rel = "./" + rel
+	}
+	return rel
+}
+
+// directiveOf returns the absolute path of the file declaring the struct obj and the options of
+// its borshgen directive, looking for it where parseStructs does. ok is false when it has none
+func (cg *CodeGenerator) directiveOf(obj *types.TypeName) (filename string, options GeneratorOptions, ok bool) {
+	filename, docs, fileComments, found := cg.declarationOf(obj)
+	if !found {
+		return "", options, false
+	}
+	for _, group := range append(docs, fileComments...) {
+		// An invalid directive fails the generation of its own package
+		if found, options, err := parseGenerateComment(group, cg.base); found && err == nil {
+			return filename, options, true
+		}
+	}
+	return filename, options, false
+}
+
+// declarationOf finds the declaration of the type obj in the loaded packages. It returns the absolute
+// path of its file, its doc comments, and the comments of the file when it is the first declaration,
+// since parseStructs also takes a directive from there
+func (cg *CodeGenerator) declarationOf(obj *types.TypeName) (filename string, docs, fileComments []*ast.CommentGroup, ok bool) {
+	pkg := cg.pkg
+	if obj.Pkg() != nil && obj.Pkg().Path() != pkg.PkgPath {
+		pkg = findPackage(cg.pkg, obj.Pkg().Path())
+	}
+	if pkg == nil || pkg.Fset == nil {
+		return "", nil, nil, false
+	}
+	for _, file := range pkg.Syntax {
+		if obj.Pos() < file.Pos() || obj.Pos() >= file.End() {
+			continue
+		}
+		filename, err := filepath.Abs(pkg.Fset.Position(file.Pos()).Filename)
+		if err != nil {
+			return "", nil, nil, false
+		}
+		for i, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if typeSpec.Name.Name != obj.Name() {
+					continue
+				}
+				if i == 0 {
+					fileComments = file.Comments
+				}
+				return filename, []*ast.CommentGroup{genDecl.Doc, typeSpec.Doc}, fileComments, true
+			}
+		}
+		return "", nil, nil, false
+	}
+	return "", nil, nil, false
+}
+
+func (b *tsBuilder) sortedImports() []tsImport {
+	var imports []tsImport
+	for path, names := range b.imports {
+		sort.Strings(names)
+		imports = append(imports, tsImport{Path: path, Names: names})
+	}
+	sort.Slice(imports, func(i, j int) bool { return imports[i].Path < imports[j].Path })
+	return imports
+}
+
+// class builds the class of a struct with its fields in encoding order
+func (b *tsBuilder) class(obj *types.TypeName) (*tsClass, error) {
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("not a named struct type")
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct", named)
+	}
+	class := &tsClass{Name: b.classes[obj], GoName: obj.Pkg().Name() + "." + obj.Name()}
+	discriminator, err := b.cg.discriminatorOf(obj)
+	if err != nil {
+		return nil, err
+	}
+	class.Discriminator = discriminatorList(discriminator)
+	params := make(map[*types.TypeParam]tsValue)
+	for i := 0; i < named.TypeParams().Len(); i++ {
+		p := named.TypeParams().At(i)
+		name := p.Obj().Name()
+		class.TypeParams = append(class.TypeParams, name)
+		params[p] = tsValue{Type: name, Codec: "borsh.nested(() => codec" + name + ")", class: "codec" + name}
+	}
+
+	list, fieldTypes, err := b.cg.structFieldList(st, obj.Name())
+	if err != nil {
+		return nil, err
+	}
+	fields, err := b.cg.visibleFields(list, fieldTypes, b.options)
+	if err != nil {
+		return nil, err
+	}
+	type encoded struct{ tag, prop string }
+	var encodedFields []encoded
+	for _, f := range fields {
+		tag, _, modifier, hasEncTag, _ := b.cg.extractFieldTag(f.field, b.options)
+		if tag == "" {
+			tag = strings.ToLower(f.goName)
+		}
+		v, err := b.field(f.typ, modifier, params, parseMaxTag(f.name, f.field))
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", obj.Name(), f.name, err)
+		}
+		prop := tsProperty(f.goName)
+		class.Fields = append(class.Fields, tsField{Name: prop, tsValue: v})
+		if hasEncTag {
+			encodedFields = append(encodedFields, encoded{tag, prop})
+		}
+	}
+	sort.SliceStable(encodedFields, func(i, j int) bool { return encodedFields[i].tag < encodedFields[j].tag })
+	for _, e := range encodedFields {
+		class.Encoded = append(class.Encoded, e.prop)
+	}
+	return class, nil
+}
+
+// field resolves the type of a struct field, where a pointer is an option and the tag modifier
+// may replace the encoding of the type. max is the limit of a max:"N" tag, 0 for none
+func (b *tsBuilder) field(t types.Type, modifier string, params map[*types.TypeParam]tsValue, max int) (tsValue, error) {
+	elem, pointer := t, false
+	if p, ok := types.Unalias(t).(*types.Pointer); ok && !isBigInt(p.Elem()) {
+		elem, pointer = p.Elem(), true
+	}
+	var (
+		v   tsValue
+		err error
+	)
+	switch {
+	case modifier == "" || modifier == setModifier || modifier == nestedModifier ||
+		modifier == u128ElementType || modifier == i128ElementType || timeEncoders[modifier] != "" ||
+		strings.HasPrefix(modifier, "[]"):
+		v, err = b.value(elem, modifier, params, max)
+	case isBasicType(modifier):
+		// The field is converted to the basic type named in the tag
+		v, err = b.value(types.Universe.Lookup(modifier).Type(), "", nil, max)
+	default:
+		// Written by a custom field encoder, whose output TypeScript holds as bytes
+		v = tsValue{Type: "Uint8Array", Zero: "new Uint8Array(0)", Codec: "borsh.opaque"}
+	}
+	if err != nil || !pointer {
+		return v, err
+	}
+	return tsValue{Type: v.Type + " | null", Zero: "null", Codec: "borsh.option(" + v.Codec + ")"}, nil
+}
+
+// limit is the max argument of a runtime codec, empty for the package limit
+func limit(max int) string {
+	if max == 0 {
+		return ""
+	}
+	return fmt.Sprint(max)
+}
+
+// value resolves a type that is not a field. Pointers are written as the value they point to
+func (b *tsBuilder) value(t types.Type, modifier string, params map[*types.TypeParam]tsValue, max int) (tsValue, error) {
+	switch t := types.Unalias(t).(type) {
+	case *types.TypeParam:
+		v, ok := params[t]
+		if !ok {
+			return tsValue{}, fmt.Errorf("unbound type parameter %s", t)
+		}
+		return v, nil
+	case *types.Pointer:
+		return b.value(t.Elem(), modifier, params, max)
+	case *types.Named:
+		return b.named(t, modifier, params, max)
+	case *types.Basic:
+		if t.Kind() == types.String {
+			return tsValue{Type: "string", Zero: `""`, Codec: "borsh.string(" + limit(max) + ")"}, nil
+		}
+		p, ok := tsPrimitives[t.Kind()]
+		if !ok {
+			return tsValue{}, fmt.Errorf("unsupported type %s", t)
+		}
+		return tsValue{Type: p[0], Zero: tsZeros[p[0]], Codec: "borsh." + p[1]}, nil
+	case *types.Slice:
+		if basic, ok := t.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte && modifier != setModifier {
+			return tsValue{Type: "Uint8Array", Zero: "new Uint8Array(0)", Codec: "borsh.bytes(" + limit(max) + ")"}, nil
+		}
+		elem, err := b.value(t.Elem(), modifier, params, 0)
+		if err != nil {
+			return tsValue{}, err
+		}
+		codec := "borsh.vec("
+		if modifier == setModifier {
+			codec = "borsh.set("
+		}
+		codec += elem.Codec
+		if max > 0 {
+			codec += fmt.Sprintf(", %d", max)
+		}
+		return tsValue{Type: "Array<" + elem.Type + ">", Zero: "[]", Codec: codec + ")"}, nil
+	case *types.Array:
+		if basic, ok := t.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte {
+			return tsValue{Type: "Uint8Array", Zero: fmt.Sprintf("new Uint8Array(%d)", t.Len()), Codec: fmt.Sprintf("borsh.fixedBytes(%d)", t.Len())}, nil
+		}
+		elem, err := b.value(t.Elem(), modifier, params, 0)
+		if err != nil {
+			return tsValue{}, err
+		}
+		zero := ""
+		if elem.Zero != "" {
+			zero = fmt.Sprintf("Array.from({ length: %d }, () => %s)", t.Len(), elem.Zero)
+		}
+		return tsValue{Type: "Array<" + elem.Type + ">", Zero: zero, Codec: fmt.Sprintf("borsh.array(%s, %d)", elem.Codec, t.Len())}, nil
+	case *types.Map:
+		key, err := b.value(t.Key(), "", params, 0)
+		if err != nil {
+			return tsValue{}, err
+		}
+		if isEmptyStruct(t.Elem()) {
+			codec := "borsh.keySet(" + key.Codec
+			if max > 0 {
+				codec += fmt.Sprintf(", %d", max)
+			}
+			return tsValue{Type: "Set<" + key.Type + ">", Zero: "new Set()", Codec: codec + ")"}, nil
+		}
+		value, err := b.value(t.Elem(), modifier, params, 0)
+		if err != nil {
+			return tsValue{}, err
+		}
+		codec := "borsh.map(" + key.Codec + ", " + value.Codec
+		if max > 0 {
+			codec += fmt.Sprintf(", %d", max)
+		}
+		return tsValue{Type: "Map<" + key.Type + ", " + value.Type + ">", Zero: "new Map()", Codec: codec + ")"}, nil
+	}
+	return tsValue{}, fmt.Errorf("unsupported type %s", t)
+}
+
+// named resolves a named type: a Borsh enum, a type with a custom element encoder,
+// a C-style enum, a struct, or otherwise its underlying type
+func (b *tsBuilder) named(t *types.Named, modifier string, params map[*types.TypeParam]tsValue, max int) (tsValue, error) {
+	obj := t.Obj()
+	var path string
+	if obj.Pkg() != nil {
+		path = obj.Pkg().Path()
+	}
+	fullName := path + "." + obj.Name()
+	if enum, ok := b.cg.enumMap[fullName]; ok {
+		name, err := b.enum(obj, enum)
+		if err != nil {
+			return tsValue{}, err
+		}
+		return tsValue{Type: name, Codec: name + "Codec"}, nil
+	}
+
+	switch {
+	case fullName == "time.Time":
+		codec, ok := tsTimes[modifier]
+		if !ok {
+			codec = tsTimes[""]
+		}
+		typ := "bigint"
+		if modifier == "" || tsTimes[modifier] == [2]string{} {
+			typ = "borsh.Timestamp"
+		}
+		return tsValue{Type: typ, Zero: codec[1], Codec: codec[0]}, nil
+	case fullName == bigIntTypeName:
+		if modifier == i128ElementType {
+			return tsValue{Type: "bigint", Zero: "0n", Codec: "borsh.i128"}, nil
+		}
+		return tsValue{Type: "bigint", Zero: "0n", Codec: "borsh.u128"}, nil
+	case fullName == runtimePackage+".Uint128":
+		return tsValue{Type: "bigint", Zero: "0n", Codec: "borsh.u128"}, nil
+	case fullName == runtimePackage+".Int128":
+		return tsValue{Type: "bigint", Zero: "0n", Codec: "borsh.i128"}, nil
+	case fullName == "encoding/json.RawMessage":
+		return tsValue{Type: "Uint8Array", Zero: "new Uint8Array(0)", Codec: "borsh.opaque"}, nil
+	case isUUID(fullName, t.Underlying()):
+		return tsValue{Type: "Uint8Array", Zero: "new Uint8Array(16)", Codec: "borsh.uuid"}, nil
+	}
+
+	valueEnum, err := b.cg.lookupValueEnum(fullName)
+	if err != nil {
+		return tsValue{}, err
+	}
+	if valueEnum != nil {
+		return b.valueEnum(obj, valueEnum), nil
+	}
+	if _, ok := t.Underlying().(*types.Struct); ok {
+		return b.structValue(t, params)
+	}
+	if b.resolving[obj] {
+		return tsValue{}, fmt.Errorf("%s contains itself and is encoded by its own methods", obj.Name())
+	}
+	b.resolving[obj] = true
+	defer delete(b.resolving, obj)
+	return b.value(t.Underlying(), modifier, params, max)
+}
+
+// structValue resolves a struct, which is written as a nested value of its class
+func (b *tsBuilder) structValue(t *types.Named, params map[*types.TypeParam]tsValue) (tsValue, error) {
+	name := b.classOf(t.Origin().Obj())
+	args := t.TypeArgs()
+	if args == nil || args.Len() == 0 {
+		return tsValue{Type: name, Zero: "new " + name + "()", Codec: "borsh.nested(() => " + name + ".codec)", class: name + ".codec"}, nil
+	}
+	typeArgs := make([]string, args.Len())
+	codecArgs := make([]string, args.Len())
+	for i := 0; i < args.Len(); i++ {
+		arg, err := b.value(args.At(i), "", params, 0)
+		if err != nil {
+			return tsValue{}, err
+		}
+		if arg.class == "" {
+			return tsValue{}, fmt.Errorf("%s: type argument %s is not a struct", t, args.At(i))
+		}
+		typeArgs[i], codecArgs[i] = arg.Type, arg.class
+	}
+	typ := name + "<" + strings.Join(typeArgs, ", ") + ">"
+	class := name + ".codec(" + strings.Join(codecArgs, ", ") + ")"
+	return tsValue{Type: typ, Zero: "new " + typ + "()", Codec: "borsh.nested(() => " + class + ")", class: class}, nil
+}
+
+// enum declares a Borsh enum, the union of the classes of its variants
+func (b *tsBuilder) enum(obj *types.TypeName, enum EnumInfo) (string, error) {
+	if name, ok := b.enums[obj]; ok {
+		return name, nil
+	}
+	name := b.declare(obj)
+	b.enums[obj] = name
+	e := &tsEnum{Name: name, GoName: obj.Pkg().Name() + "." + obj.Name()}
+	for _, v := range enum.Variants {
+		variant, ok := obj.Pkg().Scope().Lookup(v.Name).(*types.TypeName)
+		if !ok {
+			return "", fmt.Errorf("enum %s: variant %s not found", enum.Name, v.Name)
+		}
+		e.Variants = append(e.Variants, tsConst{Name: b.classOf(variant), Value: uint64(v.Index)})
+	}
+	b.Enums = append(b.Enums, e)
+	return name, nil
+}
+
+// valueEnum declares a C-style enum as an object of its constants and the union of their values
+func (b *tsBuilder) valueEnum(obj *types.TypeName, enum *ValueEnumInfo) tsValue {
+	name, ok := b.enums[obj]
+	if !ok {
+		name = b.declare(obj)
+		b.enums[obj] = name
+		e := &tsEnum{Name: name, GoName: obj.Pkg().Name() + "." + obj.Name(), Width: int(primitiveSizes[enum.Width])}
+		for _, c := range enum.Constants {
+			e.Constants = append(e.Constants, tsConst{Name: c.Name, Value: c.Value})
+		}
+		b.Enums = append(b.Enums, e)
+	}
+	// The zero value is the constant 0, or the first constant when 0 is not one
+	zero := enum.Constants[0].Name
+	for _, c := range enum.Constants {
+		if c.Value == 0 {
+			zero = c.Name
+			break
+		}
+	}
+	return tsValue{Type: name, Zero: name + "." + zero, Codec: name + "Codec"}
+}
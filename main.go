@@ -1,8 +1,10 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -11,19 +13,46 @@ import (
 
 func main() {
 		
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: borshgen <dir or file.go>") 
+	args := os.Args[1:]
+	// borshgen schema <dir or file.go> prints the schemas of the structs instead of generating code
+	schemaCommand := len(args) > 0 && args[0] == "schema"
+	if schemaCommand {
+		args = args[1:]
+	}
+	// borshgen import <schema.json> writes Go types from a Borsh schema or an Anchor IDL, and their codecs
+	importCommand := !schemaCommand && len(args) > 0 && args[0] == "import"
+	if importCommand {
+		args = args[1:]
+	}
+	// go generate runs the //go:generate borshgen directive of a struct with only its flags.
+	// The file to generate is the one it names in $GOFILE, and the flags are left to the generator,
+	// which reads the directive of each struct in the file rather than applying these to all of them
+	directive := !schemaCommand && !importCommand && len(args) > 0 && strings.HasPrefix(args[0], "-") && os.Getenv("GOFILE") != ""
+	if directive {
+		args = []string{os.Getenv("GOFILE")}
+	}
+	if len(args) < 1 {
+		fmt.Println("Usage: borshgen <dir or file.go>")
+		fmt.Println("       borshgen schema <dir or file.go> [-type=Name]") 
+		fmt.Println("       borshgen import <schema.json> [-o=file.go] [-package=name]")
 		// fmt.Println("Options:")
 		// fmt.Println("  //go:generate borshgen -tag=msg -fallback=json -encode-tag=enc")
 		// fmt.Println("  //go:generate borshgen -tag=binary -fallback=msg")
 		// fmt.Println("  //go:generate borshgen -ignore=- -max-string=32767")
+		// fmt.Println("  //go:generate borshgen -wire=borsh")
+		// fmt.Println("  //go:generate borshgen -strict")
+		// fmt.Println("  //go:generate borshgen -max-depth=64")
+		// fmt.Println("  //go:generate borshgen -max-alloc=67108864")
+		// fmt.Println("  //go:generate borshgen -fuzz")
+		// fmt.Println("  //go:generate borshgen -gen-tests")
+		// fmt.Println("  //go:generate borshgen -lang=go,ts,rust")
 		// fmt.Println("  //go:generate borshgen -zero-copy -unsafe")
 		os.Exit(1)
 	}
 
-	inputFile := os.Args[1]
+	inputFile := args[0]
 	
-	if len(os.Args) == 0 {
+	if len(args) == 0 {
 		fmt.Println("No input file or directory provided.")
 		os.Exit(1)
 	}
@@ -36,11 +65,21 @@ func main() {
 	// zeroCopy := false
 	// safeMode := true
 	encodeTag := "enc"
+	wire := generator.WireLegacy
+	strict := false
+	fuzz := false
+	genTests := false
+	maxDepth := generator.DefaultMaxDepth
+	maxAlloc := generator.DefaultMaxAlloc
+	maxSlice := 0
+	var langs []string
+	typeName := ""
+	var importOptions generator.ImportOptions
 	var err error
 	
 	// Parse additional flags
-	for i := 2; i < len(os.Args); i++ {
-		arg := os.Args[i]
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
 		
 		if strings.HasPrefix(arg, "-tag=") {
 			primaryTag = strings.TrimPrefix(arg, "-tag=")
@@ -57,6 +96,50 @@ func main() {
 		} else if strings.HasPrefix(arg, "-max-string=") {
 			maxString, err = strconv.Atoi(strings.TrimPrefix(arg, "-max-string="))
 
+		} else if arg == "-strict" {
+			strict = true
+		} else if arg == "-fuzz" {
+			fuzz = true
+		} else if arg == "-gen-tests" {
+			genTests = true
+		} else if strings.HasPrefix(arg, "-max-depth=") {
+			if maxDepth, err = strconv.Atoi(strings.TrimPrefix(arg, "-max-depth=")); err == nil && maxDepth < 1 {
+				err = fmt.Errorf("must be positive")
+			}
+			if err != nil {
+				fmt.Printf("Invalid max-depth value: %v\n", err)
+				os.Exit(1)
+			}
+		} else if strings.HasPrefix(arg, "-max-alloc=") {
+			if maxAlloc, err = strconv.Atoi(strings.TrimPrefix(arg, "-max-alloc=")); err == nil && maxAlloc < 1 {
+				err = fmt.Errorf("must be positive")
+			}
+			if err != nil {
+				fmt.Printf("Invalid max-alloc value: %v\n", err)
+				os.Exit(1)
+			}
+		} else if strings.HasPrefix(arg, "-max-slice=") {
+			if maxSlice, err = strconv.Atoi(strings.TrimPrefix(arg, "-max-slice=")); err == nil && maxSlice < 1 {
+				err = fmt.Errorf("must be positive")
+			}
+			if err != nil {
+				fmt.Printf("Invalid max-slice value: %v\n", err)
+				os.Exit(1)
+			}
+		} else if strings.HasPrefix(arg, "-lang=") {
+			if langs, err = generator.ParseLangs(strings.TrimPrefix(arg, "-lang=")); err != nil {
+				fmt.Printf("Invalid lang value: %v\n", err)
+				os.Exit(1)
+			}
+		} else if strings.HasPrefix(arg, "-type=") {
+			typeName = strings.TrimPrefix(arg, "-type=")
+		} else if strings.HasPrefix(arg, "-o=") {
+			importOptions.Output = strings.TrimPrefix(arg, "-o=")
+		} else if strings.HasPrefix(arg, "-package=") {
+			importOptions.Package = strings.TrimPrefix(arg, "-package=")
+		} else if strings.HasPrefix(arg, "-wire=") {
+			wire = strings.TrimPrefix(arg, "-wire=")
+
 		} else if strings.HasPrefix(arg, "-encodeTag=") {
 			encodeTag = strings.TrimPrefix(arg, "-encode-tag=")
 
@@ -75,10 +158,33 @@ func main() {
 	// } else {
 	// 	err = Generate(inputFile,  "", primaryTag, fallbackTag, encodeTag, ignoreTag,   usePooling, maxString)
 	// }
-	if !strings.HasSuffix(inputFile, ".go") {
-			err = generator.GenerateDir(inputFile,  primaryTag, fallbackTag, encodeTag, ignoreTag,  usePooling, maxString)
+	options := generator.DefaultOptions()
+	options.PrimaryTag = primaryTag
+	options.FallbackTag = fallbackTag
+	options.EncodeTag = encodeTag
+	options.IgnoreTag = ignoreTag
+	options.UsePooling = usePooling
+	options.Wire = wire
+	options.Strict = strict
+	options.Fuzz = fuzz
+	options.GenTests = genTests
+	options.MaxDepth = maxDepth
+	options.MaxAlloc = maxAlloc
+	options.Langs = langs
+	if maxSlice > 0 {
+		options.MaxSliceLen = maxSlice
+	}
+	if schemaCommand {
+		err = printSchemas(inputFile, typeName, options)
+	} else if importCommand {
+		err = generator.Import(inputFile, importOptions, options)
+	} else if directive {
+		err = generator.GenerateFileWithOptions(inputFile, options)
+	} else if !strings.HasSuffix(inputFile, ".go") {
+			options.MaxStringLen = maxString
+			err = generator.GenerateDirWithOptions(inputFile, options)
 	} else {
-			err = generator.Generate(inputFile,  "", primaryTag, fallbackTag, encodeTag, ignoreTag,  usePooling, generator.DefaultOptions().MaxStringLen)
+			err = generator.GenerateWithOptions(inputFile,  "", options)
 	}
 
 	
@@ -86,4 +192,41 @@ func main() {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
-}
\ No newline at end of file
+}
+
+// printSchemas writes the schema of the struct named typeName as JSON, or an array of the schemas
+// of every struct when typeName is empty. Structs that cannot be described are reported on stderr
+func printSchemas(path, typeName string, options generator.GeneratorOptions) error {
+	schemas, skipped, err := generator.Schemas(path, options)
+	if err != nil {
+		return err
+	}
+	var out any = schemas
+	if typeName != "" {
+		if err := skipped[typeName]; err != nil {
+			return err
+		}
+		out = nil
+		for _, schema := range schemas {
+			if schema.Declaration == typeName {
+				out = schema
+			}
+		}
+		if out == nil {
+			return fmt.Errorf("no struct %s generated from %s", typeName, path)
+		}
+	} else {
+		names := make([]string, 0, len(skipped))
+		for name := range skipped {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(os.Stderr, "skipped %v\n", skipped[name])
+		}
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -5,16 +5,21 @@ const BinarySizeFunctionTemplate = `// Code generated by bingen. DO NOT EDIT.
 
 // BinarySizes {{.Name}} to binary format
 {{define "binarySize"}}
-func (s {{.Name}}) BinarySize() (int, error) {
-	size := 0
+func (s {{.Receiver}}) BinarySize() (int, error) {
+	size := {{len .Discriminator}}
 	{{range .Fields}}
 		{{if not .ShouldIgnore}}
+		{{range .EmbeddedPointers}}
+		if s.{{.Path}} == nil {
+			return 0, borsh.EncodeFieldError("{{.Path}}", fmt.Errorf("embedded {{.Path}} is nil"))
+		}
+		{{end}}
 
 		{{if or .IsPointer .IsPointerSlice}}
 		{
 			size++
 			if s.{{.Name}} == nil {
-				goto SKIP{{.Name}}
+				goto SKIP{{.Label}}
 			}
 		}
 		{{end}}
@@ -23,16 +28,20 @@ func (s {{.Name}}) BinarySize() (int, error) {
 		{{if .IsCustomFieldEncoder}}
 			_size, err := {{.CustomFieldEncoder}}.BinarySize({{.PointerDeref}}s.{{.Name}}, s)
 				if err != nil {
-						return 0, fmt.Errorf("failed to calculate binary size for custom encoder {{.Name}}: %v", err)
+						return 0, borsh.EncodeFieldError("{{.Name}}", err)
 				}
-				size += 2 + _size
+				size += LengthPrefixSize + _size
 		
 		{{else if .IsCustomElementEncoder}}
 				_size, err := {{.CustomElementEncoder}}.BinarySize({{.PointerDeref}}s.{{.Name}}, s)
 				if err != nil {
-					return 0, fmt.Errorf("failed to calculate binary size for custom encoder {{.Name}}: %v", err)
+					return 0, borsh.EncodeFieldError("{{.Name}}", err)
 				}
-				size += 2 + _size
+				size += LengthPrefixSize + _size
+		{{ else if .Element.IsMap }}
+				// {{.Name}} ({{.BinaryTag}}) - map
+				{{template "binarySizeElement" dict "Var" (printf "s.%s" .Name) "Shape" .Element}}
+
 		{{ else if .Element.IsSlice  }}
 				// {{.Name}} ({{.BinaryTag}}) - slice
 				// ElementType: {{.Element.TypeName}}
@@ -55,6 +64,7 @@ func (s {{.Name}}) BinarySize() (int, error) {
 					"Var" (printf "s.%s" .Name)
 					"FieldName" .Name
 					"ElementType" .ElementType
+					"TypeName" .TypeName
 					"IsPointer" .IsPointer
 					"PointerDeref" .PointerDeref
 					"IsCustomElementEncoder" .IsCustomElementEncoder
@@ -72,6 +82,7 @@ func (s {{.Name}}) BinarySize() (int, error) {
 					"Var" (printf "s.%s" .Name)
 					"FieldName" .Name
 					"ElementType" .Element.ElementType
+					"TypeName" .Element.TypeName
 					"IsPointer" .Element.IsPointer
 					"PointerDeref" .Element.PointerDeref
 					"IsCustomElementEncoder" .Element.IsCustomElementEncoder
@@ -85,7 +96,7 @@ func (s {{.Name}}) BinarySize() (int, error) {
 		}
 		
 		{{if .IsPointer}}
-			SKIP{{.Name}}:
+			SKIP{{.Label}}:
 		{{end}}
 		{{end}}
 	{{end}}
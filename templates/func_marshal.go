@@ -7,30 +7,68 @@ const MarshalBorshTemplate = `// Code generated by bingen. DO NOT EDIT.
 
 // MarshalBorsh marshals {{.Name}} to binary format
 {{define "marshalBinary"}}
-func (s {{.Name}}) MarshalBorsh() ([]byte, error) {
-	size, err := s.BinarySize()
+func (s {{.Receiver}}) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends {{.Name}} in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s {{.Receiver}}) AppendBorsh(dst []byte) ([]byte, error) {
+	{{if .Options.UsePooling}}
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	{{else}}
+	w := borsh.NewBufferWriter(dst)
+	{{end}}
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
 	if err != nil {
-		return nil, err
+		return dst, borsh.WithType("{{.Name}}", err)
 	}
+	return out, nil
+}
 
-	{{if .Options.UsePooling}}
-	var pool *sync.Pool
-	if size > 512 { // Adjusted threshold based on typical message size
-		pool = binaryBufPoolLG
-	} else {
-		pool = binaryBufPoolMD
+// MarshalBorshTo writes {{.Name}} in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s {{.Receiver}}) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
 	}
-	buf := pool.Get().(*bytes.Buffer) // Use *bytes.Buffer for Borsh
-	defer pool.Put(buf)
-	buf.Reset() // Reset length and contents
-	if cap(buf.Bytes()) < size {
-		buf.Grow(size) // Ensure sufficient capacity
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "{{.Name}}", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
 	}
-{{else}}
-	buf := bytes.NewBuffer(make([]byte, 0, size))
-{{end}}
+	return len(out), nil
+}
+
+// WriteBorshTo writes {{.Name}} to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s {{.Receiver}}) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("{{.Name}}", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes {{.Name}} to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to {{.Name}}
+func (s {{.Receiver}}) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	{{if .Discriminator}}
+	buf.Write(discriminator{{.Name}})
+	{{end}}
 	{{range .Fields}}
 		{{if not .ShouldIgnore}}
+		{{range .EmbeddedPointers}}
+		if s.{{.Path}} == nil {
+			return borsh.EncodeFieldError("{{.Path}}", fmt.Errorf("embedded {{.Path}} is nil"))
+		}
+		{{end}}
 		
 		
 
@@ -38,7 +76,7 @@ func (s {{.Name}}) MarshalBorsh() ([]byte, error) {
 		{
 			if s.{{.Name}} == nil {
 				buf.WriteByte(0) // // nil marker
-				goto SKIP{{.Name}}
+				goto SKIP{{.Label}}
 			} else {
 				buf.WriteByte(1) // non-nil marker
 			}
@@ -48,16 +86,26 @@ func (s {{.Name}}) MarshalBorsh() ([]byte, error) {
 
 		{{if .IsCustomFieldEncoder}}
 			data, err := {{.CustomFieldEncoder}}.MarshalBorsh(({{.PointerDeref}}(s.{{.Name}})), s)
+			if err == nil {
+				err = checkLength("byte slice", len(data), {{if .MaxLen}}{{.MaxLen}}{{else}}MaxSliceLen{{end}})
+			}
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal {{.Name}}: %v", err)
+				return borsh.EncodeFieldError("{{.Name}}", err)
 			}
 			appendBytes(buf, data)
 		{{else if .IsCustomElementEncoder}}
 			data, err := {{.CustomElementEncoder}}.MarshalBorsh(({{.PointerDeref}}(s.{{.Name}})), s)
+			if err == nil {
+				err = checkLength("byte slice", len(data), {{if .MaxLen}}{{.MaxLen}}{{else}}MaxSliceLen{{end}})
+			}
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal {{.Name}}: %v", err)
+				return borsh.EncodeFieldError("{{.Name}}", err)
 			}
 			appendBytes(buf, data)
+		{{ else if .Element.IsMap }}
+				// {{.Name}} ({{.BinaryTag}}) - map
+				{{template "marshalElement" dict "Var" (printf "s.%s" .Name) "Path" (printf "%q" .Name) "Max" .MaxLen "Shape" .Element}}
+
 		{{ else if or .IsSlice  .Element.IsSlice  }}
 				// {{.Name}} ({{.BinaryTag}}) - slice
 				// ElementType: {{.Element.TypeName}}
@@ -81,6 +129,8 @@ func (s {{.Name}}) MarshalBorsh() ([]byte, error) {
 					{{template "marshalScalarElement"  dict
 					"Var" (printf "s.%s" .Name)
 					"FieldName" .Name
+					"Path" (printf "%q" .Name)
+					"Max" .MaxLen
 					"TypeName" .Element.TypeName
 					"ElementType" .Element.ElementType
 					"IsPointer" .Element.IsPointer
@@ -101,6 +151,8 @@ func (s {{.Name}}) MarshalBorsh() ([]byte, error) {
 					{{template "marshalScalarElement" dict
 					"Var" (printf "s.%s" .Name)
 					"FieldName" .Name
+					"Path" (printf "%q" .Name)
+					"Max" .MaxLen
 					"ElementType" .ElementType
 					"TypeName" .TypeName
 					"IsSlice" .IsSlice
@@ -119,18 +171,12 @@ func (s {{.Name}}) MarshalBorsh() ([]byte, error) {
 			
 		}
 			{{if .IsPointer}}
-					SKIP{{.Name}}:
+					SKIP{{.Label}}:
 				{{end}}
 		{{end}}
 	{{end}}
 
-	
-		// Copy result before returning buffer to pool
-		result := make([]byte, buf.Len())
-		copy(result, buf.Bytes())
-		return result, nil
-		
-	
+	return nil
 }
 {{end}}
 `
\ No newline at end of file
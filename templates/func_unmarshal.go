@@ -6,24 +6,59 @@ const UnmarshalBorshTemplate = `// Code generated by bingen. DO NOT EDIT.
 
 // UnarshalBinary unmarshals binary data to {{.Name}}
 {{define "unmarshalBinary"}}
-func (s *{{.Name}}) UnmarshalBorsh(data []byte) (error) {
+func (s *{{.Receiver}}) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("{{.Name}}", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to {{.Name}} like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of {{.Name}}, without trailing bytes
+func (s *{{.Receiver}}) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("{{.Name}}", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads {{.Name}} in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of {{.Name}}, so wrap unbuffered readers in a bufio.Reader
+func (s *{{.Receiver}}) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("{{.Name}}", err)
+}
+
+// ReadBorsh decodes {{.Name}} nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to {{.Name}}
+func (s *{{.Receiver}}) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "{{.Name}} is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	{{if .Discriminator}}
+	if err := r.ReadDiscriminator(discriminator{{.Name}}); err != nil {
+		return err
+	}
+	{{end}}
 	// FIELDS: {{.Name}}
-	offset := 0
     var err error
     {{range .Fields}}
 		{{if not .ShouldIgnore}}
+		{{range .EmbeddedPointers}}
+		if s.{{.Path}} == nil {
+			s.{{.Path}} = new({{.TypeName}})
+		}
+		{{end}}
 		
 		
 		
 		{{ if  or .IsPointer .IsPointerSlice}}
-			{	ptr := data[offset]
-				if int(ptr) == 0 {
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("{{.Name}}", err)
+				}
+				if !present {
 					s.{{.Name }} = nil
-					offset++
-					goto SKIP{{.Name}}
+					goto SKIP{{.Label}}
 				} 
 			}
-				offset++
 					
 		{{ end }}
 	
@@ -31,10 +66,12 @@ func (s *{{.Name}}) UnmarshalBorsh(data []byte) (error) {
 
 		{{if .IsCustomFieldEncoder}}
 			
-				var itemData []byte
-				itemData, offset, err = getBytes(data, offset)
+				itemData, err := readByteSlice(r, {{if .MaxLen}}{{.MaxLen}}{{else}}MaxSliceLen{{end}})
+				if err != nil {
+					return r.FieldError("{{.Name}}", err)
+				}
 				if _v, err := {{.CustomFieldEncoder}}.UnmarshalBorsh(itemData); err != nil {
-					return fmt.Errorf("failed to unmarshal custom field encoder slice {{.Name}}]: %v", err)
+					return r.FieldError("{{.Name}}", err)
 				} else {
 				 	{{ if .Element.TypeName }}
 				 		_m := (_v).({{ .Element.TypeName }})
@@ -46,20 +83,26 @@ func (s *{{.Name}}) UnmarshalBorsh(data []byte) (error) {
 				}
 					
 		{{else if .IsCustomElementEncoder}}
-			var itemData []byte
-			itemData, offset, err = getBytes(data, offset)
+			itemData, err := readByteSlice(r, {{if .MaxLen}}{{.MaxLen}}{{else}}MaxSliceLen{{end}})
+			if err != nil {
+				return r.FieldError("{{.Name}}", err)
+			}
 			if _v, err := {{.CustomElementEncoder}}.UnmarshalBorsh(itemData); err != nil {
-				return fmt.Errorf("failed to unmarshal custom element encoder slice {{.Name}}]: %v", err)
+				return r.FieldError("{{.Name}}", err)
 			} else {
 				// _m := (_v).({{ .Element.TypeName}})
 				// s.{{.Name}} = {{.PointerRef}}_m
 					{{ if .Element.TypeName}}
-				 		_m := (_v).({{ .Element.TypeName}})
+				 		_m := {{ customDecoded .Element "_v" }}
 					{{else}}
 						_m := (_v)
 					{{end}}
 					s.{{.Name}} = {{.PointerRef}}_m
 			}
+		{{ else if .Element.IsMap }}
+				// {{.Name}} ({{.BinaryTag}}) - map
+				{{template "unmarshalElement" dict "Var" (printf "s.%s" .Name) "Path" (printf "%q" .Name) "Max" .MaxLen "Shape" .Element}}
+
 		{{ else if or .IsSlice  .Element.IsSlice  }}
 				// {{.Name}} ({{.BinaryTag}}) - slice
 				// ElementType: {{.Element.ElementType}}
@@ -84,6 +127,8 @@ func (s *{{.Name}}) UnmarshalBorsh(data []byte) (error) {
 					{{template "unmarshalScalarElement"  dict
 					"Var" (printf "s.%s" .Name)
 					"FieldName" .Name
+					"Path" (printf "%q" .Name)
+					"Max" .MaxLen
 					"ElementType" .Element.ElementType
 					"TypeName" .Element.TypeName
 					"IsPointer" .Element.IsPointer
@@ -91,6 +136,7 @@ func (s *{{.Name}}) UnmarshalBorsh(data []byte) (error) {
 					"PointerRef" .Element.PointerRef
 					"IsCustomElementEncoder" .Element.IsCustomElementEncoder
 					"CustomElementEncoder" .Element.CustomElementEncoder
+					"DecodedTypeName" .Element.DecodedTypeName
 					"IsStruct" .Element.IsStruct
 					"IsBasicType" .Element.IsBasicType
 					"Element" .Element.Element
@@ -104,6 +150,8 @@ func (s *{{.Name}}) UnmarshalBorsh(data []byte) (error) {
 					{{template "unmarshalScalarElement" dict
 					"Var" (printf "s.%s" .Name)
 					"FieldName" .Name
+					"Path" (printf "%q" .Name)
+					"Max" .MaxLen
 					"ElementType" .Element.ElementType
 					"TypeName" .Element.TypeName
 					"PointerRef" .Element.PointerRef
@@ -111,6 +159,7 @@ func (s *{{.Name}}) UnmarshalBorsh(data []byte) (error) {
 					"PointerDeref" .Element.PointerDeref
 					"IsCustomElementEncoder" .Element.IsCustomElementEncoder
 					"CustomElementEncoder" .Element.CustomElementEncoder
+					"DecodedTypeName" .Element.DecodedTypeName
 					"IsStruct" .Element.IsStruct
 					"IsBasicType" .Element.IsBasicType
 					"Element" .Element.Element
@@ -121,7 +170,7 @@ func (s *{{.Name}}) UnmarshalBorsh(data []byte) (error) {
 			
 		}
 			{{if .IsPointer}}
-					SKIP{{.Name}}:
+					SKIP{{.Label}}:
 				{{end}}
 		{{end}}
 	{{end}}
@@ -7,18 +7,36 @@ package {{.Package}}
 
 import (
 	"encoding/binary"
-	"errors"
-	"fmt"
-	"bytes"
-	{{if .Options.UsePooling}}"sync"{{end}}
-	{{if and .Options.ZeroCopy (not .Options.SafeMode)}}"unsafe"{{end}}
+	"reflect"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
 )
 
 // Binary encoding constants
 const (
 	BinaryVersion = 1
+	// MaxStringLen is the longest string in bytes, unless the field has a max:"N" tag
 	MaxStringLen = {{.Options.MaxStringLen}}
+	// MaxSliceLen is the most bytes of a byte slice and the most elements of a slice or map,
+	// unless the field has a max:"N" tag
 	MaxSliceLen  = {{.Options.MaxSliceLen}}
+	// MaxDecodeAlloc is the most memory in bytes one UnmarshalBorsh or ReadBorshFrom call
+	// allocates for strings, byte slices, slices and maps
+	MaxDecodeAlloc = {{.Options.MaxAlloc}}
+	// WireFormat is the wire layout this package was generated with ("legacy" or "borsh")
+	WireFormat = "{{.Options.Wire}}"
+	// LengthPrefixSize is the width of string, slice and byte array length prefixes
+	LengthPrefixSize = {{.Options.LengthPrefixSize}}
+	// NestedPrefixSize is the width of the length prefix written before nested structs
+	NestedPrefixSize = {{if .Options.IsBorshWire}}0{{else}}LengthPrefixSize{{end}}
+	// StrictDecoding makes UnmarshalBorsh and ReadBorshFrom reject input that is not in canonical form,
+	// as UnmarshalBorshStrict does: trailing bytes, bools and option flags other than 0 or 1,
+	// non-canonical NaNs and unsorted map keys
+	StrictDecoding = {{.Options.Strict}}
+	// MaxDepth is the deepest struct nesting accepted when decoding
+	MaxDepth = {{.Options.MaxDepth}}
 )
 
 type EncodeField struct {
@@ -27,130 +45,352 @@ type EncodeField struct {
 	Value any
 }
 
-{{if .Options.UsePooling}}
-// Buffer pool for encoding
+// The interfaces are those of the borsh runtime, so types generated in different packages satisfy the same ones
+type (
+	BinaryMarshaler      = borsh.BinaryMarshaler
+	BinaryUnmarshaler    = borsh.BinaryUnmarshaler
+	BinaryEncoder        = borsh.BinaryEncoder
+	BorshEncoder         = borsh.BorshEncoder
+	CustomElementEncoder = borsh.CustomElementEncoder
+)
+
+// Default encoders, which tags can name as custom encoders, e.g. ` + "`" + `msg:"data,_DefaultByteArrayEncoder"` + "`" + `
+var (
+	_DefaultJsonRawMessageEncoder = borsh.DefaultJsonRawMessageEncoder{}
+	_DefaultByteArrayEncoder      = borsh.DefaultByteArrayEncoder{}
+
+	// time.Time fields are written as i64 seconds and u32 nanoseconds unless the tag selects
+	// a precision, e.g. ` + "`" + `msg:"created,unixmilli"` + "`" + `
+	_CustomTimeTimeEncoder      = borsh.DefaultTimeEncoder{}
+	_CustomTimeUnixEncoder      = borsh.DefaultTimeEncoder{Unit: time.Second}
+	_CustomTimeUnixMilliEncoder = borsh.DefaultTimeEncoder{Unit: time.Millisecond}
+	_CustomTimeUnixNanoEncoder  = borsh.DefaultTimeEncoder{Unit: time.Nanosecond}
+	_CustomUuidUUIDEncoder      = borsh.DefaultUUIDEncoder{}
+)
 
-var binaryBufPoolXS = &sync.Pool{
-	New: func() interface{} {
-		return bytes.NewBuffer(make([]byte, 0, 1024))
-	},
+// Registry holds the structs of this package by type ID and discriminator. Fields typed by an
+// interface decode their values through it, and Registry.Decode unmarshals data into the struct
+// whose discriminator it starts with. Include the registries of other packages whose structs
+// such fields hold
+var Registry = borsh.NewRegistry()
+
+// The helpers below depend on the options this package was generated with,
+// so they are generated rather than part of the runtime
+
+// appendLength writes a length prefix of LengthPrefixSize bytes
+func appendLength(buf *borsh.Writer, n int) {
+	{{if .Options.IsBorshWire}}buf.WriteUint32(uint32(n)){{else}}buf.WriteUint16(uint16(n)){{end}}
 }
 
-var binaryBufPoolMD = &sync.Pool{
-	New: func() interface{} {
-		return bytes.NewBuffer(make([]byte, 0, 2048))
-	},
+// readLengthFrom reads a length prefix of LengthPrefixSize bytes
+func readLengthFrom(r *borsh.Reader) (int, error) {
+	{{if .Options.IsBorshWire}}n, err := r.ReadUint32(){{else}}n, err := r.ReadUint16(){{end}}
+	if err != nil {
+		return 0, err
+	}
+	// A u32 length does not fit in an int on 32-bit platforms
+	if int(n) < 0 {
+		return 0, r.Errorf(borsh.LengthLimit, "length %d does not fit in an int", n)
+	}
+	return int(n), nil
 }
 
-var binaryBufPoolLG = &sync.Pool{
-	New: func() interface{} {
-		return bytes.NewBuffer(make([]byte, 0, 8184))
-	},
+// newReader returns a Reader over data with the allocation limit of this package
+func newReader(data []byte, strict bool) *borsh.Reader {
+	r := borsh.NewBytesReader(data)
+	r.SetStrict(strict)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	return r
 }
-{{end}}
 
-{{if and .Options.ZeroCopy (not .Options.SafeMode)}}
-// bytesToStringUnsafe converts a byte slice to a string without copying
-//go:nosplit
-func bytesToStringUnsafe(b []byte) string {
-	return *(*string)(unsafe.Pointer(&b))
+// readString reads a length-prefixed string of at most max bytes.
+// A strict Reader rejects strings that are not valid UTF-8
+func readString(r *borsh.Reader, max int) (string, error) {
+	n, err := readLengthFrom(r)
+	if err != nil {
+		return "", err
+	}
+	if n > max {
+		return "", r.Errorf(borsh.LengthLimit, "string of %d bytes exceeds the limit of %d", n, max)
+	}
+	// Charge the string before a streaming Reader buffers its bytes
+	if err := r.Alloc(int64(n)); err != nil {
+		return "", err
+	}
+	b, err := r.Next(n)
+	if err != nil {
+		return "", err
+	}
+	if r.Strict() && !utf8.Valid(b) {
+		return "", r.Errorf(borsh.InvalidValue, "string is not valid UTF-8")
+	}
+	return string(b), nil
+}
+
+// readByteSlice reads a length-prefixed byte slice of at most max bytes
+func readByteSlice(r *borsh.Reader, max int) ([]byte, error) {
+	n, err := readLengthFrom(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > max {
+		return nil, r.Errorf(borsh.LengthLimit, "byte slice of %d bytes exceeds the limit of %d", n, max)
+	}
+	if err := r.Alloc(int64(n)); err != nil {
+		return nil, err
+	}
+	return r.Bytes(n)
 }
-{{end}}
 
-// Helper functions for appending data to a bytes.Buffer
-func appendUint16(buf *bytes.Buffer, v uint16) {
-	buf.Write([]byte{byte(v), byte(v >> 8)})
+// readCount reads the element count of a slice or map, of at most max elements
+func readCount(r *borsh.Reader, max int) (int, error) {
+	n, err := readLengthFrom(r)
+	if err != nil {
+		return 0, err
+	}
+	if n > max {
+		return 0, r.Errorf(borsh.LengthLimit, "%d elements exceed the limit of %d", n, max)
+	}
+	return n, nil
 }
 
-func appendUint32(buf *bytes.Buffer, v uint32) {
-	buf.Write([]byte{
-		byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24),
-	})
+// readBytes reads the length-prefixed bytes written by appendBytes
+func readBytes(r *borsh.Reader) ([]byte, error) {
+	n, err := readLengthFrom(r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Bytes(n)
 }
 
-func appendUint64(buf *bytes.Buffer, v uint64) {
-	buf.Write([]byte{
-		byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24),
-		byte(v >> 32), byte(v >> 40), byte(v >> 48), byte(v >> 56),
-	})
+// checkLength rejects a string or byte slice of n bytes, or a slice or map of n elements, that is
+// longer than max, so marshaling fails on values that decoding would refuse
+func checkLength(what string, n, max int) error {
+	if n <= max {
+		return nil
+	}
+	if what == "slice" || what == "map" {
+		return borsh.Errorf(borsh.LengthLimit, "%s of %d elements exceeds the limit of %d", what, n, max)
+	}
+	return borsh.Errorf(borsh.LengthLimit, "%s of %d bytes exceeds the limit of %d", what, n, max)
 }
 
-func appendBytes(buf *bytes.Buffer, data []byte) {
-	// Write length as uint16
-	appendUint16(buf, uint16(len(data)))
+func appendBytes(buf *borsh.Writer, data []byte) {
+	// Write length prefix
+	appendLength(buf, len(data))
 	// Write data
 	buf.Write(data)
 }
 
-func getBytes(data []byte, offset int) ([]byte, int, error) {
-	if offset+2 > len(data) {
-		return nil, offset, errors.New("buffer too short for length")
+// appendString writes a length-prefixed string without converting it to []byte
+func appendString(buf *borsh.Writer, str string) {
+	appendLength(buf, len(str))
+	buf.WriteString(str)
+}
+
+// appendNested writes an encoded nested struct.
+// The legacy layout prefixes it with its length, the Borsh layout writes it inline
+func appendNested(buf *borsh.Writer, data []byte) error {
+	{{if .Options.IsBorshWire}}buf.Write(data){{else}}if err := checkNestedLength(len(data)); err != nil {
+		return err
 	}
-	length := binary.LittleEndian.Uint16(data[offset : offset+2])
-	offset += 2
-	if offset+int(length) > len(data) {
-		return nil, offset, errors.New("buffer too short for data")
+	appendBytes(buf, data){{end}}
+	return nil
+}
+{{if not .Options.IsBorshWire}}
+// checkNestedLength rejects a nested struct too long for its u16 length prefix.
+// Strings, byte slices, slices and maps are bounded by MaxStringLen and MaxSliceLen instead
+func checkNestedLength(n int) error {
+	if n > 1<<16-1 {
+		return borsh.Errorf(borsh.LengthLimit, "nested struct of %d bytes exceeds the %d bytes of a length prefix", n, 1<<16-1)
 	}
-	return data[offset : offset+int(length)], offset + int(length), nil
+	return nil
+}
+{{end}}
+// writeNested writes the nested struct v as appendNested does. Generated structs,
+// from this package or any other, are written in place rather than encoded into a buffer first
+func writeNested(buf *borsh.Writer, v interface{}) error {
+	wt, ok := v.(borsh.WriterTo)
+	if !ok {
+		data, err := borsh.MarshalValue(v)
+		if err != nil {
+			return err
+		}
+		return appendNested(buf, data)
+	}
+	if err := borsh.CheckNil(v); err != nil {
+		return err
+	}
+	{{if not .Options.IsBorshWire}}
+	n, err := borsh.SizeOf(v)
+	if err != nil {
+		return err
+	}
+	if err := checkNestedLength(n); err != nil {
+		return err
+	}
+	appendLength(buf, n)
+	{{end}}
+	return wt.WriteBorsh(buf)
 }
 
-func getFixedBytes(data []byte, offset, length int) ([]byte, int, error) {
-	if offset+length > len(data) {
-		return nil, offset, errors.New("buffer too short for length")
+// readNested decodes a nested struct written by writeNested into v.
+// depth is the nesting depth of v
+func readNested(r *borsh.Reader, v interface{}, depth int) error {
+	{{if .Options.IsBorshWire}}
+	if rf, ok := v.(borsh.ReaderFrom); ok {
+		return rf.ReadBorsh(r, depth)
+	}
+	if r.Streaming() {
+		return r.Errorf(borsh.InvalidValue, "%T cannot be read from a stream without a ReadBorsh method", v)
+	}
+	if err := borsh.UnmarshalValue(r.Remaining(), v, depth); err != nil {
+		return borsh.Rebase(err, r.Pos())
+	}
+	// Inline structs carry no length, so the consumed size is the size of the decoded value
+	n, err := borsh.SizeOf(v)
+	if err != nil {
+		return err
 	}
-	return data[offset : offset+length], offset + length, nil
+	_, err = r.Next(n)
+	return err
+	{{else}}
+	itemData, err := readBytes(r)
+	if err != nil {
+		return err
+	}
+	return r.DecodePart(itemData, v, depth)
+	{{end}}
 }
-func marshalValue(v interface{}) ([]byte, error) {
-	if be, ok := v.([]byte); ok {
-			return be, nil
+
+// appendMap writes a Borsh map: the entry count followed by the entries sorted by key
+func appendMap(buf *borsh.Writer, entries []borsh.MapEntry) error {
+	if err := borsh.SortMapEntries(entries); err != nil {
+		return err
 	}
-	if be, ok := v.(string); ok {
-			return []byte(be), nil
+	appendLength(buf, len(entries))
+	for _, e := range entries {
+		buf.Write(e.Key)
+		buf.Write(e.Value)
 	}
-	if bm, ok := v.(BinaryMarshaler); ok {
-		return bm.MarshalBorsh()
+	return nil
+}
+
+// checkMapKey rejects a key that does not sort after the previous key of the map.
+// Keys are only checked by strict readers
+func checkMapKey(prev, key any, i int) error {
+	return borsh.CheckAscending(prev, key, i, "map key")
+}
+
+// checkSetElement rejects the element i of a set unless it sorts strictly after prev
+func checkSetElement(prev, elem any, i int) error {
+	return borsh.CheckAscending(prev, elem, i, "set element")
+}
+
+// Fields typed by a type parameter of a generic struct are written as nested values.
+// The type argument may be a generated struct or a pointer to one
+
+func appendParam[T any](buf *borsh.Writer, v T) error {
+	if err := borsh.CheckParam(v); err != nil {
+		return err
 	}
-	return nil, fmt.Errorf("unsupported type for marshaling: %T", v)
+	return writeNested(buf, v)
 }
 
-func unmarshalValue(data []byte, v interface{}) error {
-	if be, ok := v.(*[]byte); ok {
-		*be = data
-		return nil
+func sizeParam[T any](v T) (int, error) {
+	if err := borsh.CheckParam(v); err != nil {
+		return 0, err
 	}
-	if be, ok := v.(*string); ok {
-		*be = string(data)
-		return nil
+	n, err := borsh.SizeOf(v)
+	if err != nil {
+		return 0, err
 	}
-	if bu, ok := v.(BinaryUnmarshaler); ok {
-		return bu.UnmarshalBorsh(data)
+	return NestedPrefixSize + n, nil
+}
+
+func encodeParam[T any](v T) ([]byte, error) {
+	if err := borsh.CheckParam(v); err != nil {
+		return nil, err
 	}
-	return fmt.Errorf("unsupported type for unmarshaling: %T", v)
+	return borsh.EncodeValue(v)
 }
 
-func encodeValue(v interface{}) ([]byte, error) {
-	if be, ok := v.([]byte); ok {
-		return be, nil
+// readParam decodes a value written by appendParam into v
+func readParam[T any](r *borsh.Reader, v *T, depth int) error {
+	if _, ok := any(v).(BinaryUnmarshaler); ok {
+		return readNested(r, v, depth)
+	}
+	// Pointer type arguments get a new value to decode into
+	p, err := borsh.NewParam[T]()
+	if err != nil {
+		return err
 	}
-	if be, ok := v.(string); ok {
-		return []byte(be), nil
+	if err := readNested(r, p, depth); err != nil {
+		return err
 	}
-		
-	if be, ok := v.(BinaryEncoder); ok {
-		return be.Encode()
+	*v = p
+	return nil
+}
+
+// Fields typed by an interface that is not a Borsh enum, such as any, are written as the type ID
+// of their value followed by the value as a nested struct. The value is a generated struct or a
+// pointer to one, and decodes through Registry
+
+func appendAny(buf *borsh.Writer, v any) error {
+	id, err := borsh.TypeIDOf(v)
+	if err != nil {
+		return err
 	}
-	return nil, fmt.Errorf("unsupported type for encoding: %T", v)
+	buf.WriteUint64(id)
+	return writeNested(buf, v)
 }
 
+func sizeAny(v any) (int, error) {
+	if _, err := borsh.TypeIDOf(v); err != nil {
+		return 0, err
+	}
+	n, err := borsh.SizeOf(v)
+	if err != nil {
+		return 0, err
+	}
+	return borsh.TypeIDSize + NestedPrefixSize + n, nil
+}
 
-func binarySize(v interface{}) (int, error) {
-	if be, ok := v.([]byte); ok {
-		return len(be), nil
+func encodeAny(v any) ([]byte, error) {
+	id, err := borsh.TypeIDOf(v)
+	if err != nil {
+		return nil, err
 	}
-	if be, ok := v.(BinaryMarshaler); ok {
-		return be.BinarySize()
+	data, err := borsh.EncodeValue(v)
+	if err != nil {
+		return nil, err
 	}
-	return 0, fmt.Errorf("unsupported type for binary size: %T", v)
+	return append(binary.LittleEndian.AppendUint64(nil, id), data...), nil
 }
 
-`
\ No newline at end of file
+// readAny decodes a value written by appendAny into v, whose type T is the interface of the field.
+// The struct registered under the type ID is stored as a value, or as a pointer when only the
+// pointer implements T
+func readAny[T any](r *borsh.Reader, v *T, depth int) error {
+	id, err := r.ReadUint64()
+	if err != nil {
+		return err
+	}
+	p, ok := Registry.New(id)
+	if !ok {
+		return r.Errorf(borsh.UnknownType, "no type is registered for type ID %#x", id)
+	}
+	if err := readNested(r, p, depth); err != nil {
+		return err
+	}
+	if value, ok := reflect.ValueOf(p).Elem().Interface().(T); ok {
+		*v = value
+		return nil
+	}
+	if value, ok := p.(T); ok {
+		*v = value
+		return nil
+	}
+	return r.Errorf(borsh.InvalidValue, "%T is not a %s", p, reflect.TypeFor[T]())
+}
+`
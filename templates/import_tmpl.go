@@ -0,0 +1,66 @@
+package templates
+
+// ImportTemplate is the Go file written by borshgen import: a struct for each struct of the
+// schema with a directive generating its codecs on the borsh wire, an interface for each enum with
+// struct variants and an integer type for each enum of unit variants
+const ImportTemplate = `// Code generated by borshgen import from {{.Source}}. DO NOT EDIT.
+
+//go:generate borshgen import {{.Command}}
+
+package {{.Package}}
+{{- if .Imports}}
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{- end}}
+{{- range .Types}}
+{{if eq .Kind "value"}}
+{{- range .Doc}}
+// {{.}}
+{{- end}}
+//
+//borshgen:enum{{if ne .Width "u8"}} {{.Width}}{{end}}
+type {{.Name}} {{.Underlying}}
+
+const (
+{{- $enum := .Name}}
+{{- range .Constants}}
+	{{.Name}} {{$enum}} = {{.Value}}
+{{- end}}
+)
+{{- else if eq .Kind "enum"}}
+{{- range .Doc}}
+// {{.}}
+{{- end}}
+//
+//borshgen:enum {{.Name}} = {{range $i, $v := .Variants}}{{if $i}} | {{end}}{{$v}}{{end}}
+type {{.Name}} interface {
+	is{{.Name}}()
+}
+{{$enum := .Name}}
+{{- range .Variants}}
+func ({{.}}) is{{$enum}}() {}
+{{- end}}
+{{- else}}
+{{- range .Doc}}
+// {{.}}
+{{- end}}
+//
+{{- if .Discriminator}}
+//borshgen:discriminator {{.Discriminator}}
+{{- end}}
+//go:generate borshgen {{$.Directive}}
+type {{.Name}} struct {{if .Fields}}{
+{{- range .Fields}}
+{{- range .Doc}}
+	// {{.}}
+{{- end}}
+	{{.Name}} {{.Type}} ` + "`{{.Tag}}`" + `
+{{- end}}
+}{{else}}{}{{end}}
+{{- end}}
+{{- end}}
+`
@@ -0,0 +1,122 @@
+package templates
+
+// RustTemplate is the Rust module written with -lang=rust: a struct for each struct, deriving
+// BorshSerialize and BorshDeserialize to the bytes of MarshalBorsh, and the enums the structs
+// refer to. Fields the derive does not write as Go does are serialized through borshgen.rs
+const RustTemplate = `// Code generated by borshgen from {{.Source}}. DO NOT EDIT.
+
+#![allow(dead_code)]
+
+use borsh::{BorshDeserialize, BorshSerialize};
+{{- if and .Maps .Sets}}
+use std::collections::{BTreeMap, BTreeSet};
+{{- else if .Maps}}
+use std::collections::BTreeMap;
+{{- else if .Sets}}
+use std::collections::BTreeSet;
+{{- end}}
+
+use super::borshgen;
+
+/// Wire is the layout of the Go code generated from {{.Source}}
+pub type Wire = borshgen::{{.Wire}};
+{{- range .Enums}}
+{{if .Repr}}
+/// {{.Name}} is the C-style enum {{.GoName}}, written as a {{.Repr}}
+#[derive(Clone, Copy, Debug, PartialEq, Eq, PartialOrd, Ord, Hash)]
+#[repr({{.Repr}})]
+pub enum {{.Name}} {
+{{- range .Variants}}
+    {{.Name}} = {{.Value}},
+{{- end}}
+}
+
+impl BorshSerialize for {{.Name}} {
+    fn serialize<W: borsh::io::Write>(&self, writer: &mut W) -> borsh::io::Result<()> {
+        (*self as {{.Repr}}).serialize(writer)
+    }
+}
+
+impl BorshDeserialize for {{.Name}} {
+    fn deserialize_reader<R: borsh::io::Read>(reader: &mut R) -> borsh::io::Result<Self> {
+        match {{.Repr}}::deserialize_reader(reader)? {
+{{- range .Variants}}
+            {{.Value}} => Ok(Self::{{.Name}}),
+{{- end}}
+            v => Err(borsh::io::Error::new(borsh::io::ErrorKind::InvalidData, format!("invalid {{.Name}} {v}"))),
+        }
+    }
+}
+{{- else}}
+/// {{.Name}} is the Borsh enum {{.GoName}}, a u8 variant index followed by the variant
+#[derive(BorshSerialize, BorshDeserialize, Clone, Debug, PartialEq)]
+pub enum {{.Name}} {
+{{- range .Variants}}
+    {{.Name}}({{if .Attrs}}{{.Attrs}} {{end}}{{.Type}}),
+{{- end}}
+}
+{{- end}}
+
+impl<W: borshgen::Wire> borshgen::Go<W> for {{.Name}} {
+    fn write_go<Wr: borsh::io::Write>(&self, w: &mut Wr) -> borsh::io::Result<()> {
+        self.serialize(w)
+    }
+    fn read_go<R: borsh::io::Read>(r: &mut R) -> borsh::io::Result<Self> {
+        Self::deserialize_reader(r)
+    }
+}
+{{- end}}
+{{- range .Structs}}
+{{- if .Discriminator}}
+
+/// {{.Name}}Discriminator is the discriminator {{.Name}} starts with
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, PartialOrd, Ord, Hash)]
+pub struct {{.Name}}Discriminator;
+
+impl {{.Name}}Discriminator {
+    pub const BYTES: [u8; {{.DiscriminatorLen}}] = [{{.Discriminator}}];
+}
+
+impl BorshSerialize for {{.Name}}Discriminator {
+    fn serialize<W: borsh::io::Write>(&self, writer: &mut W) -> borsh::io::Result<()> {
+        writer.write_all(&Self::BYTES)
+    }
+}
+
+impl BorshDeserialize for {{.Name}}Discriminator {
+    fn deserialize_reader<R: borsh::io::Read>(reader: &mut R) -> borsh::io::Result<Self> {
+        borshgen::read_discriminator(reader, &Self::BYTES).map(|()| Self)
+    }
+}
+{{- end}}
+
+/// {{.Name}} is {{.GoName}}
+#[derive(BorshSerialize, BorshDeserialize, Clone, Debug, PartialEq{{if .Key}}, Eq, PartialOrd, Ord, Hash{{end}})]
+pub struct {{.Name}}{{.Generics}} {
+{{- if .Discriminator}}
+    pub discriminator: {{.Name}}Discriminator,
+{{- end}}
+{{- range .Fields}}
+{{- if .Attrs}}
+    {{.Attrs}}
+{{- end}}
+    pub {{.Name}}: {{.Type}},
+{{- end}}
+}
+
+impl<W: borshgen::Wire{{range .TypeParams}}, {{.}}{{end}}> borshgen::Go<W> for {{.Name}}{{.Generics}}
+{{- if .TypeParams}}
+where
+    Self: BorshSerialize + BorshDeserialize,
+{
+{{- else}} {
+{{- end}}
+    fn write_go<Wr: borsh::io::Write>(&self, w: &mut Wr) -> borsh::io::Result<()> {
+        borshgen::write_nested::<W, Self, _>(self, w)
+    }
+    fn read_go<R: borsh::io::Read>(r: &mut R) -> borsh::io::Result<Self> {
+        borshgen::read_nested::<W, Self, _>(r)
+    }
+}
+{{- end}}
+`
@@ -16,13 +16,58 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 	{{else if .IsBasicType}}
 			 {{if eq .ElementType "string"}}
 				ptr := {{.PointerDeref}}{{.Var}}
-				size += 2 + len([]byte(ptr))
+				size += LengthPrefixSize + len([]byte(ptr))
 
 			{{else if eq .ElementType "[]byte"}}
 				ptr := {{.PointerDeref}}{{.Var}}
-				size += 2 + len(ptr)
+				size += LengthPrefixSize + len(ptr)
 
-			{{else if or (eq .ElementType "uint64") (eq .ElementType "int64")   (eq .ElementType "int") (eq .ElementType "float64")}}
+			{{else if eq .ElementType "enum"}}
+				{
+					_s, err := sizeEnum{{.TypeName}}({{.Var}})
+					if err != nil {
+						return 0, borsh.EncodeFieldError("{{.FieldName}}", err)
+					}
+					size += _s
+				}
+
+			{{else if eq .ElementType "param"}}
+				{
+					_s, err := sizeParam({{.PointerDeref}}{{.Var}})
+					if err != nil {
+						return 0, borsh.EncodeFieldError("{{.FieldName}}", err)
+					}
+					size += _s
+				}
+
+			{{else if eq .ElementType "any"}}
+				{
+					_s, err := sizeAny({{.PointerDeref}}{{.Var}})
+					if err != nil {
+						return 0, borsh.EncodeFieldError("{{.FieldName}}", err)
+					}
+					size += _s
+				}
+
+			{{else if eq .ElementType "enum_u8"}}
+				size += 1
+
+			{{else if eq .ElementType "enum_u16"}}
+				size += 2
+
+			{{else if eq .ElementType "enum_u32"}}
+				size += 4
+
+			{{else if or (eq .ElementType "u128") (eq .ElementType "i128") (eq .ElementType "uint128") (eq .ElementType "int128") (eq .ElementType "uuid")}}
+				size += 16
+
+			{{else if eq .ElementType "time"}}
+				size += 12
+
+			{{else if timeEncoder .ElementType}}
+				size += 8
+
+			{{else if or (eq .ElementType "uint64") (eq .ElementType "int64")   (eq .ElementType "int") (eq .ElementType "uint") (eq .ElementType "float64")}}
 				size += 8
 
 			{{else if or (eq .ElementType "uint32") (eq .ElementType "int32") (eq .ElementType "float32") (eq .ElementType "rune")}}
@@ -36,7 +81,7 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 
 			{{else}}
 				// Fallback estimate for custom or unknown pointer types
-				size += 2 + 64
+				size += LengthPrefixSize + 64
 			{{end}}
 
 	{{else if .IsPointer}}
@@ -44,7 +89,7 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 		{{if .Element}}
 			// Element: {{.Element.IsBasicType}}
 			{{template "binarySizeScalarElement" dict
-							"Var" (printf "s.%s" .FieldName)
+							"Var" .Var
 							"FieldName" .FieldName
 							"ElementType" .Element.ElementType
 							"TypeName" .Element.TypeName
@@ -61,7 +106,7 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 			{{else}}
 		
 				{{template "binarySizeScalarElement" dict
-							"Var" (printf "s.%s" .FieldName)
+							"Var" .Var
 							"FieldName" .FieldName
 							"ElementType" .ElementType
 							"TypeName" .TypeName
@@ -79,14 +124,14 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 		
 
 	{{else if .IsStruct}}
-			bs, err := binarySize({{.Var}})
+			bs, err := borsh.SizeOf({{.Var}})
 			if err != nil {
-				return 0, err
+				return 0, borsh.EncodeFieldError("{{.FieldName}}", err)
 			}
-					size += 2 + bs
+					size += NestedPrefixSize + bs
 
 	{{else}}
-			// {{.Var}} ({{.}}) - custom type
+			// {{.Var}} - custom type
 			// VarVar {{.Var}}
 			{{if or .Element .HasElement }}
 				// Element: {{.Element.ElementType}}
@@ -106,11 +151,11 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 				}}
 			{{ else   }}
 				// Element: No Element
-					_s, err := binarySize({{.Var}})
+					_s, err := borsh.SizeOf({{.Var}})
 					if err != nil {
 						panic(err)
 					}
-						size += _s
+						size += NestedPrefixSize + _s
 				
 				{{end}}
 			{{end}}
@@ -125,7 +170,7 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 
 {{else if and .IsSlice (not .IsFixedArray) }}
 	// Slice of {{.Field.Name}}: []{{.Field.Name}}
-	size += 2 // for slice length
+	size += LengthPrefixSize // for slice length
 	
 		for _, item := range {{.PointerDeref}}(s.{{.Field.Name}}) {
 			_ = item
@@ -141,7 +186,7 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 	{{template "binarySizeSlice" .Element}}
 {{else}}
 	// Direct encoding
-	d, _ := binarySize(s.{{.Field.Name}})
+	d, _ := borsh.SizeOf(s.{{.Field.Name}})
 	buf = appendBytes(buf, d)
 {{end}}
 {{end}}
@@ -155,19 +200,32 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 				if err != nil {
 					panic(fmt.Sprintf("failed to calculate binary size for custom encoder {{.Var}}: %v", err))
 				}
-				size += 2 + _s
+				size += LengthPrefixSize + _s
 		
+{{else if .Shape.IsMap}}
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range {{.Shape.PointerDeref}}({{.Var}}) {
+			_, _ = mk, mv
+			{
+				{{template "binarySizeElement" dict "Var" "mk" "Shape" .Shape.Key}}
+			}
+			{{if not .Shape.IsSet}}
+			{
+				{{template "binarySizeElement" dict "Var" "mv" "Shape" .Shape.Element}}
+			}
+			{{end}}
+		}
 {{else if and .Shape.IsSlice (not .Shape.IsFixedArray) }}
 		{{ if .Shape.IsPointerSlice}}
 			{	if {{.Var}} == nil {
-					goto SKIP{{.Field.Name}}
+					goto SKIP{{.Field.Label}}
 				}
 			}
 		{{end}}
-		size += 2 // for length prefix
+		size += LengthPrefixSize // for length prefix
 		// ElementIsSlice: {{ .Shape.IsSlice}}
 		for _, item := range {{.Shape.PointerDeref}}({{.Var}}) {
-
+			_ = item
 			{{template "binarySizeElement" dict "Var" "item" "Index" .Shape.Index "Shape" .Shape.Element}}
 		}
 {{else if .Shape.IsFixedArray}}
@@ -179,7 +237,6 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 			// NONSLICE:
 			// IsBasice {{ .Shape.IsBasicType}}
 			// ElementType {{ .Shape.ElementType }}
-			// Element {{ .Shape.Element }}
 			// Element {{ .Shape.TypeName }}
 					{{template "binarySizeScalarElement" dict
 						"Var" .Var
@@ -201,12 +258,11 @@ const BinarySizeTemplate = `// Code generated by bingen. DO NOT EDIT.
 // No Shape
 // Field: {{.Field.Name}}
 // Var: {{.Var}}
-// Element: {{.Element}}
-				_s, err := binarySize({{.Var}})
+				_s, err := borsh.SizeOf({{.Var}})
 				if err != nil {
 					panic(fmt.Sprintf("failed to calculate binary size for custom encoder {{.Var}}: %v", err))
 				}
-				size += 2 + _s
+				size += LengthPrefixSize + _s
 {{end}}
 {{end}}
 
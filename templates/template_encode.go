@@ -7,8 +7,11 @@ const EncodeTemplate = `// Code generated by bingen. DO NOT EDIT.
 	
 	{{if .IsCustomElementEncoder}}
 		data, err := {{.CustomElementEncoder}}.Encode(({{.PointerDeref}}{{.Var}}), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}})
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to encode {{.FieldName}}: %v", err)
+			return borsh.EncodeFieldError({{.Path}}, err)
 		}
 		buf.Write(data)
 	{{ else if .IsSlice  }}
@@ -23,35 +26,79 @@ const EncodeTemplate = `// Code generated by bingen. DO NOT EDIT.
 					
 					{{if eq .ElementType "string"}}
 					str := {{.Var}}
-					if len(str) > MaxStringLen {
-						return nil, fmt.Errorf("{{.Field.Name}} too long: %d bytes", len(str))
+					if err := checkLength("string", len(str), {{if .Max}}{{.Max}}{{else}}MaxStringLen{{end}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
 					}
 					buf.Write([]byte(str))
 
 					{{else if eq .ElementType "[]byte"}}
 					data := {{.Var}}
-					if len(data) > MaxSliceLen {
-						return nil, fmt.Errorf("{{.Field.Name}} too long: %d bytes", len(data))
+					if err := checkLength("byte slice", len(data), {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
 					}
 					buf.Write(data)
 
-					{{else if or (eq .ElementType "int64") (eq .ElementType "uint64") (eq .ElementType "int")}}
-					appendUint64(buf, uint64({{.PointerDeref}}{{.Var}}))
+					{{else if eq .ElementType "enum"}}
+					if err := appendEnum{{.TypeName}}(buf, {{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if or (eq .ElementType "enum_u8") (eq .ElementType "enum_u16") (eq .ElementType "enum_u32")}}
+					if !({{.PointerDeref}}{{.Var}}).Valid() {
+						return borsh.EncodeFieldError({{.Path}}, fmt.Errorf("invalid enum value %d", {{.PointerDeref}}{{.Var}}))
+					}
+					{{if eq .ElementType "enum_u8"}}buf.WriteByte(byte({{.PointerDeref}}{{.Var}})){{else if eq .ElementType "enum_u16"}}buf.WriteUint16(uint16({{.PointerDeref}}{{.Var}})){{else}}buf.WriteUint32(uint32({{.PointerDeref}}{{.Var}})){{end}}
+
+					{{else if eq .ElementType "param"}}
+					data, err := encodeParam({{.PointerDeref}}{{.Var}})
+					if err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+					buf.Write(data)
+
+					{{else if eq .ElementType "any"}}
+					data, err := encodeAny({{.PointerDeref}}{{.Var}})
+					if err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+					buf.Write(data)
+
+					{{else if eq .ElementType "u128"}}
+					if err := buf.WriteU128({{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if eq .ElementType "i128"}}
+					if err := buf.WriteI128({{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if timeEncoder .ElementType}}
+					if err := {{timeEncoder .ElementType}}.Write(buf, {{.PointerDeref}}{{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if or (eq .ElementType "uint128") (eq .ElementType "int128") (eq .ElementType "uuid")}}
+					_v128 := {{.PointerDeref}}{{.Var}}
+					buf.Write(_v128[:])
+
+					{{else if or (eq .ElementType "int64") (eq .ElementType "uint64") (eq .ElementType "int") (eq .ElementType "uint")}}
+					buf.WriteUint64(uint64({{.PointerDeref}}{{.Var}}))
 
 					{{else if or (eq .ElementType "int32") (eq .ElementType "uint32")}}
-					appendUint32(buf, uint32({{.PointerDeref}}{{.Var}}))
+					buf.WriteUint32(uint32({{.PointerDeref}}{{.Var}}))
 
 					{{else if or (eq .ElementType "int16") (eq .ElementType "uint16")}}
-					appendUint16(buf, uint16({{.PointerDeref}}{{.Var}}))
+					buf.WriteUint16(uint16({{.PointerDeref}}{{.Var}}))
 
 					{{else if or (eq .ElementType "int8") (eq .ElementType "uint8") (eq .ElementType "byte")}}
 					buf.WriteByte(byte({{.PointerDeref}}{{.Var}}))
 
 					{{else if eq .ElementType "float32"}}
-					appendUint32(buf, math.Float32bits({{.PointerDeref}}{{.Var}}))
+					buf.WriteFloat32({{.PointerDeref}}{{.Var}})
 
 					{{else if eq .ElementType "float64"}}
-					appendUint64(buf, math.Float64bits({{.PointerDeref}}{{.Var}}))
+					buf.WriteFloat64({{.PointerDeref}}{{.Var}})
 
 					{{else if eq .ElementType "bool"}}
 					if {{.PointerDeref}}{{.Var}} {
@@ -62,20 +109,20 @@ const EncodeTemplate = `// Code generated by bingen. DO NOT EDIT.
 					{{end}}
 
 	{{else if .IsStruct}}
-					nestedData, err := encodeValue({{.Var}})
-					if err != nil {
-						return nil, fmt.Errorf("failed to encode {{.Field.Name}}: %v", err)
+					if err := borsh.WriteEncoded(buf, {{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
 					}
-					buf.Write(nestedData)
 
 
 	{{else}}
-			// {{.Var}} ({{.}}) - custom type
+			// {{.Var}} - custom type
 			{{if or .Element .HasElement }}
 				// Element: {{.Element.ElementType}}
 				{{template "encodeScalarElement" dict
 								"Var" (printf "s.%s" .FieldName)
 								"FieldName" .Field.Name
+								"Path" .Path
+								"Max" .Max
 								"TypeName" .Element.TypeName
 								"PointerRef" .Element.PointerRef
 								"ElementType" .Element.ElementType
@@ -91,11 +138,9 @@ const EncodeTemplate = `// Code generated by bingen. DO NOT EDIT.
 			{{ else   }}
 				// Element: No Element
 					
-					data, err := encodeValue({{.PointerDeref}}{{.Var}})
-					if err != nil {
-						return nil, fmt.Errorf("failed to encode custom type {{.Name}}: %v", err)
+					if err := borsh.WriteEncoded(buf, {{.PointerDeref}}{{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
 					}
-					buf.Write(data)
 					{{end}}
 					
 			
@@ -108,26 +153,33 @@ const EncodeTemplate = `// Code generated by bingen. DO NOT EDIT.
 {{define "encodeSlice"}}
 {{if .IsCustomElementEncoder }}
 	data, err := {{.CustomElementEncoder}}.Encode(({{.PointerDeref}}s.{{.Field.Name}}), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), {{if .Field.MaxLen}}{{.Field.MaxLen}}{{else}}MaxSliceLen{{end}})
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to encode {{.Field.Name}}: %v", err)
+			return borsh.EncodeFieldError("{{.Field.Name}}", err)
 		}
 		buf.Write(data)
 
 {{else if and .IsSlice (not .IsFixedArray) }}
 	// Slice of {{.Field.Name}}: []{{.Field.Name}}
-		for _, item := range {{.PointerDeref}}(s.{{.Field.Name}}) {
-			{{template "encodeElement" dict "Var" "item" "Shape" .Element}}
+	if err := checkLength("slice", len({{.PointerDeref}}(s.{{.Field.Name}})), {{if .Field.MaxLen}}{{.Field.MaxLen}}{{else}}MaxSliceLen{{end}}); err != nil {
+		return borsh.EncodeFieldError("{{.Field.Name}}", err)
+	}
+		for i, item := range {{.PointerDeref}}(s.{{.Field.Name}}) {
+			_ = i
+			{{template "encodeElement" dict "Var" "item" "Path" (printf "borsh.Index(%q, i)" .Field.Name) "Shape" .Element}}
 		}
 {{else if .IsFixedArray}}
 	// Fixed array of length {{.IsFixedArray}}: [{{.FixedArrayLength}}]{{.Field.Name}}
 	for i := 0; i < {{.FixedArrayLength}}; i++ {
-		{{template "encodeElement" dict "Var" (printf "s.%s[i]" .Field.Name) "Shape" .Element}}
+		{{template "encodeElement" dict "Var" (printf "s.%s[i]" .Field.Name) "Path" (printf "borsh.Index(%q, i)" .Field.Name) "Shape" .Element}}
 	}
 {{else if .IsPointer }}
 	{{template "encodeSlice" .Element}}
 {{else}}
 	// Direct encoding
-	d, _ := encodeValue(s.{{.Field.Name}})
+	d, _ := borsh.EncodeValue(s.{{.Field.Name}})
 	buf.Write(d)
 {{end}}
 {{end}}
@@ -137,27 +189,66 @@ const EncodeTemplate = `// Code generated by bingen. DO NOT EDIT.
 {{if .Shape }}
 {{if .Shape.IsCustomElementEncoder}}
 		data, err := {{.Shape.CustomElementEncoder}}.Encode(({{.Shape.PointerDeref}}{{.Var}}), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}})
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to encode {{.Shape.Field.Name}}: %v", err)
+			return borsh.EncodeFieldError({{.Path}}, err)
 		}
 		buf.Write(data)
+{{else if .Shape.IsMap}}
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len({{.Shape.PointerDeref}}({{.Var}})), {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}}); err != nil {
+				return borsh.EncodeFieldError({{.Path}}, err)
+			}
+			entries := make([]borsh.MapEntry, 0, len({{.Shape.PointerDeref}}({{.Var}})))
+			for mk, mv := range {{.Shape.PointerDeref}}({{.Var}}) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+					{{template "encodeElement" dict "Var" "mk" "Path" (printf "borsh.Key(%s, mk)" .Path) "Shape" .Shape.Key}}
+					entry.Key = buf.Bytes()
+				}
+				{{if not .Shape.IsSet}}
+				{
+					buf := borsh.NewBufferWriter(nil)
+					{{template "encodeElement" dict "Var" "mv" "Path" (printf "borsh.Key(%s, mk)" .Path) "Shape" .Shape.Element}}
+					entry.Value = buf.Bytes()
+				}
+				{{end}}
+				entries = append(entries, entry)
+			}
+			if err := borsh.SortMapEntries(entries); err != nil {
+				return borsh.EncodeFieldError({{.Path}}, err)
+			}
+			for _, entry := range entries {
+				buf.Write(entry.Key)
+				buf.Write(entry.Value)
+			}
+		}
 {{else if .Shape.IsSlice}}
 		// ElementIsSlice: {{ .Shape.IsSlice}}
-		for _, inner := range {{.Shape.PointerDeref}}({{.Var}}) {
-			{{template "encodeElement" dict "Var" "inner" "Shape" .Shape.Element}}
+		if err := checkLength("slice", len({{.Shape.PointerDeref}}({{.Var}})), MaxSliceLen); err != nil {
+			return borsh.EncodeFieldError({{.Path}}, err)
+		}
+		for i{{.Index}}, inner := range {{.Shape.PointerDeref}}({{.Var}}) {
+			_ = i{{.Index}}
+			{{template "encodeElement" dict "Var" "inner" "Path" (printf "borsh.Index(%s, i%d)" .Path .Index) "Index" .Shape.Index "Shape" .Shape.Element}}
 		}
 {{else if .Shape.IsFixedArray}}
-	for j := 0; j < {{.Shape.FixedArrayLength}}; j++ {
-		{{template "encodeElement" dict "Var" (printf "%s[j]" .Var) "Shape" .Shape.Element}}
+	for j{{.Index}} := 0; j{{.Index}} < {{.Shape.FixedArrayLength}}; j{{.Index}}++ {
+		{{template "encodeElement" dict "Var" (printf "%s[j%d]" .Var .Index) "Path" (printf "borsh.Index(%s, j%d)" .Path .Index) "Index" .Shape.Index "Shape" .Shape.Element}}
 	}
 {{else}}
 			// NONSLICE:
 			// IsBasice {{ .Shape.IsBasicType}}
 			// ElementType {{ .Shape.ElementType }}
-			// Element {{ .Shape.Element }}
 					{{template "encodeScalarElement" dict
 						"Var" .Var
 						"FieldName" .Shape.Field.Name
+						"Path" .Path
 						"TypeName" .Shape.TypeName
 						"PointerRef" .Shape.PointerRef
 						"ElementType" .Shape.ElementType
@@ -175,10 +266,9 @@ const EncodeTemplate = `// Code generated by bingen. DO NOT EDIT.
 // No Shape
 // Field: {{.Field.Name}}
 // Var: {{.Var}}
-// Element: {{.Element}}
- 		data, err := encodeValue({{.Var}})
+ 		data, err := borsh.EncodeValue({{.Var}})
 		if err != nil {
-			return nil, fmt.Errorf("failed to encode {{.FieldName}}: %v", err)
+			return fmt.Errorf("failed to encode {{.FieldName}}: %v", err)
 		}
 		buf.Write(data)
 {{end}}
@@ -0,0 +1,144 @@
+package templates
+
+// Borsh enum (tagged union) helpers, one set per //borshgen:enum directive
+const EnumTemplate = `// Code generated by bingen. DO NOT EDIT.
+
+{{define "enum"}}
+// appendEnum{{.Name}} writes the variant index of v followed by the variant
+func appendEnum{{.Name}}(buf *borsh.Writer, v {{.Name}}) error {
+	var err error
+	switch x := v.(type) {
+	{{range .Variants}}
+	{{if not .IsPointer}}
+	case {{.Name}}:
+		buf.WriteByte({{.Index}})
+		err = writeNested(buf, x)
+	{{end}}
+	case *{{.Name}}:
+		if x == nil {
+			return fmt.Errorf("nil {{$.Name}} variant {{.Name}}")
+		}
+		buf.WriteByte({{.Index}})
+		err = writeNested(buf, x)
+	{{end}}
+	case nil:
+		return fmt.Errorf("nil {{.Name}}")
+	default:
+		return fmt.Errorf("unknown {{.Name}} variant %T", v)
+	}
+	return err
+}
+
+// readEnum{{.Name}} reads the variant index and decodes the matching variant at depth
+func readEnum{{.Name}}(r *borsh.Reader, depth int) ({{.Name}}, error) {
+	index, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch index {
+	{{range .Variants}}
+	case {{.Index}}:
+		m := &{{.Name}}{}
+		if err := readNested(r, m, depth); err != nil {
+			return nil, err
+		}
+		return {{if not .IsPointer}}*{{end}}m, nil
+	{{end}}
+	default:
+		return nil, r.Errorf(borsh.UnknownVariant, "unknown {{.Name}} variant index %d", index)
+	}
+}
+
+// sizeEnum{{.Name}} returns the encoded size of v including the variant index
+func sizeEnum{{.Name}}(v {{.Name}}) (int, error) {
+	var (
+		size int
+		err  error
+	)
+	switch x := v.(type) {
+	{{range .Variants}}
+	{{if not .IsPointer}}
+	case {{.Name}}:
+		size, err = x.BinarySize()
+	{{end}}
+	case *{{.Name}}:
+		if x == nil {
+			return 0, fmt.Errorf("nil {{$.Name}} variant {{.Name}}")
+		}
+		size, err = x.BinarySize()
+	{{end}}
+	case nil:
+		return 0, fmt.Errorf("nil {{.Name}}")
+	default:
+		return 0, fmt.Errorf("unknown {{.Name}} variant %T", v)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return 1 + NestedPrefixSize + size, nil
+}
+
+// Marshal{{.Name}} encodes v as a Borsh enum: a 1-byte variant index followed by the variant
+func Marshal{{.Name}}(v {{.Name}}) ([]byte, error) {
+	size, err := sizeEnum{{.Name}}(v)
+	if err != nil {
+		return nil, borsh.EncodeFieldError("{{.Name}}", err)
+	}
+	buf := borsh.NewBufferWriter(make([]byte, 0, size))
+	if err := appendEnum{{.Name}}(buf, v); err != nil {
+		return nil, borsh.EncodeFieldError("{{.Name}}", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal{{.Name}} decodes a Borsh enum into the {{.Name}} variant selected by its index
+func Unmarshal{{.Name}}(data []byte) ({{.Name}}, error) {
+	r := newReader(data, StrictDecoding)
+	v, err := readEnum{{.Name}}(r, 0)
+	if err == nil && StrictDecoding && len(r.Remaining()) > 0 {
+		err = r.Errorf(borsh.TrailingBytes, "%d bytes after the value", len(r.Remaining()))
+	}
+	if err != nil {
+		return nil, r.FieldError("{{.Name}}", err)
+	}
+	return v, nil
+}
+
+// {{.Name}}BinarySize returns the encoded size of v
+func {{.Name}}BinarySize(v {{.Name}}) (int, error) {
+	size, err := sizeEnum{{.Name}}(v)
+	if err != nil {
+		return 0, borsh.EncodeFieldError("{{.Name}}", err)
+	}
+	return size, nil
+}
+{{end}}
+`
+
+// C-style enum helpers, one set per named integer type marked with //borshgen:enum
+const ValueEnumTemplate = `// Code generated by bingen. DO NOT EDIT.
+
+{{define "valueEnum"}}
+{{if not .HasString}}
+// String returns the name of the {{.Name}} constant
+func (v {{.Name}}) String() string {
+	switch v {
+	{{range .Constants}}
+	case {{.Name}}:
+		return "{{.Name}}"
+	{{end}}
+	}
+	return fmt.Sprintf("{{.Name}}(%d)", v)
+}
+{{end}}
+
+// Valid reports whether v is one of the declared {{.Name}} constants
+func (v {{.Name}}) Valid() bool {
+	switch v {
+	case {{range $i, $c := .Constants}}{{if $i}}, {{end}}{{$c.Name}}{{end}}:
+		return true
+	}
+	return false
+}
+{{end}}
+`
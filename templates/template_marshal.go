@@ -7,8 +7,11 @@ const MarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 	
 	{{if .IsCustomElementEncoder}}
 		data, err := {{.CustomElementEncoder}}.MarshalBorsh(({{.PointerDeref}}{{.Var}}), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}})
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal {{.Field.Name}}: %v", err)
+			return borsh.EncodeFieldError({{.Path}}, err)
 		}
 		appendBytes(buf, data)
 	{{ else if .IsSlice  }}
@@ -22,35 +25,75 @@ const MarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 					
 					{{if eq .ElementType "string"}}
 					str := {{.PointerDeref}}{{.Var}}
-					if len(str) > MaxStringLen {
-						return nil, fmt.Errorf("{{.FieldName}} too long: %d bytes", len(str))
+					if err := checkLength("string", len(str), {{if .Max}}{{.Max}}{{else}}MaxStringLen{{end}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
 					}
-					appendBytes(buf, []byte(str))
+					appendString(buf, string(str))
 
 					{{else if eq .ElementType "[]byte"}}
 					data := {{.PointerDeref}}{{.Var}}
-					if len(data) > MaxSliceLen {
-						return nil, fmt.Errorf("{{.FieldName}} too long: %d bytes", len(data))
+					if err := checkLength("byte slice", len(data), {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
 					}
 					appendBytes(buf, data)
 
-					{{else if or (eq .ElementType "int64") (eq .ElementType "uint64") (eq .ElementType "int")}}
-					appendUint64(buf, uint64({{.PointerDeref}}{{.Var}}))
+					{{else if eq .ElementType "enum"}}
+					if err := appendEnum{{.TypeName}}(buf, {{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if or (eq .ElementType "enum_u8") (eq .ElementType "enum_u16") (eq .ElementType "enum_u32")}}
+					if !({{.PointerDeref}}{{.Var}}).Valid() {
+						return borsh.EncodeFieldError({{.Path}}, fmt.Errorf("invalid enum value %d", {{.PointerDeref}}{{.Var}}))
+					}
+					{{if eq .ElementType "enum_u8"}}buf.WriteByte(byte({{.PointerDeref}}{{.Var}})){{else if eq .ElementType "enum_u16"}}buf.WriteUint16(uint16({{.PointerDeref}}{{.Var}})){{else}}buf.WriteUint32(uint32({{.PointerDeref}}{{.Var}})){{end}}
+
+					{{else if eq .ElementType "param"}}
+					if err := appendParam(buf, {{.PointerDeref}}{{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if eq .ElementType "any"}}
+					if err := appendAny(buf, {{.PointerDeref}}{{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if eq .ElementType "u128"}}
+					if err := buf.WriteU128({{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if eq .ElementType "i128"}}
+					if err := buf.WriteI128({{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if timeEncoder .ElementType}}
+					if err := {{timeEncoder .ElementType}}.Write(buf, {{.PointerDeref}}{{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+
+					{{else if or (eq .ElementType "uint128") (eq .ElementType "int128") (eq .ElementType "uuid")}}
+					_v128 := {{.PointerDeref}}{{.Var}}
+					buf.Write(_v128[:])
+
+					{{else if or (eq .ElementType "int64") (eq .ElementType "uint64") (eq .ElementType "int") (eq .ElementType "uint")}}
+					buf.WriteUint64(uint64({{.PointerDeref}}{{.Var}}))
 
 					{{else if or (eq .ElementType "int32") (eq .ElementType "uint32")}}
-					appendUint32(buf, uint32({{.PointerDeref}}{{.Var}}))
+					buf.WriteUint32(uint32({{.PointerDeref}}{{.Var}}))
 
 					{{else if or (eq .ElementType "int16") (eq .ElementType "uint16")}}
-					appendUint16(buf, uint16({{.PointerDeref}}{{.Var}}))
+					buf.WriteUint16(uint16({{.PointerDeref}}{{.Var}}))
 
 					{{else if or (eq .ElementType "int8") (eq .ElementType "uint8") (eq .ElementType "byte")}}
 					buf.WriteByte(byte({{.PointerDeref}}{{.Var}}))
 
 					{{else if eq .ElementType "float32"}}
-					appendUint32(buf, math.Float32bits({{.PointerDeref}}{{.Var}}))
+					buf.WriteFloat32({{.PointerDeref}}{{.Var}})
 
 					{{else if eq .ElementType "float64"}}
-					appendUint64(buf, math.Float64bits({{.PointerDeref}}{{.Var}}))
+					buf.WriteFloat64({{.PointerDeref}}{{.Var}})
 
 					{{else if eq .ElementType "bool"}}
 					if {{.PointerDeref}}{{.Var}} {
@@ -63,20 +106,20 @@ const MarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 	
 
 	{{else if .IsStruct}}
-					nestedData, err := marshalValue({{.Var}})
-					if err != nil {
-						return nil, fmt.Errorf("failed to marshal {{.FieldName}}: %v", err)
+					if err := writeNested(buf, {{.Var}}); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
 					}
-					appendBytes(buf, nestedData)
 
 
 	{{else}}
-			// {{.Var}} ({{.}}) - custom type
+			// {{.Var}} - custom type
 			{{if or .Element .HasElement }}
 				// Element: {{.Element.ElementType}}
 				{{template "marshalScalarElement" dict
 								"Var" (printf "s.%s" .FieldName)
 								"FieldName" .FieldName
+								"Path" .Path
+								"Max" .Max
 								"TypeName" .Element.TypeName
 								"ElementType" .Element.ElementType
 								"IsPointer" .Element.IsPointer
@@ -91,11 +134,13 @@ const MarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 				}}
 			{{ else   }}
 				// Element: No Element
-					data, err := marshalValue({{.PointerDeref}}{{.Var}})
+					data, err := borsh.MarshalValue({{.PointerDeref}}{{.Var}})
 					if err != nil {
-						return nil, fmt.Errorf("failed to marshal custom type {{.Name}}: %v", err)
+						return borsh.EncodeFieldError({{.Path}}, err)
+					}
+					if err := appendNested(buf, data); err != nil {
+						return borsh.EncodeFieldError({{.Path}}, err)
 					}
-					appendBytes(buf, data)
 					{{end}}
 					
 			
@@ -108,27 +153,42 @@ const MarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 {{define "marshalSlice"}}
 {{if .IsCustomElementEncoder }}
 	data, err := {{.CustomElementEncoder}}.MarshalBorsh(({{.PointerDeref}}s.{{.Field.Name}}), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), {{if .Field.MaxLen}}{{.Field.MaxLen}}{{else}}MaxSliceLen{{end}})
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal {{.Field.Name}}: %v", err)
+			return borsh.EncodeFieldError("{{.Field.Name}}", err)
 		}
 		 appendBytes(buf, data)
 
 {{else if and .IsSlice (not .IsFixedArray) }}
 	// Slice of {{.Field.Name}}: []{{.Field.Name}}
-	 appendUint16(buf, uint16(len({{.PointerDeref}}(s.{{.Field.Name}}))))
-		for _, item := range {{.PointerDeref}}(s.{{.Field.Name}}) {
-			{{template "marshalElement" dict "Var" "item" "Shape" .Element}}
+	if err := checkLength("slice", len({{.PointerDeref}}(s.{{.Field.Name}})), {{if .Field.MaxLen}}{{.Field.MaxLen}}{{else}}MaxSliceLen{{end}}); err != nil {
+		return borsh.EncodeFieldError("{{.Field.Name}}", err)
+	}
+	 appendLength(buf, len({{.PointerDeref}}(s.{{.Field.Name}})))
+		for i, item := range {{.PointerDeref}}(s.{{.Field.Name}}) {
+			_ = i
+			{{if .Field.IsSet}}
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement(({{.PointerDeref}}s.{{.Field.Name}})[i-1], item, i); err != nil {
+					return borsh.EncodeFieldError(borsh.Index("{{.Field.Name}}", i), err)
+				}
+			}
+			{{end}}
+			{{template "marshalElement" dict "Var" "item" "Path" (printf "borsh.Index(%q, i)" .Field.Name) "Shape" .Element}}
 		}
 {{else if .IsFixedArray}}
 	// Fixed array of length {{.IsFixedArray}}: [{{.FixedArrayLength}}]{{.Field.Name}}
 	for i := 0; i < {{.FixedArrayLength}}; i++ {
-		{{template "marshalElement" dict "Var" (printf "s.%s[i]" .Field.Name) "Shape" .Element}}
+		{{template "marshalElement" dict "Var" (printf "s.%s[i]" .Field.Name) "Path" (printf "borsh.Index(%q, i)" .Field.Name) "Shape" .Element}}
 	}
 {{else if .IsPointer }}
 	{{template "marshalSlice" .Element}}
 {{else}}
 	// Direct encoding
-	d, _ := marshalValue(s.{{.Field.Name}})
+	d, _ := borsh.MarshalValue(s.{{.Field.Name}})
 	appendBytes(buf, d)
 {{end}}
 {{end}}
@@ -137,30 +197,64 @@ const MarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 {{define "marshalElement"}}
 {{if .Shape }}
 {{if .Shape.IsCustomElementEncoder}}
-		//{{ .Field }}
 		data, err := {{.Shape.CustomElementEncoder}}.MarshalBorsh(({{.Shape.PointerDeref}}{{.Var}}), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}})
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal {{.Shape.Field.Name}}: %v", err)
+			return borsh.EncodeFieldError({{.Path}}, err)
 		}
 		 appendBytes(buf, data)
+{{else if .Shape.IsMap}}
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len({{.Shape.PointerDeref}}({{.Var}})), {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}}); err != nil {
+				return borsh.EncodeFieldError({{.Path}}, err)
+			}
+			entries := make([]borsh.MapEntry, 0, len({{.Shape.PointerDeref}}({{.Var}})))
+			for mk, mv := range {{.Shape.PointerDeref}}({{.Var}}) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+					{{template "marshalElement" dict "Var" "mk" "Path" (printf "borsh.Key(%s, mk)" .Path) "Shape" .Shape.Key}}
+					entry.Key = buf.Bytes()
+				}
+				{{if not .Shape.IsSet}}
+				{
+					buf := borsh.NewBufferWriter(nil)
+					{{template "marshalElement" dict "Var" "mv" "Path" (printf "borsh.Key(%s, mk)" .Path) "Shape" .Shape.Element}}
+					entry.Value = buf.Bytes()
+				}
+				{{end}}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError({{.Path}}, err)
+			}
+		}
 {{else if and .Shape.IsSlice (not .Shape.IsFixedArray) }}
 		// ElementIsSlice: {{ .Shape.IsSlice}}
-		appendUint16(buf, uint16(len({{.Shape.PointerDeref}}({{.Var}}))))
-		for _, inner := range {{.Shape.PointerDeref}}({{.Var}}) {
-			{{template "marshalElement" dict "Var" "inner" "Index" .Shape.Index "Shape"  .Shape.Element}}
+		if err := checkLength("slice", len({{.Shape.PointerDeref}}({{.Var}})), MaxSliceLen); err != nil {
+			return borsh.EncodeFieldError({{.Path}}, err)
+		}
+		appendLength(buf, len({{.Shape.PointerDeref}}({{.Var}})))
+		for i{{.Index}}, inner := range {{.Shape.PointerDeref}}({{.Var}}) {
+			_ = i{{.Index}}
+			{{template "marshalElement" dict "Var" "inner" "Path" (printf "borsh.Index(%s, i%d)" .Path .Index) "Index" .Shape.Index "Shape"  .Shape.Element}}
 		}
 {{else if .Shape.IsFixedArray}}
 	for j{{.Index}} := 0; j{{.Index}} < {{.Shape.FixedArrayLength}}; j{{.Index}}++ {
-		{{template "marshalElement" dict "Var" (printf "%s[j%d]" .Var .Index) "Index" .Shape.Index "Shape" .Shape.Element}}
+		{{template "marshalElement" dict "Var" (printf "%s[j%d]" .Var .Index) "Path" (printf "borsh.Index(%s, j%d)" .Path .Index) "Index" .Shape.Index "Shape" .Shape.Element}}
 	}
 {{else}}
 			// NONSLICE:
 			// IsBasice {{ .Shape.IsBasicType}}
 			// ElementType {{ .Shape.ElementType }}
-			// Element {{ .Shape.Element }}
 					{{template "marshalScalarElement" dict
 						"Var" .Var
 						"FieldName" .Shape.Field.Name
+						"Path" .Path
 						"ElementType" .Shape.ElementType
 						"PointerRef" .Shape.PointerRef
 						"TypeName" .Shape.TypeName
@@ -178,10 +272,9 @@ const MarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 // No Shape
 // Field: {{.Field.Name}}
 // Var: {{.Var}}
-// Element: {{.Element}}
-		data, err := marshalValue({{.Var}})
+		data, err := borsh.MarshalValue({{.Var}})
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal  {{.Var}}: %v", err)
+			return fmt.Errorf("failed to marshal  {{.Var}}: %v", err)
 		}
 		appendBytes(buf, data)
 {{end}}
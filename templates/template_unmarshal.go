@@ -6,7 +6,7 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 {{define "unmarshalScalarElement"}}
 	
 	{{if .IsCustomElementEncoder}}
-		{{template "unmarshalElement" dict "Var" .Var "Shape" .}}
+		{{template "unmarshalElement" dict "Var" .Var "Path" .Path "Max" .Max "Shape" .}}
 	{{ else if .IsSlice  }}
 				// {{.Name}} ({{.BinaryTag}}) - slice
 				// ElementType: {{.ElementType}}
@@ -18,20 +18,14 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 	
 
 	{{else if .IsStruct}}
-				if offset+2 > len(data) {
-						return fmt.Errorf("buffer too short for {{.Name}} length")
-					}
 					// IsPointer: {{.IsPointer}}
 					{{if .Element}}
 						// IsPointer: {{.Element.IsPointer}}
 						{{end}}
 						// IsPointer: {{.Field.IsPointer}}
-					var itemData []byte
-					itemData, offset, err = getBytes(data, offset)
 					m := &{{.TypeName}}{}
-					err := unmarshalValue(itemData, m)
-					if err != nil {
-						return fmt.Errorf("failed to unmarshal {{.FieldName}}: %v", err)
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError({{.Path}}, err)
 					}
 					{{if.IsPointer }}
 						{{.Var}} = m
@@ -42,12 +36,14 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 
 
 	{{else}}
-			// {{.Var}} ({{.}}) - custom type
+			// {{.Var}} - custom type
 			{{if or .Element .HasElement }}
 				// Element: {{.Element.ElementType}}
 				{{template "unmarshalScalarElement" dict
 								"Var" (printf "s.%s" .FieldName)
 								"FieldName" .FieldName
+								"Path" .Path
+								"Max" .Max
 								"ElementType" .Element.ElementType
 								"TypeName" .Element.TypeName
 								"IsPointer" .Element.IsPointer
@@ -55,6 +51,7 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 								"PointerRef" .Element.PointerRef
 								"IsCustomElementEncoder" .Element.IsCustomElementEncoder
 								"CustomElementEncoder" .Element.CustomElementEncoder
+								"DecodedTypeName" .Element.DecodedTypeName
 								"IsStruct" .Element.IsStruct
 								"IsBasicType" .Element.IsBasicType
 								"Element" .Element.Element
@@ -63,14 +60,9 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 			{{ else   }}
 				// Element: No Element
 				// IsPointer: {{ .IsPointer }}
-				if offset+2 > len(data) {
-						return fmt.Errorf("buffer too short for {{.Name}} length")
-					}
-						var itemData []byte
-					itemData, offset, err = getBytes(data, offset)
 					{{ if .TypeName}}
 					 	_m := {{ .TypeName}}{}
-						err := unmarshalValue(itemData, &_m)
+						err = readNested(r, &_m, depth+1)
 						
 					 	{{ if .IsPointer }}
 							{{.Var}} = &_m
@@ -78,10 +70,10 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 							{{.Var}} = _m
 						{{end}}
 					{{else}}
-							err := unmarshalValue(itemData, {{.Var}})
+							err = readNested(r, {{.Var}}, depth+1)
 					{{end}}
 						if err != nil {
-							return nil
+							return r.FieldError({{.Path}}, err)
 						}
 					
 					{{end}}
@@ -93,25 +85,39 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 //////////////
 {{define "unmarshalSlice"}}
 {{if .IsCustomElementEncoder }}
-	var itemData []byte
-			itemData, offset, err = getBytes(data, offset)
+			itemData, err := readByteSlice(r, {{if .Field.MaxLen}}{{.Field.MaxLen}}{{else}}MaxSliceLen{{end}})
+			if err != nil {
+				return r.FieldError("{{.Field.Name}}", err)
+			}
 			if _v, err := {{.CustomElementEncoder}}.UnmarshalBorsh(itemData); err != nil {
-				return fmt.Errorf("failed to unmarshal custom element encoder slice {{.Field.Name}}]: %v", err)
+				return r.FieldError("{{.Field.Name}}", err)
 			} else {
-				_m := (_v).({{ .TypeName }})
+				_m := {{ customDecoded . "_v" }}
 				s.{{.Field.Name}} = {{.PointerRef}}_m
 			}
 
 {{else if and .IsSlice (not .IsFixedArray) }}
 	// Slice of {{.Field.Name}}: []{{.Field.Name}}
-	if offset+2 > len(data) {
-		return fmt.Errorf("buffer too short for {{.Field.Name}} length")
+	length, err := readCount(r, {{if .Field.MaxLen}}{{.Field.MaxLen}}{{else}}MaxSliceLen{{end}})
+	if err != nil {
+		return r.FieldError("{{.Field.Name}}", err)
 	}
-	length := binary.LittleEndian.Uint16(data[offset : offset+2])
-		offset += 2
-		p := make({{.TypeName}}, length)
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[{{.TypeName}}](r, length)
+		if err != nil {
+			return r.FieldError("{{.Field.Name}}", err)
+		}
 		for i := 0; i < int(length); i++ {
-			{{template "unmarshalElement" dict "Var" "p[i]"  "Shape" .Element}}
+			p = slices.Grow(p, 1)[:i+1]
+			{{template "unmarshalElement" dict "Var" "p[i]" "Path" (printf "borsh.Index(%q, i)" .Field.Name) "Shape" .Element}}
+			{{if .Field.IsSet}}
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement(p[i-1], p[i], i); err != nil {
+					return r.FieldError(borsh.Index("{{.Field.Name}}", i), err)
+				}
+			}
+			{{end}}
 		}
 			
 				s.{{.Field.Name}} =  {{.PointerRef}}p
@@ -121,7 +127,7 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 	var p = {{.TypeName}}{}
 	length := {{.FixedArrayLength}}
 	for i := 0; i < int(length); i++ {
-		{{template "unmarshalElement" dict "Var" "p[i]" "Shape" .Element}}
+		{{template "unmarshalElement" dict "Var" "p[i]" "Path" (printf "borsh.Index(%q, i)" .Field.Name) "Shape" .Element}}
 	}
 		//_m := ({{.PointerRef}}p)
 		s.{{.Field.Name}} = ({{.PointerRef}}p)
@@ -129,7 +135,7 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 	{{template "unmarshalSlice" .Element}}
 {{else}}
 	// Direct encoding
-	d, _ := unmarshalValue(s.{{.Field.Name}})
+	d, _ := borsh.UnmarshalValue(s.{{.Field.Name}}, depth+1)
 	buf = appendBytes(buf, d)
 {{end}}
 {{end}}
@@ -138,52 +144,83 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 {{define "unmarshalElement"}}
 {{if .Shape }}
 {{if .Shape.IsCustomElementEncoder}}
-		
-		
-		if offset+2 > len(data) {
-			return fmt.Errorf("buffer too short for {{.Var}} length")
+		itemData, err := readByteSlice(r, {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}})
+		if err != nil {
+			return r.FieldError({{.Path}}, err)
 		}
-		var itemData []byte
-		itemData, offset, err = getBytes(data, offset)
-		
 		if _v, err := {{.Shape.CustomElementEncoder}}.UnmarshalBorsh(itemData); err != nil {
-			return fmt.Errorf("failed to unmarshal custom element encoder slice {{.Var}}]: %v", err)
+			return r.FieldError({{.Path}}, err)
 		} else {
 		 	{{ if .Shape.TypeName}}
-			_m := (_v).({{ .Shape.TypeName}})
+			_m := {{ customDecoded .Shape "_v" }}
 			{{else}}
 			_m := (_v)
 			{{end}}
 			{{.Var}} = {{.Shape.PointerRef}}_m
 		}
+{{else if .Shape.IsMap}}
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, {{if .Max}}{{.Max}}{{else}}MaxSliceLen{{end}})
+			if err != nil {
+				return r.FieldError({{.Path}}, err)
+			}
+			mp, err := borsh.MakeMap[{{.Shape.TypeName}}](r, mapLen)
+			if err != nil {
+				return r.FieldError({{.Path}}, err)
+			}
+			var prevKey {{.Shape.KeyTypeName}}
+			for mi := 0; mi < mapLen; mi++ {
+				var mk {{.Shape.KeyTypeName}}
+				var mv {{.Shape.ValueTypeName}}
+				{
+					{{template "unmarshalElement" dict "Var" "mk" "Path" (printf "borsh.Index(%s, mi)" .Path) "Shape" .Shape.Key}}
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key({{.Path}}, mk), err)
+					}
+					prevKey = mk
+				}
+				{{if not .Shape.IsSet}}
+				{
+					{{template "unmarshalElement" dict "Var" "mv" "Path" (printf "borsh.Key(%s, mk)" .Path) "Shape" .Shape.Element}}
+				}
+				{{end}}
+				mp[mk] = mv
+			}
+			{{.Var}} = {{.Shape.PointerRef}}mp
+		}
 {{else if and .Shape.IsSlice (not .Shape.IsFixedArray) }}
 		// ElementIsSlice: {{ .Shape.IsSlice}}
-		if offset+2 > len(data) {
-		return fmt.Errorf("buffer too short for {{.Field.Name}} length")
-	}
-			length := binary.LittleEndian.Uint16(data[offset : offset+2])
-			offset += 2
-			{{.Var}} = make({{.Shape.TypeName}}, length)
+			length, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError({{.Path}}, err)
+			}
+			{{.Var}}, err = borsh.MakeSlice[{{.Shape.TypeName}}](r, length)
+			if err != nil {
+				return r.FieldError({{.Path}}, err)
+			}
 				for i{{.Index}} := 0; i{{.Index}} < int(length); i{{.Index}}++ {
-					{{template "unmarshalElement" dict "Var" (printf "%s[i%d]" .Var .Index) "Index" .Shape.Index  "Shape" .Shape.Element}}
+					{{.Var}} = slices.Grow({{.Var}}, 1)[:i{{.Index}}+1]
+					{{template "unmarshalElement" dict "Var" (printf "%s[i%d]" .Var .Index) "Path" (printf "borsh.Index(%s, i%d)" .Path .Index) "Index" .Shape.Index  "Shape" .Shape.Element}}
 				}
 {{else if .Shape.IsFixedArray}}
 
 			length := {{.Shape.FixedArrayLength}}
 			{{.Var}} = {{.Shape.TypeName}}{}
-			// {{.Element}} 
 				for i{{.Index}} := 0; i{{.Index}} < int(length); i{{.Index}}++ {
-					{{template "unmarshalElement" dict "Var"  (printf "%s[i%d]" .Var .Index)  "Index" .Shape.Index "Shape" .Shape.Element}}
+					{{template "unmarshalElement" dict "Var"  (printf "%s[i%d]" .Var .Index) "Path" (printf "borsh.Index(%s, i%d)" .Path .Index) "Index" .Shape.Index "Shape" .Shape.Element}}
 				}
 {{else}}
 			// NONSLICE:
 			// IsBasice {{ .Shape.IsBasicType}}
 			// ElementType {{ .Shape.ElementType }}
-			// Element {{ .Shape.Element }}
 			// Element {{ .Shape.TypeName }}
 					{{template "unmarshalScalarElement" dict
 						"Var" .Var
 						"FieldName" .Shape.Field.Name
+						"Path" .Path
 						"TypeName" .Shape.TypeName
 						"ElementType" .Shape.ElementType
 						"IsPointer" .Shape.IsPointer
@@ -191,6 +228,7 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 						"PointerRef" .Shape.PointerRef
 						"IsCustomElementEncoder" .Shape.IsCustomElementEncoder
 						"CustomElementEncoder" .Shape.CustomElementEncoder
+						"DecodedTypeName" .Shape.DecodedTypeName
 						"IsStruct" .Shape.IsStruct
 						"IsBasicType" .Shape.IsBasicType
 						"Element" .Shape.Element
@@ -201,12 +239,12 @@ const UnmarshalTemplate = `// Code generated by bingen. DO NOT EDIT.
 // No Shape
 // Field: {{.Field.Name}}
 // Var: {{.Var}}
-// Element: {{.Element}}
-		var itemData []byte
-		itemData, offset, err = getBytes(data, offset)
-		err := unmarshalValue(itemData, {{.Var}})
+		itemData, err := readBytes(r)
 		if err != nil {
-			return err
+			return r.FieldError({{.Path}}, err)
+		}
+		if err := r.DecodePart(itemData, {{.Var}}, depth+1); err != nil {
+			return r.FieldError({{.Path}}, err)
 		}
 {{end}}
 {{end}}
@@ -0,0 +1,239 @@
+package templates
+
+// TestTemplate is the test file written next to a generated file for the structs generated with -fuzz
+// or -gen-tests. -fuzz writes a FuzzUnmarshal<Type> target, which go test runs on its seed corpus and
+// go test -fuzz=FuzzUnmarshal<Type> uses to search for inputs that panic. -gen-tests adds a randomized
+// round-trip test, seeds the fuzz target with random values and adds benchmarks
+const TestTemplate = `// Code generated by borshgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"bytes"
+	"errors"
+{{- if .GenTests}}
+	"math/rand"
+{{- if .Enums}}
+	"reflect"
+{{- end}}
+{{- end}}
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+{{- if .GenTests}}
+	"github.com/mlayerprotocol/go-borshgen/borsh/borshtest"
+{{- end}}
+)
+{{range .Structs}}
+{{- if .Options.GenTests}}
+// _{{.Name}}TestOptions tells borshtest how {{.Name}} was generated
+var _{{.Name}}TestOptions = borshtest.Options{
+	Tag:      {{printf "%q" .Options.PrimaryTag}},
+	Fallback: {{printf "%q" .Options.FallbackTag}},
+	Ignore:   {{printf "%q" .Options.IgnoreTag}},
+{{- if $.Enums}}
+	Variants: map[reflect.Type][]any{
+{{- range $.Enums}}
+		reflect.TypeOf((*{{.Name}})(nil)).Elem(): { {{- range $i, $v := .Variants}}{{if $i}}, {{end}}{{if .IsPointer}}&{{end}}{{.Name}}{}{{end -}} },
+{{- end}}
+	},
+{{- end}}
+}
+
+// _random{{.Name}} returns a {{.Name}} with every encoded field set to a random value
+func _random{{.Name}}(rng *rand.Rand) {{.Name}} {
+	var v {{.Name}}
+	borshtest.Fill(rng, &v, _{{.Name}}TestOptions)
+	return v
+}
+
+// TestRoundTrip{{.Name}} checks that random {{.Name}} values decode to themselves,
+// and that BinarySize is the length of their encoding
+func TestRoundTrip{{.Name}}(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		v := _random{{.Name}}(rng)
+		data, err := v.MarshalBorsh()
+		if err != nil {
+			t.Fatalf("MarshalBorsh() of %+v failed: %v", v, err)
+		}
+		size, err := v.BinarySize()
+		if err != nil {
+			t.Fatalf("BinarySize() of %+v failed: %v", v, err)
+		}
+		if size != len(data) {
+			t.Fatalf("BinarySize() = %d, want the %d bytes of MarshalBorsh() for %+v", size, len(data), v)
+		}
+		var restored {{.Name}}
+		if err := restored.UnmarshalBorsh(data); err != nil {
+			t.Fatalf("UnmarshalBorsh() of %+v failed: %v", v, err)
+		}
+		if diff := borshtest.Diff(v, restored, _{{.Name}}TestOptions); diff != "" {
+			t.Fatalf("UnmarshalBorsh(MarshalBorsh(%+v)): %s", v, diff)
+		}
+{{- if .Schema}}
+		if err := borshtest.Walk(v.BorshSchema(), data); err != nil {
+			t.Fatalf("BorshSchema() does not describe MarshalBorsh(%+v): %v", v, err)
+		}
+{{- end}}
+	}
+}
+
+func Benchmark{{.Name}}BinarySize(b *testing.B) {
+	v := _random{{.Name}}(rand.New(rand.NewSource(1)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := v.BinarySize(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark{{.Name}}MarshalBorsh(b *testing.B) {
+	v := _random{{.Name}}(rand.New(rand.NewSource(1)))
+	size, err := v.BinarySize()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(size))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := v.MarshalBorsh(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark{{.Name}}UnmarshalBorsh(b *testing.B) {
+	data, err := _random{{.Name}}(rand.New(rand.NewSource(1))).MarshalBorsh()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var v {{.Name}}
+		if err := v.UnmarshalBorsh(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+{{end}}
+// FuzzUnmarshal{{.Name}} checks that no input makes decoding a {{.Name}} panic or fail with anything
+// but a *borsh.DecodeError, and that every input accepted by strict decoding is its own encoding
+func FuzzUnmarshal{{.Name}}(f *testing.F) {
+	var zero {{.Name}}
+	if data, err := zero.MarshalBorsh(); err == nil {
+		f.Add(data)
+		for n := len(data) - 1; n >= 0 && n >= len(data)-8; n-- {
+			f.Add(data[:n])
+		}
+		f.Add(append(bytes.Clone(data), 0))
+	}
+{{- if .Options.GenTests}}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 8; i++ {
+		if data, err := _random{{.Name}}(rng).MarshalBorsh(); err == nil {
+			f.Add(data)
+		}
+	}
+{{- end}}
+	f.Add([]byte{})
+	f.Add(bytes.Repeat([]byte{0xff}, 64))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		check := func(method string, err error) {
+			var de *borsh.DecodeError
+			if err != nil && !errors.As(err, &de) {
+				t.Fatalf("%s() error = %v (%T), want a *borsh.DecodeError", method, err, err)
+			}
+		}
+		var v {{.Name}}
+		check("UnmarshalBorsh", v.UnmarshalBorsh(data))
+		_, err := new({{.Name}}).ReadBorshFrom(bytes.NewReader(data))
+		check("ReadBorshFrom", err)
+
+		var s {{.Name}}
+		if err := s.UnmarshalBorshStrict(data); err != nil {
+			check("UnmarshalBorshStrict", err)
+			return
+		}
+		out, err := s.MarshalBorsh()
+		if err != nil {
+			t.Fatalf("MarshalBorsh() of a strictly decoded {{.Name}} failed: %v", err)
+		}
+		if !bytes.Equal(out, data) {
+			t.Fatalf("MarshalBorsh() = %x, want the strictly decoded input %x", out, data)
+		}
+	})
+}
+{{end}}
+`
+
+// CrossLangTestTemplate is the test file of the structs generated with -gen-tests and -lang=ts or
+// -lang=rust. Its TestTypeScript<File> and TestRust<File> run node and cargo, so they are built only
+// with go test -tags crosslang
+const CrossLangTestTemplate = `// Code generated by borshgen. DO NOT EDIT.
+
+//go:build crosslang
+
+package {{.Package}}
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh/borshtest"
+)
+{{if .TypeScript}}
+// TestTypeScript{{.TypeScriptTest}} encodes random values in Go and checks that the TypeScript classes
+// of {{.TypeScriptBase}}.ts decode them and encode them to the same bytes. It is skipped without node 22.6
+func TestTypeScript{{.TypeScriptTest}}(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var fixtures []borshtest.Fixture
+	add := func(typ string, v borshtest.Encoder) {
+		f, err := borshtest.NewFixture(typ, v)
+		if err != nil {
+			t.Fatalf("encoding a random %s failed: %v", typ, err)
+		}
+		fixtures = append(fixtures, f)
+	}
+	for i := 0; i < 20; i++ {
+{{- range .TypeScript}}
+		{
+			v := _random{{.}}(rng)
+			add("{{.}}", &v)
+		}
+{{- end}}
+	}
+	if err := borshtest.WriteFixtures("{{.TypeScriptBase}}.fixtures.json", fixtures); err != nil {
+		t.Fatal(err)
+	}
+	borshtest.RunTypeScript(t, "{{.TypeScriptBase}}.test.ts")
+}
+{{end}}
+{{- if .Rust}}
+// TestRust{{.TypeScriptTest}} encodes random values in Go and checks that the Rust structs of
+// {{.TypeScriptBase}}.rs deserialize them and serialize them to the same bytes. It is skipped without cargo
+func TestRust{{.TypeScriptTest}}(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var fixtures []borshtest.Fixture
+	add := func(typ string, v borshtest.Encoder) {
+		f, err := borshtest.NewFixture(typ, v)
+		if err != nil {
+			t.Fatalf("encoding a random %s failed: %v", typ, err)
+		}
+		fixtures = append(fixtures, f)
+	}
+	for i := 0; i < 20; i++ {
+{{- range .Rust}}
+		{
+			v := _random{{.}}(rng)
+			add("{{.}}", &v)
+		}
+{{- end}}
+	}
+	borshtest.RunRust(t, "{{.TypeScriptBase}}.rs", fixtures)
+}
+{{end}}`
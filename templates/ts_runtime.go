@@ -0,0 +1,963 @@
+package templates
+
+// TypeScriptRuntime is borshgen.ts, the runtime of the TypeScript codecs written with -lang=ts.
+// It is written next to every generated .ts file, and its codecs write the same bytes as the
+// Go code generated from the same structs
+const TypeScriptRuntime = `// Code generated by borshgen. DO NOT EDIT.
+
+// Runtime of the TypeScript codecs borshgen writes with -lang=ts. Every codec writes the
+// bytes the Go code generated from the same structs writes, on the wire layout of its file.
+
+// BorshError is thrown when a value cannot be encoded or decoded
+export class BorshError extends Error {
+  offset: number;
+
+  constructor(message: string, offset = -1) {
+    super(offset >= 0 ? message + " at offset " + offset : message);
+    this.name = "BorshError";
+    this.offset = offset;
+  }
+}
+
+// Wire is the layout and the limits a generated file was generated with
+export interface Wire {
+  prefixSize: 2 | 4; // width of string, byte slice, slice and map length prefixes
+  nestedPrefix: boolean; // nested structs are length-prefixed, as in the legacy layout
+  strict: boolean; // decoding rejects input that is not in canonical form
+  maxStringLen: number;
+  maxSliceLen: number;
+  maxDepth: number;
+}
+
+// Writer appends little-endian values to a growing buffer
+export class Writer {
+  wire: Wire; // layout of the struct being written
+  private buf: Uint8Array;
+  private view: DataView;
+  private len = 0;
+
+  constructor(wire: Wire) {
+    this.wire = wire;
+    this.buf = new Uint8Array(64);
+    this.view = new DataView(this.buf.buffer);
+  }
+
+  get length(): number {
+    return this.len;
+  }
+
+  bytes(): Uint8Array {
+    return this.buf.slice(0, this.len);
+  }
+
+  private grow(n: number): number {
+    const at = this.len;
+    if (at + n > this.buf.length) {
+      const buf = new Uint8Array(Math.max(this.buf.length * 2, at + n));
+      buf.set(this.buf.subarray(0, at));
+      this.buf = buf;
+      this.view = new DataView(buf.buffer);
+    }
+    this.len += n;
+    return at;
+  }
+
+  raw(b: Uint8Array): void {
+    const at = this.grow(b.length);
+    this.buf.set(b, at);
+  }
+
+  u8(v: number): void {
+    const x = checkInt(v, 0, 0xff, "u8");
+    const at = this.grow(1);
+    this.view.setUint8(at, x);
+  }
+
+  u16(v: number): void {
+    const x = checkInt(v, 0, 0xffff, "u16");
+    const at = this.grow(2);
+    this.view.setUint16(at, x, true);
+  }
+
+  u32(v: number): void {
+    const x = checkInt(v, 0, 0xffffffff, "u32");
+    const at = this.grow(4);
+    this.view.setUint32(at, x, true);
+  }
+
+  i8(v: number): void {
+    const x = checkInt(v, -0x80, 0x7f, "i8");
+    const at = this.grow(1);
+    this.view.setInt8(at, x);
+  }
+
+  i16(v: number): void {
+    const x = checkInt(v, -0x8000, 0x7fff, "i16");
+    const at = this.grow(2);
+    this.view.setInt16(at, x, true);
+  }
+
+  i32(v: number): void {
+    const x = checkInt(v, -0x80000000, 0x7fffffff, "i32");
+    const at = this.grow(4);
+    this.view.setInt32(at, x, true);
+  }
+
+  u64(v: bigint): void {
+    const x = checkBig(v, 0n, 0xffffffffffffffffn, "u64");
+    const at = this.grow(8);
+    this.view.setBigUint64(at, x, true);
+  }
+
+  i64(v: bigint): void {
+    const x = checkBig(v, -(1n << 63n), (1n << 63n) - 1n, "i64");
+    const at = this.grow(8);
+    this.view.setBigInt64(at, x, true);
+  }
+
+  u128(v: bigint): void {
+    v = checkBig(v, 0n, (1n << 128n) - 1n, "u128");
+    this.u64(v & 0xffffffffffffffffn);
+    this.u64(v >> 64n);
+  }
+
+  i128(v: bigint): void {
+    v = BigInt.asUintN(128, checkBig(v, -(1n << 127n), (1n << 127n) - 1n, "i128"));
+    this.u64(v & 0xffffffffffffffffn);
+    this.u64(v >> 64n);
+  }
+
+  // f32 and f64 write any NaN as the canonical NaN, as the Go Writer does
+  f32(v: number): void {
+    const at = this.grow(4);
+    if (Number.isNaN(v)) {
+      this.view.setUint32(at, 0x7fc00000, true);
+    } else {
+      this.view.setFloat32(at, v, true);
+    }
+  }
+
+  f64(v: number): void {
+    const at = this.grow(8);
+    if (Number.isNaN(v)) {
+      this.view.setBigUint64(at, 0x7ff8000000000000n, true);
+    } else {
+      this.view.setFloat64(at, v, true);
+    }
+  }
+
+  // prefix writes a length prefix of the width of the wire layout
+  prefix(n: number): void {
+    if (this.wire.prefixSize === 2) {
+      this.u16(n);
+    } else {
+      this.u32(n);
+    }
+  }
+}
+
+function checkInt(v: number, min: number, max: number, type: string): number {
+  if (!Number.isInteger(v) || v < min || v > max) {
+    throw new BorshError(v + " is not a " + type);
+  }
+  return v;
+}
+
+function checkBig(v: bigint, min: bigint, max: bigint, type: string): bigint {
+  if (typeof v !== "bigint" || v < min || v > max) {
+    throw new BorshError(v + " is not a " + type);
+  }
+  return v;
+}
+
+// Reader reads little-endian values from data
+export class Reader {
+  wire: Wire; // layout of the struct being read
+  readonly data: Uint8Array;
+  readonly strict: boolean;
+  readonly base: number; // offset of data in the input, for errors
+  pos = 0;
+  depth = 0; // number of structs being read
+  private view: DataView;
+
+  constructor(data: Uint8Array, wire: Wire, strict = wire.strict, base = 0) {
+    this.wire = wire;
+    this.data = data;
+    this.strict = strict;
+    this.base = base;
+    this.view = new DataView(data.buffer, data.byteOffset, data.byteLength);
+  }
+
+  get remaining(): number {
+    return this.data.length - this.pos;
+  }
+
+  error(message: string): BorshError {
+    return new BorshError(message, this.base + this.pos);
+  }
+
+  private skip(n: number): number {
+    if (n > this.remaining) {
+      throw this.error("need " + n + " bytes, have " + this.remaining);
+    }
+    const at = this.pos;
+    this.pos += n;
+    return at;
+  }
+
+  take(n: number): Uint8Array {
+    const at = this.skip(n);
+    return this.data.subarray(at, at + n);
+  }
+
+  // sub returns a Reader over the next n bytes, for values written with a length prefix
+  sub(n: number): Reader {
+    const base = this.base + this.pos;
+    const r = new Reader(this.take(n), this.wire, this.strict, base);
+    r.depth = this.depth;
+    return r;
+  }
+
+  u8(): number {
+    return this.view.getUint8(this.skip(1));
+  }
+
+  u16(): number {
+    return this.view.getUint16(this.skip(2), true);
+  }
+
+  u32(): number {
+    return this.view.getUint32(this.skip(4), true);
+  }
+
+  i8(): number {
+    return this.view.getInt8(this.skip(1));
+  }
+
+  i16(): number {
+    return this.view.getInt16(this.skip(2), true);
+  }
+
+  i32(): number {
+    return this.view.getInt32(this.skip(4), true);
+  }
+
+  u64(): bigint {
+    return this.view.getBigUint64(this.skip(8), true);
+  }
+
+  i64(): bigint {
+    return this.view.getBigInt64(this.skip(8), true);
+  }
+
+  u128(): bigint {
+    const lo = this.u64();
+    return (this.u64() << 64n) | lo;
+  }
+
+  i128(): bigint {
+    return BigInt.asIntN(128, this.u128());
+  }
+
+  f32(): number {
+    const at = this.skip(4);
+    const v = this.view.getFloat32(at, true);
+    if (this.strict && Number.isNaN(v) && this.view.getUint32(at, true) !== 0x7fc00000) {
+      throw new BorshError("non-canonical NaN", this.base + at);
+    }
+    return v;
+  }
+
+  f64(): number {
+    const at = this.skip(8);
+    const v = this.view.getFloat64(at, true);
+    if (this.strict && Number.isNaN(v) && this.view.getBigUint64(at, true) !== 0x7ff8000000000000n) {
+      throw new BorshError("non-canonical NaN", this.base + at);
+    }
+    return v;
+  }
+
+  // flag reads a bool or an option flag. Any non-zero byte is set unless the Reader is strict
+  flag(what: string): boolean {
+    const b = this.u8();
+    if (b > 1 && this.strict) {
+      throw new BorshError("invalid " + what + " " + b, this.base + this.pos - 1);
+    }
+    return b !== 0;
+  }
+
+  prefix(): number {
+    return this.wire.prefixSize === 2 ? this.u16() : this.u32();
+  }
+
+  // count reads the element count of a slice or map of at most max elements
+  count(max: number): number {
+    const n = this.prefix();
+    if (n > max) {
+      throw this.error(n + " elements exceed the limit of " + max);
+    }
+    return n;
+  }
+}
+
+// Codec writes and reads values of type T in the MarshalBorsh form, and writes them in the
+// Encode form: only the fields with an enc tag, sorted by tag, without length prefixes
+export interface Codec<T> {
+  write(w: Writer, v: T): void;
+  encode(w: Writer, v: T): void;
+  read(r: Reader): T;
+}
+
+// fixed makes a codec whose Encode form is its MarshalBorsh form
+function fixed<T>(write: (w: Writer, v: T) => void, read: (r: Reader) => T): Codec<T> {
+  return { write, encode: write, read };
+}
+
+export const bool: Codec<boolean> = fixed((w, v) => w.u8(v ? 1 : 0), (r) => r.flag("bool"));
+export const u8: Codec<number> = fixed((w, v) => w.u8(v), (r) => r.u8());
+export const u16: Codec<number> = fixed((w, v) => w.u16(v), (r) => r.u16());
+export const u32: Codec<number> = fixed((w, v) => w.u32(v), (r) => r.u32());
+export const u64: Codec<bigint> = fixed((w, v) => w.u64(v), (r) => r.u64());
+export const u128: Codec<bigint> = fixed((w, v) => w.u128(v), (r) => r.u128());
+export const i8: Codec<number> = fixed((w, v) => w.i8(v), (r) => r.i8());
+export const i16: Codec<number> = fixed((w, v) => w.i16(v), (r) => r.i16());
+export const i32: Codec<number> = fixed((w, v) => w.i32(v), (r) => r.i32());
+export const i64: Codec<bigint> = fixed((w, v) => w.i64(v), (r) => r.i64());
+export const i128: Codec<bigint> = fixed((w, v) => w.i128(v), (r) => r.i128());
+export const f32: Codec<number> = fixed((w, v) => w.f32(v), (r) => r.f32());
+export const f64: Codec<number> = fixed((w, v) => w.f64(v), (r) => r.f64());
+
+const utf8Encoder = new TextEncoder();
+const utf8Decoder = new TextDecoder("utf-8", { fatal: true });
+const lenientUtf8Decoder = new TextDecoder("utf-8");
+
+// string is a length-prefixed UTF-8 string of at most max bytes when read, or MaxStringLen.
+// A strict Reader rejects invalid UTF-8, others replace it with U+FFFD.
+// The Encode form has no prefix
+export function string(max?: number): Codec<string> {
+  const bytes = (w: Writer, v: string): Uint8Array => {
+    const b = utf8Encoder.encode(v);
+    if (b.length > w.wire.maxStringLen) {
+      throw new BorshError("string of " + b.length + " bytes exceeds MaxStringLen (" + w.wire.maxStringLen + ")");
+    }
+    return b;
+  };
+  return {
+    write(w, v) {
+      const b = bytes(w, v);
+      w.prefix(b.length);
+      w.raw(b);
+    },
+    encode(w, v) {
+      w.raw(bytes(w, v));
+    },
+    read(r) {
+      const n = r.prefix();
+      const limit = max ?? r.wire.maxStringLen;
+      if (n > limit) {
+        throw r.error("string of " + n + " bytes exceeds the limit of " + limit);
+      }
+      const at = r.pos;
+      if (!r.strict) {
+        return lenientUtf8Decoder.decode(r.take(n));
+      }
+      try {
+        return utf8Decoder.decode(r.take(n));
+      } catch {
+        throw new BorshError("string is not valid UTF-8", r.base + at);
+      }
+    },
+  };
+}
+
+// bytes is a length-prefixed byte slice of at most max bytes when read, or MaxSliceLen.
+// The Encode form has no prefix
+export function bytes(max?: number): Codec<Uint8Array> {
+  const check = (w: Writer, v: Uint8Array): Uint8Array => {
+    if (v.length > w.wire.maxSliceLen) {
+      throw new BorshError("byte slice of " + v.length + " bytes exceeds MaxSliceLen (" + w.wire.maxSliceLen + ")");
+    }
+    return v;
+  };
+  return {
+    write(w, v) {
+      w.prefix(check(w, v).length);
+      w.raw(v);
+    },
+    encode(w, v) {
+      w.raw(check(w, v));
+    },
+    read(r) {
+      const n = r.prefix();
+      const limit = max ?? r.wire.maxSliceLen;
+      if (n > limit) {
+        throw r.error("byte slice of " + n + " bytes exceeds the limit of " + limit);
+      }
+      return r.take(n).slice();
+    },
+  };
+}
+
+// fixedBytes is a byte array of n bytes, written without a prefix
+export function fixedBytes(n: number): Codec<Uint8Array> {
+  return fixed(
+    (w, v) => {
+      if (v.length !== n) {
+        throw new BorshError("expected " + n + " bytes, got " + v.length);
+      }
+      w.raw(v);
+    },
+    (r) => r.take(n).slice(),
+  );
+}
+
+// vec is a slice: its element count followed by the elements. The Encode form has no count
+export function vec<T>(elem: Codec<T>, max?: number): Codec<T[]> {
+  return {
+    write(w, v) {
+      w.prefix(v.length);
+      for (const e of v) {
+        elem.write(w, e);
+      }
+    },
+    encode(w, v) {
+      for (const e of v) {
+        elem.encode(w, e);
+      }
+    },
+    read(r) {
+      const n = r.count(max ?? r.wire.maxSliceLen);
+      const v: T[] = [];
+      for (let i = 0; i < n; i++) {
+        v.push(elem.read(r));
+      }
+      return v;
+    },
+  };
+}
+
+// array is a fixed-size array of n elements, written without a count
+export function array<T>(elem: Codec<T>, n: number): Codec<T[]> {
+  const check = (v: T[]): T[] => {
+    if (v.length !== n) {
+      throw new BorshError("expected " + n + " elements, got " + v.length);
+    }
+    return v;
+  };
+  return {
+    write(w, v) {
+      for (const e of check(v)) {
+        elem.write(w, e);
+      }
+    },
+    encode(w, v) {
+      for (const e of check(v)) {
+        elem.encode(w, e);
+      }
+    },
+    read(r) {
+      const v: T[] = [];
+      for (let i = 0; i < n; i++) {
+        v.push(elem.read(r));
+      }
+      return v;
+    },
+  };
+}
+
+// compare orders encoded values as Go's bytes.Compare does
+function compare(a: Uint8Array, b: Uint8Array): number {
+  const n = Math.min(a.length, b.length);
+  for (let i = 0; i < n; i++) {
+    if (a[i] !== b[i]) {
+      return a[i] - b[i];
+    }
+  }
+  return a.length - b.length;
+}
+
+function hex(b: Uint8Array): string {
+  let s = "";
+  for (const x of b) {
+    s += x.toString(16).padStart(2, "0");
+  }
+  return s;
+}
+
+// set is a slice tagged set, whose elements must be strictly ascending
+export function set<T>(elem: Codec<T>, max?: number): Codec<T[]> {
+  const inner = vec(elem, max);
+  const check = (v: T[], i: number, at?: number) => {
+    const c = order(v[i - 1], v[i]);
+    if (c >= 0) {
+      throw new BorshError("set element " + i + (c === 0 ? " is a duplicate" : " is not sorted"), at);
+    }
+  };
+  return {
+    write(w, v) {
+      for (let i = 1; i < v.length; i++) {
+        check(v, i);
+      }
+      inner.write(w, v);
+    },
+    encode: inner.encode,
+    read(r) {
+      const n = r.count(max ?? r.wire.maxSliceLen);
+      const v: T[] = [];
+      for (let i = 0; i < n; i++) {
+        const at = r.pos;
+        v.push(elem.read(r));
+        if (i > 0) {
+          check(v, i, r.base + at);
+        }
+      }
+      return v;
+    },
+  };
+}
+
+// order compares two values of the same type the way Rust orders them, as borsh.Compare does:
+// numbers by value, strings by their UTF-8 bytes, byte arrays byte by byte, arrays element by element
+// and then by length, objects field by field, and null first
+function order(a: unknown, b: unknown): number {
+  if (a === null || a === undefined || b === null || b === undefined) {
+    return (a == null ? 0 : 1) - (b == null ? 0 : 1);
+  }
+  if (typeof a === "string" && typeof b === "string") {
+    return compare(utf8Encoder.encode(a), utf8Encoder.encode(b));
+  }
+  if (a instanceof Uint8Array && b instanceof Uint8Array) {
+    return compare(a, b);
+  }
+  if (Array.isArray(a) && Array.isArray(b)) {
+    for (let i = 0; i < a.length && i < b.length; i++) {
+      const c = order(a[i], b[i]);
+      if (c !== 0) {
+        return c;
+      }
+    }
+    return a.length - b.length;
+  }
+  if (typeof a === "object" && typeof b === "object") {
+    const x = a as Record<string, unknown>;
+    const y = b as Record<string, unknown>;
+    for (const k of Object.keys(x)) {
+      const c = order(x[k], y[k]);
+      if (c !== 0) {
+        return c;
+      }
+    }
+    return 0;
+  }
+  const x = a as number | bigint | boolean;
+  const y = b as number | bigint | boolean;
+  return x < y ? -1 : x > y ? 1 : 0;
+}
+
+type Entry = { key: Uint8Array; value: Uint8Array; order: unknown };
+
+// sortEntries sorts encoded map entries by key, as borsh.SortMapEntries does
+function sortEntries(entries: Entry[]): Entry[] {
+  entries.sort((a, b) => order(a.order, b.order) || compare(a.key, b.key));
+  for (let i = 1; i < entries.length; i++) {
+    if (compare(entries[i - 1].key, entries[i].key) === 0) {
+      throw new BorshError("duplicate map key " + hex(entries[i].key));
+    }
+  }
+  return entries;
+}
+
+// entries encodes the keys and values of a map with write or encode
+function entries<K, V>(w: Writer, m: Iterable<[K, V]>, key: Codec<K>, value: Codec<V> | null, form: "write" | "encode"): Entry[] {
+  const out: Entry[] = [];
+  for (const [k, v] of m) {
+    const kw = new Writer(w.wire);
+    key[form](kw, k);
+    const vw = new Writer(w.wire);
+    if (value !== null) {
+      value[form](vw, v);
+    }
+    out.push({ key: kw.bytes(), value: vw.bytes(), order: k });
+  }
+  return sortEntries(out);
+}
+
+// readEntries reads n map entries, checking that keys ascend when the Reader is strict
+function readEntries<K>(r: Reader, max: number | undefined, key: Codec<K>, entry: (k: K) => void): void {
+  const n = r.count(max ?? r.wire.maxSliceLen);
+  let prev: K | undefined;
+  for (let i = 0; i < n; i++) {
+    const at = r.pos;
+    const k = key.read(r);
+    if (r.strict && i > 0) {
+      const c = order(prev, k);
+      if (c >= 0) {
+        throw new BorshError("map key " + hex(r.data.subarray(at, r.pos)) + (c === 0 ? " is a duplicate" : " is not sorted"), r.base + at);
+      }
+    }
+    prev = k;
+    entry(k);
+  }
+}
+
+// map is a map: its entry count followed by the entries sorted by their encoded key.
+// The Encode form sorts entries by their key in the Encode form and has no count
+export function map<K, V>(key: Codec<K>, value: Codec<V>, max?: number): Codec<Map<K, V>> {
+  return {
+    write(w, m) {
+      const sorted = entries(w, m, key, value, "write");
+      w.prefix(sorted.length);
+      for (const e of sorted) {
+        w.raw(e.key);
+        w.raw(e.value);
+      }
+    },
+    encode(w, m) {
+      for (const e of entries(w, m, key, value, "encode")) {
+        w.raw(e.key);
+        w.raw(e.value);
+      }
+    },
+    read(r) {
+      const m = new Map<K, V>();
+      readEntries(r, max, key, (k) => m.set(k, value.read(r)));
+      return m;
+    },
+  };
+}
+
+// keySet is a map of empty structs: a map without values
+export function keySet<K>(key: Codec<K>, max?: number): Codec<Set<K>> {
+  const pairs = (s: Set<K>): Iterable<[K, null]> => Array.from(s, (k): [K, null] => [k, null]);
+  return {
+    write(w, s) {
+      const sorted = entries(w, pairs(s), key, null, "write");
+      w.prefix(sorted.length);
+      for (const e of sorted) {
+        w.raw(e.key);
+      }
+    },
+    encode(w, s) {
+      for (const e of entries(w, pairs(s), key, null, "encode")) {
+        w.raw(e.key);
+      }
+    },
+    read(r) {
+      const s = new Set<K>();
+      readEntries(r, max, key, (k) => s.add(k));
+      return s;
+    },
+  };
+}
+
+// option is a pointer field: a flag of 0 for null, or 1 followed by the value.
+// The Encode form skips null fields and writes others without the flag
+export function option<T>(some: Codec<T>): Codec<T | null> {
+  return {
+    write(w, v) {
+      if (v === null || v === undefined) {
+        w.u8(0);
+        return;
+      }
+      w.u8(1);
+      some.write(w, v);
+    },
+    encode(w, v) {
+      if (v !== null && v !== undefined) {
+        some.encode(w, v);
+      }
+    },
+    read(r) {
+      return r.flag("option flag") ? some.read(r) : null;
+    },
+  };
+}
+
+// nested is a struct held by another one. The legacy layout writes it with a length prefix,
+// the Borsh layout inline. The Encode form is inline on both
+export function nested<T>(codec: () => Codec<T>): Codec<T> {
+  return {
+    write(w, v) {
+      if (!w.wire.nestedPrefix) {
+        codec().write(w, v);
+        return;
+      }
+      const sub = new Writer(w.wire);
+      codec().write(sub, v);
+      w.prefix(sub.length);
+      w.raw(sub.bytes());
+    },
+    encode(w, v) {
+      codec().encode(w, v);
+    },
+    read(r) {
+      if (!r.wire.nestedPrefix) {
+        return codec().read(r);
+      }
+      const sub = r.sub(r.prefix());
+      const v = codec().read(sub);
+      if (r.strict && sub.remaining > 0) {
+        throw sub.error(sub.remaining + " bytes after the value");
+      }
+      return v;
+    },
+  };
+}
+
+// Field is a struct field: its property name and codec
+export type Field = [name: string, codec: Codec<any>];
+
+// struct writes fields in order, with the layout of the file the struct was generated from, which
+// a struct of another package may not share, after the discriminator of the struct if it has one.
+// Its Encode form writes the fields named in encoded, in that order
+export function struct<T extends object>(wire: Wire, create: () => T, fields: Field[], encoded: string[], discriminator?: Uint8Array): Codec<T> {
+  const byName = new Map(fields);
+  return {
+    write(w, v) {
+      const o = v as Record<string, unknown>;
+      const outer = w.wire;
+      w.wire = wire;
+      try {
+        if (discriminator) {
+          w.raw(discriminator);
+        }
+        for (const [name, codec] of fields) {
+          codec.write(w, o[name]);
+        }
+      } finally {
+        w.wire = outer;
+      }
+    },
+    encode(w, v) {
+      const o = v as Record<string, unknown>;
+      for (const name of encoded) {
+        const codec = byName.get(name)!;
+        codec.encode(w, o[name]);
+      }
+    },
+    read(r) {
+      if (r.depth > wire.maxDepth) {
+        throw r.error("structs nested deeper than MaxDepth (" + wire.maxDepth + ")");
+      }
+      const outer = r.wire;
+      r.wire = wire;
+      r.depth++;
+      try {
+        if (discriminator) {
+          const at = r.base + r.pos;
+          const got = r.take(discriminator.length);
+          if (hex(got) !== hex(discriminator)) {
+            throw new BorshError("discriminator " + hex(got) + ", want " + hex(discriminator), at);
+          }
+        }
+        const v = create();
+        const o = v as Record<string, unknown>;
+        for (const [name, codec] of fields) {
+          o[name] = codec.read(r);
+        }
+        return v;
+      } finally {
+        r.depth--;
+        r.wire = outer;
+      }
+    },
+  };
+}
+
+// Variant is a variant of a Borsh enum: its discriminant, class and codec
+export type Variant = [index: number, type: abstract new (...args: any[]) => object, codec: Codec<any>];
+
+// enumeration is a Borsh enum: a u8 discriminant followed by the variant, written as a nested struct.
+// Variants are told apart by their class, and listed by a function as their classes may be declared
+// later. The Encode form is the MarshalBorsh form
+export function enumeration<T extends object>(name: string, variants: () => Variant[]): Codec<T> {
+  let resolved: [number, abstract new (...args: any[]) => object, Codec<any>][] | null = null;
+  const list = () => (resolved ??= variants().map(([index, type, codec]) => [index, type, nested(() => codec)]));
+  const write = (w: Writer, v: T): void => {
+    for (const [index, type, codec] of list()) {
+      if (v instanceof type) {
+        w.u8(index);
+        codec.write(w, v);
+        return;
+      }
+    }
+    throw new BorshError(v === null || v === undefined ? "nil " + name : "unknown " + name + " variant " + String(v));
+  };
+  return fixed(write, (r) => {
+    const index = r.u8();
+    const variant = list().find(([i]) => i === index);
+    if (variant === undefined) {
+      throw new BorshError("unknown " + name + " variant index " + index, r.base + r.pos - 1);
+    }
+    return variant[2].read(r);
+  });
+}
+
+// valueEnum is a C-style enum: one of values, written in width bytes
+export function valueEnum<T extends number>(name: string, width: 1 | 2 | 4, values: T[]): Codec<T> {
+  const valid = new Set<number>(values);
+  const int = width === 1 ? u8 : width === 2 ? u16 : u32;
+  return fixed(
+    (w, v) => {
+      if (!valid.has(v)) {
+        throw new BorshError("invalid " + name + " value " + v);
+      }
+      int.write(w, v);
+    },
+    (r) => {
+      const v = int.read(r);
+      if (!valid.has(v)) {
+        throw new BorshError("invalid " + name + " value " + v, r.base + r.pos - width);
+      }
+      return v as T;
+    },
+  );
+}
+
+// prefixed is a value written by a custom element encoder: length-prefixed bytes, which the
+// Encode form writes without the prefix. inner must read all the bytes
+export function prefixed<T>(inner: Codec<T>): Codec<T> {
+  return {
+    write(w, v) {
+      const sub = new Writer(w.wire);
+      inner.write(sub, v);
+      w.prefix(sub.length);
+      w.raw(sub.bytes());
+    },
+    encode(w, v) {
+      inner.write(w, v);
+    },
+    read(r) {
+      const sub = r.sub(r.prefix());
+      const v = inner.read(sub);
+      if (sub.remaining > 0) {
+        throw sub.error(sub.remaining + " bytes after the value");
+      }
+      return v;
+    },
+  };
+}
+
+// rest is all the bytes left, the value of a custom field encoder or a json.RawMessage
+const rest: Codec<Uint8Array> = fixed((w, v) => w.raw(v), (r) => r.take(r.remaining).slice());
+
+// zeroUnix is the Unix time in seconds of Go's zero time.Time
+const zeroUnix = -62135596800n;
+
+// Timestamp is a time.Time: seconds since the Unix epoch and nanoseconds within the second.
+// A new Timestamp is Go's zero time
+export class Timestamp {
+  seconds: bigint;
+  nanos: number;
+
+  constructor(seconds = zeroUnix, nanos = 0) {
+    this.seconds = seconds;
+    this.nanos = nanos;
+  }
+
+  static fromDate(d: Date): Timestamp {
+    const ms = BigInt(d.getTime());
+    const s = ms >= 0n ? ms / 1000n : -((-ms + 999n) / 1000n);
+    return new Timestamp(s, Number(ms - s * 1000n) * 1e6);
+  }
+
+  toDate(): Date {
+    return new Date(Number(this.seconds) * 1000 + Math.floor(this.nanos / 1e6));
+  }
+}
+
+// time is a time.Time field, as i64 seconds and u32 nanoseconds
+export const time: Codec<Timestamp> = fixed(
+  (w, v) => {
+    w.i64(v.seconds);
+    w.u32(v.nanos);
+  },
+  (r) => {
+    const seconds = r.i64();
+    const nanos = r.u32();
+    if (nanos >= 1e9) {
+      throw new BorshError("invalid nanoseconds " + nanos, r.base + r.pos - 4);
+    }
+    return new Timestamp(seconds, nanos);
+  },
+);
+
+// timeUnix, timeUnixMilli and timeUnixNano are time.Time fields tagged unix, unixmilli and unixnano,
+// as an i64 count since the Unix epoch. Go's zero time is zeroTimeUnix and its variants
+export const timeUnix: Codec<bigint> = i64;
+export const timeUnixMilli: Codec<bigint> = i64;
+export const timeUnixNano: Codec<bigint> = i64;
+export const zeroTimeUnix = zeroUnix;
+export const zeroTimeUnixMilli = zeroUnix * 1000n;
+export const zeroTimeUnixNano = -(1n << 63n);
+
+// uuid is a UUID as its 16 bytes
+export const uuid: Codec<Uint8Array> = fixedBytes(16);
+
+// opaque is the output of a custom field encoder or a json.RawMessage, which TypeScript holds as bytes
+export const opaque: Codec<Uint8Array> = prefixed(rest);
+
+// serialize returns the MarshalBorsh form of v
+export function serialize<T>(wire: Wire, codec: Codec<T>, v: T): Uint8Array {
+  const w = new Writer(wire);
+  codec.write(w, v);
+  return w.bytes();
+}
+
+// encode returns the Encode form of v
+export function encode<T>(wire: Wire, codec: Codec<T>, v: T): Uint8Array {
+  const w = new Writer(wire);
+  codec.encode(w, v);
+  return w.bytes();
+}
+
+// deserialize decodes data. Trailing bytes are rejected when decoding is strict
+export function deserialize<T>(wire: Wire, codec: Codec<T>, data: Uint8Array, strict = wire.strict): T {
+  const r = new Reader(data, wire, strict);
+  const v = codec.read(r);
+  if (strict && r.remaining > 0) {
+    throw r.error(r.remaining + " bytes after the value");
+  }
+  return v;
+}
+
+// Fixture is a value encoded by Go: the hex of its MarshalBorsh and Encode forms
+export interface Fixture {
+  type: string;
+  borsh: string;
+  encode: string;
+}
+
+interface Decodable {
+  deserialize(buf: Uint8Array): { serialize(): Uint8Array; encode(): Uint8Array };
+}
+
+function unhex(s: string): Uint8Array {
+  const b = new Uint8Array(s.length / 2);
+  for (let i = 0; i < b.length; i++) {
+    b[i] = parseInt(s.slice(2 * i, 2 * i + 2), 16);
+  }
+  return b;
+}
+
+// checkFixtures decodes every fixture with the class of its type and checks that encoding it
+// again gives the bytes Go wrote. It returns the number of fixtures checked
+export function checkFixtures(fixtures: Fixture[], classes: Record<string, Decodable>): number {
+  for (const [i, f] of fixtures.entries()) {
+    const type = classes[f.type];
+    if (type === undefined) {
+      throw new BorshError("fixture " + i + ": no class " + f.type);
+    }
+    const v = type.deserialize(unhex(f.borsh));
+    const got = hex(v.serialize());
+    if (got !== f.borsh) {
+      throw new BorshError("fixture " + i + ": " + f.type + ".serialize() = " + got + ", want " + f.borsh);
+    }
+    const enc = hex(v.encode());
+    if (enc !== f.encode) {
+      throw new BorshError("fixture " + i + ": " + f.type + ".encode() = " + enc + ", want " + f.encode);
+    }
+  }
+  return fixtures.length;
+}
+`
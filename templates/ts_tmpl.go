@@ -0,0 +1,123 @@
+package templates
+
+// TypeScriptTemplate is the TypeScript file written with -lang=ts: a class for each struct with
+// serialize(), deserialize() and encode() writing the bytes of MarshalBorsh, UnmarshalBorsh and
+// Encode, and the enums the structs refer to
+const TypeScriptTemplate = `// Code generated by borshgen from {{.Source}}. DO NOT EDIT.
+
+import * as borsh from "./borshgen.ts";
+{{- range .Imports}}
+import { {{range $i, $n := .Names}}{{if $i}}, {{end}}{{$n}}{{end}} } from "{{.Path}}";
+{{- end}}
+
+// wire is the layout and the limits of the Go code generated from {{.Source}}
+export const wire: borsh.Wire = {
+  prefixSize: {{if .Options.IsBorshWire}}4{{else}}2{{end}},
+  nestedPrefix: {{if .Options.IsBorshWire}}false{{else}}true{{end}},
+  strict: {{.Options.Strict}},
+  maxStringLen: {{.Options.MaxStringLen}},
+  maxSliceLen: {{.Options.MaxSliceLen}},
+  maxDepth: {{.Options.MaxDepth}},
+};
+{{- range .Enums}}
+{{if .Width}}
+// {{.Name}} is the C-style enum {{.GoName}}
+export const {{.Name}} = {
+{{- range .Constants}}
+  {{.Name}}: {{.Value}},
+{{- end}}
+} as const;
+export type {{.Name}} = (typeof {{.Name}})[keyof typeof {{.Name}}];
+export const {{.Name}}Codec: borsh.Codec<{{.Name}}> = borsh.valueEnum<{{.Name}}>("{{.Name}}", {{.Width}}, [{{.Values}}]);
+{{- else}}
+// {{.Name}} is the Borsh enum {{.GoName}}, one of its variant classes
+export type {{.Name}} = {{.Union}};
+export const {{.Name}}Codec: borsh.Codec<{{.Name}}> = borsh.enumeration<{{.Name}}>("{{.Name}}", () => [
+{{- range .Variants}}
+  [{{.Value}}, {{.Name}}, {{.Name}}.codec],
+{{- end}}
+]);
+{{- end}}
+{{- end}}
+{{- range .Classes}}
+
+// {{.Name}} is {{.GoName}}
+export class {{.Generic}} {
+{{- range .Fields}}
+  {{.Name}}{{if .Zero}}: {{.Type}} = {{.Zero}}{{else}}!: {{.Type}}{{end}};
+{{- end}}
+{{- if .Fields}}
+{{end}}
+  constructor(init?: Partial<{{.Generic}}>) {
+    Object.assign(this, init);
+  }
+{{if .TypeParams}}
+  // codec returns the codec of {{.Name}} with the given codecs of its type parameters
+  static codec<{{range $i, $p := .TypeParams}}{{if $i}}, {{end}}{{$p}}{{end}}>({{.CodecParams}}): borsh.Codec<{{.Generic}}> {
+    return borsh.struct(wire, () => new {{.Generic}}(), [
+{{- range .Fields}}
+      ["{{.Name}}", {{.Codec}}],
+{{- end}}
+{{- if .Fields}}
+    {{end}}], [{{range $i, $n := .Encoded}}{{if $i}}, {{end}}"{{$n}}"{{end}}]);
+  }
+
+  // serialize returns the bytes MarshalBorsh writes
+  serialize({{.CodecParams}}): Uint8Array {
+    return borsh.serialize(wire, {{.Name}}.codec({{.CodecArgs}}), this);
+  }
+
+  // deserialize decodes the bytes UnmarshalBorsh reads
+  static deserialize<{{range $i, $p := .TypeParams}}{{if $i}}, {{end}}{{$p}}{{end}}>({{.CodecParams}}, buf: Uint8Array, strict = wire.strict): {{.Generic}} {
+    return borsh.deserialize(wire, {{.Name}}.codec({{.CodecArgs}}), buf, strict);
+  }
+
+  // encode returns the bytes Encode writes
+  encode({{.CodecParams}}): Uint8Array {
+    return borsh.encode(wire, {{.Name}}.codec({{.CodecArgs}}), this);
+  }
+{{- else}}
+{{- if .Discriminator}}
+  // discriminator starts the bytes serialize returns
+  static readonly discriminator = new Uint8Array([{{.Discriminator}}]);
+{{end}}
+  static readonly codec: borsh.Codec<{{.Name}}> = borsh.struct(wire, () => new {{.Name}}(), [
+{{- range .Fields}}
+    ["{{.Name}}", {{.Codec}}],
+{{- end}}
+{{- if .Fields}}
+  {{end}}], [{{range $i, $n := .Encoded}}{{if $i}}, {{end}}"{{$n}}"{{end}}]{{if .Discriminator}}, {{.Name}}.discriminator{{end}});
+
+  // serialize returns the bytes MarshalBorsh writes
+  serialize(): Uint8Array {
+    return borsh.serialize(wire, {{.Name}}.codec, this);
+  }
+
+  // deserialize decodes the bytes UnmarshalBorsh reads
+  static deserialize(buf: Uint8Array, strict = wire.strict): {{.Name}} {
+    return borsh.deserialize(wire, {{.Name}}.codec, buf, strict);
+  }
+
+  // encode returns the bytes Encode writes
+  encode(): Uint8Array {
+    return borsh.encode(wire, {{.Name}}.codec, this);
+  }
+{{- end}}
+}
+{{- end}}
+`
+
+// TypeScriptTestTemplate checks the classes of a TypeScript file against the fixtures written by the
+// TestTypeScript<File> test generated with -gen-tests, which runs it with node
+const TypeScriptTestTemplate = `// Code generated by borshgen. DO NOT EDIT.
+
+// Decodes the values TestTypeScript{{.Test}} encodes in Go and checks that the classes of
+// {{.Base}}.ts encode them to the same bytes.
+import { readFileSync } from "node:fs";
+import * as borsh from "./borshgen.ts";
+import { {{range $i, $n := .Classes}}{{if $i}}, {{end}}{{$n}}{{end}} } from "./{{.Base}}.ts";
+
+const fixtures: borsh.Fixture[] = JSON.parse(readFileSync(new URL("./{{.Base}}.fixtures.json", import.meta.url), "utf8"));
+const n = borsh.checkFixtures(fixtures, { {{range $i, $n := .Classes}}{{if $i}}, {{end}}{{$n}}{{end}} });
+console.log("ok " + n + " fixtures");
+`
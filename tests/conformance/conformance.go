@@ -0,0 +1,131 @@
+// Package conformance holds one struct per row of the type table in the README.
+// Their encodings are checked against the hand-written fixtures in testdata, which follow the Borsh spec
+package conformance
+
+import (
+	"math/big"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Primitives struct {
+	Flag bool          `msg:"flag"`
+	U8   uint8         `msg:"u8"`
+	U16  uint16        `msg:"u16"`
+	U32  uint32        `msg:"u32"`
+	U64  uint64        `msg:"u64"`
+	U128 borsh.Uint128 `msg:"u128"`
+	I8   int8          `msg:"i8"`
+	I16  int16         `msg:"i16"`
+	I32  int32         `msg:"i32"`
+	I64  int64         `msg:"i64"`
+	I128 *big.Int      `msg:"i128,i128"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Floats struct {
+	F32 float32 `msg:"f32"`
+	F64 float64 `msg:"f64"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Strings struct {
+	Empty string `msg:"empty"`
+	ASCII string `msg:"ascii"`
+	UTF8  string `msg:"utf8"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Arrays struct {
+	Bytes [4]byte   `msg:"bytes"`
+	Words [2]uint16 `msg:"words"`
+	Names [2]string `msg:"names"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Vectors struct {
+	Bytes   []byte   `msg:"bytes"`
+	Numbers []uint32 `msg:"numbers"`
+	Names   []string `msg:"names"`
+	Empty   []uint64 `msg:"empty"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Point struct {
+	X int32 `msg:"x"`
+	Y int32 `msg:"y"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Options struct {
+	Some  *uint32 `msg:"some"`
+	None  *uint32 `msg:"none"`
+	Name  *string `msg:"name"`
+	Point *Point  `msg:"point"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Nested struct {
+	Origin Point   `msg:"origin"`
+	Path   []Point `msg:"path"`
+	Label  string  `msg:"label"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Maps struct {
+	Counts map[uint8]uint16 `msg:"counts"`
+	Names  map[string]uint8 `msg:"names"`
+	Empty  map[uint32]bool  `msg:"empty"`
+	Ports  map[uint16]uint8 `msg:"ports"`
+	Ids    map[uint32]uint8 `msg:"ids"`
+	Words  map[string]uint8 `msg:"words"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Sets struct {
+	Flags map[uint8]struct{}  `msg:"flags"`
+	Tags  []string            `msg:"tags,set"`
+	Ports map[uint16]struct{} `msg:"ports"`
+	Ids   []uint32            `msg:"ids,set"`
+	Words map[string]struct{} `msg:"words"`
+}
+
+// Shape is a Borsh enum with two variants
+//
+//borshgen:enum Shape = Circle | Rect
+type Shape interface {
+	isShape()
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Circle struct {
+	Radius uint32 `msg:"radius"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Rect struct {
+	W uint16 `msg:"w"`
+	H uint16 `msg:"h"`
+}
+
+func (Circle) isShape() {}
+func (Rect) isShape()   {}
+
+// Color is a unit-only enum, written as a u8
+//
+//borshgen:enum
+type Color uint8
+
+const (
+	ColorRed Color = iota
+	ColorGreen
+	ColorBlue
+)
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict
+type Enums struct {
+	First Shape   `msg:"first"`
+	All   []Shape `msg:"all"`
+	Color Color   `msg:"color"`
+}
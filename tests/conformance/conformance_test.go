@@ -0,0 +1,161 @@
+package conformance
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/borsh/borshtest"
+)
+
+// fixture reads testdata/<name>.hex: hex bytes separated by white space, with # comments
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name+".hex"))
+	if err != nil {
+		t.Fatalf("failed to open fixture: %v", err)
+	}
+	defer f.Close()
+
+	var data []byte
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text, _, _ := strings.Cut(scanner.Text(), "#")
+		for _, field := range strings.Fields(text) {
+			b, err := hex.DecodeString(field)
+			if err != nil {
+				t.Fatalf("%s.hex:%d: %v", name, line, err)
+			}
+			data = append(data, b...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	return data
+}
+
+type marshaler interface {
+	MarshalBorsh() ([]byte, error)
+	BinarySize() (int, error)
+	BorshSchema() *borsh.Schema
+}
+
+type strictUnmarshaler interface {
+	UnmarshalBorshStrict(data []byte) error
+}
+
+func TestConformance(t *testing.T) {
+	seven, hi := uint32(7), "hi"
+	cases := []struct {
+		fixture string
+		value   marshaler
+	}{
+		{"primitives", Primitives{
+			Flag: true,
+			U8:   0x12,
+			U16:  0x1234,
+			U32:  0x12345678,
+			U64:  0x0102030405060708,
+			U128: borsh.NewUint128(1, 2),
+			I8:   -2,
+			I16:  -300,
+			I32:  -70000,
+			I64:  -1,
+			I128: big.NewInt(-2),
+		}},
+		{"floats", Floats{F32: 1.5, F64: -0.25}},
+		{"strings", Strings{Empty: "", ASCII: "borsh", UTF8: "héllo"}},
+		{"arrays", Arrays{Bytes: [4]byte{1, 2, 3, 4}, Words: [2]uint16{1, 256}, Names: [2]string{"a", ""}}},
+		{"vectors", Vectors{Bytes: []byte{0xde, 0xad}, Numbers: []uint32{1, 2}, Names: []string{"x"}, Empty: []uint64{}}},
+		{"options", Options{Some: &seven, Name: &hi, Point: &Point{X: 1, Y: -1}}},
+		{"nested", Nested{Origin: Point{X: -5, Y: 5}, Path: []Point{{X: 1, Y: 2}}, Label: "n"}},
+		{"maps", Maps{
+			Counts: map[uint8]uint16{2: 512, 1: 1},
+			Names:  map[string]uint8{"b": 2, "a": 1},
+			Empty:  map[uint32]bool{},
+			Ports:  map[uint16]uint8{256: 2, 1: 1},
+			Ids:    map[uint32]uint8{256: 2, 1: 1},
+			Words:  map[string]uint8{"b": 2, "aa": 1},
+		}},
+		{"sets", Sets{
+			Flags: map[uint8]struct{}{3: {}, 1: {}},
+			Tags:  []string{"a", "b"},
+			Ports: map[uint16]struct{}{256: {}, 1: {}},
+			Ids:   []uint32{1, 256},
+			Words: map[string]struct{}{"b": {}, "aa": {}},
+		}},
+		{"enums", Enums{First: Rect{W: 3, H: 4}, All: []Shape{Circle{Radius: 9}, Rect{W: 1, H: 1}}, Color: ColorBlue}},
+	}
+	opts := borshtest.Options{
+		Tag:      "msg",
+		Fallback: "json",
+		Variants: map[reflect.Type][]any{reflect.TypeOf((*Shape)(nil)).Elem(): {Circle{}, Rect{}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.fixture, func(t *testing.T) {
+			want := fixture(t, c.fixture)
+
+			data, err := c.value.MarshalBorsh()
+			if err != nil {
+				t.Fatalf("MarshalBorsh() failed: %v", err)
+			}
+			if !bytes.Equal(data, want) {
+				t.Errorf("MarshalBorsh() = %x, want %x", data, want)
+			}
+			if size, err := c.value.BinarySize(); err != nil || size != len(want) {
+				t.Errorf("BinarySize() = %d, %v, want %d", size, err, len(want))
+			}
+
+			if err := borshtest.Walk(c.value.BorshSchema(), want); err != nil {
+				t.Errorf("BorshSchema() does not describe the fixture: %v", err)
+			}
+
+			decoded := reflect.New(reflect.TypeOf(c.value))
+			if err := decoded.Interface().(strictUnmarshaler).UnmarshalBorshStrict(want); err != nil {
+				t.Fatalf("UnmarshalBorshStrict() of the fixture failed: %v", err)
+			}
+			if diff := borshtest.Diff(c.value, decoded.Elem().Interface(), opts); diff != "" {
+				t.Errorf("UnmarshalBorshStrict() of the fixture: %s", diff)
+			}
+		})
+	}
+}
+
+// TestSchemaJSON checks the JSON of a schema against testdata/enums.schema.json,
+// written in the layout of Rust's BorshSchemaContainer, and that it reads back to the same schema
+func TestSchemaJSON(t *testing.T) {
+	want, err := os.ReadFile(filepath.Join("testdata", "enums.schema.json"))
+	if err != nil {
+		t.Fatalf("failed to read the schema: %v", err)
+	}
+	schema := Enums{}.BorshSchema()
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(schema); err != nil {
+		t.Fatalf("failed to encode the schema: %v", err)
+	}
+	if buf.String() != string(want) {
+		t.Errorf("schema JSON = %s\nwant %s", buf.String(), want)
+	}
+
+	var restored borsh.Schema
+	if err := json.Unmarshal(want, &restored); err != nil {
+		t.Fatalf("failed to decode the schema: %v", err)
+	}
+	if !reflect.DeepEqual(&restored, schema) {
+		t.Errorf("decoded schema = %+v, want %+v", restored, schema)
+	}
+}
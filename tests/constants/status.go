@@ -0,0 +1,15 @@
+package constants
+
+// Status is the lifecycle state of an account
+//
+//borshgen:enum
+type Status uint8
+
+const (
+	StatusPending Status = iota
+	StatusActive
+	StatusClosed
+
+	// StatusDefault is an alias and does not add a value
+	StatusDefault = StatusPending
+)
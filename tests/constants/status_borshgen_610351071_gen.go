@@ -0,0 +1,46 @@
+package constants
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+// String returns the name of the Status constant
+func (v Status) String() string {
+	switch v {
+	case StatusPending:
+		return "StatusPending"
+	case StatusActive:
+		return "StatusActive"
+	case StatusClosed:
+		return "StatusClosed"
+	}
+	return fmt.Sprintf("Status(%d)", v)
+}
+
+// Valid reports whether v is one of the declared Status constants
+func (v Status) Valid() bool {
+	switch v {
+	case StatusPending, StatusActive, StatusClosed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package discriminator
+
+// Vault is an Anchor account, whose data starts with sha256("account:Vault")[:8]
+//
+//borshgen:discriminator anchor-account
+//go:generate borshgen -tag=msg -wire=borsh -gen-tests -lang=ts,rust
+type Vault struct {
+	Owner   [32]byte `msg:"owner"`
+	Balance uint64   `msg:"balance"`
+	Label   string   `msg:"label"`
+}
+
+// DepositArgs is the data of the deposit instruction, which starts with sha256("global:deposit")[:8]
+//
+//borshgen:discriminator anchor-instruction
+//go:generate borshgen -tag=msg -wire=borsh -gen-tests -lang=ts,rust
+type DepositArgs struct {
+	Amount uint64  `msg:"amount"`
+	Memo   *string `msg:"memo"`
+}
+
+// Deposited is an Anchor event named differently in Rust
+//
+//borshgen:discriminator anchor-event=VaultDeposited
+//go:generate borshgen -tag=msg -wire=borsh -gen-tests -lang=ts,rust
+type Deposited struct {
+	Amount uint64 `msg:"amount"`
+}
+
+// Ping has a discriminator of its own length
+//
+//borshgen:discriminator hex=0badf00d
+//go:generate borshgen -tag=msg -wire=borsh -gen-tests -lang=ts,rust
+type Ping struct {
+	Seq uint32 `msg:"seq"`
+}
+
+// Batch has no discriminator, and holds structs that write theirs where they are nested
+//
+//go:generate borshgen -tag=msg -wire=borsh -gen-tests -lang=ts,rust
+type Batch struct {
+	Pings []Ping `msg:"pings"`
+	Vault *Vault `msg:"vault"`
+}
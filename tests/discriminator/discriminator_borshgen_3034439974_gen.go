@@ -0,0 +1,1295 @@
+package discriminator
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Vault) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Vault) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Vault", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Vault to buf.
+// The FieldPath of a returned EncodeError is relative to Vault
+func (s Vault) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Vault) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Vault in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Vault) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Vault", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Vault in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Vault) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Vault", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Vault to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Vault) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Vault", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Vault to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Vault
+func (s Vault) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorVault)
+		{
+		
+				// Owner (owner) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [32]Owner
+	for i := 0; i < 32; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Owner[i]))
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Balance))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Label
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Label", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Vault) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Vault", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Vault like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Vault, without trailing bytes
+func (s *Vault) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Vault", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Vault in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Vault, so wrap unbuffered readers in a bufio.Reader
+func (s *Vault) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Vault", err)
+}
+
+// ReadBorsh decodes Vault nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Vault
+func (s *Vault) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Vault is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorVault); err != nil {
+		return err
+	}
+	
+	// FIELDS: Vault
+    var err error
+		{
+		
+				// Owner (owner) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [32]byte{}
+	length := 32
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Owner", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Owner = (p)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Balance", err)
+	}
+	__m := uint64(__v)
+	s.Balance = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Label", err)
+	}
+	__m := string(__s)
+	s.Label = (__m)
+		}
+	return err
+}
+func (s Vault) BinarySize() (int, error) {
+	size := 8
+		{
+		
+				// Owner (owner) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [32]Owner
+	for _, item := range  (s.Owner) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Label
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Vault by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Vault) CompareBorsh(other any) int {
+	o := other.(Vault)
+	_ = o
+	if c := borsh.Compare(s.Owner, o.Owner); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Balance, o.Balance); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Label, o.Label); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorVault starts the encoding of Vault
+var discriminatorVault = []byte{0xd3, 0x08, 0xe8, 0x2b, 0x02, 0x98, 0x75, 0x77}
+
+// BorshDiscriminator returns the bytes that start the encoding of Vault
+func (Vault) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorVault)
+}
+
+// BorshTypeID returns the type ID written before Vault in fields typed by an interface
+func (Vault) BorshTypeID() uint64 {
+	return 0x7b7dc397fb96815f
+}
+func init() {
+	Registry.Register(0x7b7dc397fb96815f, func() borsh.BorshEncoder { return new(Vault) })
+	Registry.RegisterDiscriminator(discriminatorVault, func() borsh.BorshEncoder { return new(Vault) })
+}
+
+// BorshSchema describes the Borsh encoding of Vault in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Vault) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Vault",
+		Definitions: map[string]borsh.Definition{
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vault": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "owner", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "balance", Declaration: "u64"},
+				borsh.Field{Name: "label", Declaration: "String"},),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s DepositArgs) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s DepositArgs) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("DepositArgs", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of DepositArgs to buf.
+// The FieldPath of a returned EncodeError is relative to DepositArgs
+func (s DepositArgs) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s DepositArgs) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends DepositArgs in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s DepositArgs) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("DepositArgs", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes DepositArgs in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s DepositArgs) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "DepositArgs", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes DepositArgs to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s DepositArgs) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("DepositArgs", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes DepositArgs to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to DepositArgs
+func (s DepositArgs) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorDepositArgs)
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Amount))
+		}
+		{
+			if s.Memo == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPMemo
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Memo (memo) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := *s.Memo
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Memo", err)
+					}
+					appendString(buf, string(str))
+		}
+					SKIPMemo:
+	return nil
+}
+func (s *DepositArgs) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("DepositArgs", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to DepositArgs like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of DepositArgs, without trailing bytes
+func (s *DepositArgs) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("DepositArgs", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads DepositArgs in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of DepositArgs, so wrap unbuffered readers in a bufio.Reader
+func (s *DepositArgs) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("DepositArgs", err)
+}
+
+// ReadBorsh decodes DepositArgs nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to DepositArgs
+func (s *DepositArgs) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "DepositArgs is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorDepositArgs); err != nil {
+		return err
+	}
+	
+	// FIELDS: DepositArgs
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+	__m := uint64(__v)
+	s.Amount = (__m)
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Memo", err)
+				}
+				if !present {
+					s.Memo = nil
+					goto SKIPMemo
+				} 
+			}
+		{
+		
+					// Memo (memo) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: string
+					// IsPointer: true
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Memo", err)
+	}
+	__m := string(__s)
+	s.Memo = &(__m)
+		}
+					SKIPMemo:
+	return err
+}
+func (s DepositArgs) BinarySize() (int, error) {
+	size := 8
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+			size++
+			if s.Memo == nil {
+				goto SKIPMemo
+			}
+		}
+		{
+		
+					// Memo (memo) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+		// ElementType: string
+		
+			// Element: true
+				ptr := *s.Memo
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+			SKIPMemo:
+	return size, nil
+}
+
+// CompareBorsh orders DepositArgs by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s DepositArgs) CompareBorsh(other any) int {
+	o := other.(DepositArgs)
+	_ = o
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Memo, o.Memo); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorDepositArgs starts the encoding of DepositArgs
+var discriminatorDepositArgs = []byte{0xf2, 0x23, 0xc6, 0x89, 0x52, 0xe1, 0xf2, 0xb6}
+
+// BorshDiscriminator returns the bytes that start the encoding of DepositArgs
+func (DepositArgs) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorDepositArgs)
+}
+
+// BorshTypeID returns the type ID written before DepositArgs in fields typed by an interface
+func (DepositArgs) BorshTypeID() uint64 {
+	return 0xdc753440a241d94c
+}
+func init() {
+	Registry.Register(0xdc753440a241d94c, func() borsh.BorshEncoder { return new(DepositArgs) })
+	Registry.RegisterDiscriminator(discriminatorDepositArgs, func() borsh.BorshEncoder { return new(DepositArgs) })
+}
+
+// BorshSchema describes the Borsh encoding of DepositArgs in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (DepositArgs) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "DepositArgs",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"DepositArgs": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "amount", Declaration: "u64"},
+				borsh.Field{Name: "memo", Declaration: "Option<String>"},),
+			"Option<String>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "String"}),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Deposited) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Deposited) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Deposited", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Deposited to buf.
+// The FieldPath of a returned EncodeError is relative to Deposited
+func (s Deposited) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Deposited) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Deposited in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Deposited) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Deposited", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Deposited in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Deposited) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Deposited", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Deposited to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Deposited) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Deposited", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Deposited to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Deposited
+func (s Deposited) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorDeposited)
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Amount))
+		}
+	return nil
+}
+func (s *Deposited) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Deposited", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Deposited like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Deposited, without trailing bytes
+func (s *Deposited) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Deposited", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Deposited in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Deposited, so wrap unbuffered readers in a bufio.Reader
+func (s *Deposited) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Deposited", err)
+}
+
+// ReadBorsh decodes Deposited nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Deposited
+func (s *Deposited) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Deposited is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorDeposited); err != nil {
+		return err
+	}
+	
+	// FIELDS: Deposited
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+	__m := uint64(__v)
+	s.Amount = (__m)
+		}
+	return err
+}
+func (s Deposited) BinarySize() (int, error) {
+	size := 8
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Deposited by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Deposited) CompareBorsh(other any) int {
+	o := other.(Deposited)
+	_ = o
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorDeposited starts the encoding of Deposited
+var discriminatorDeposited = []byte{0x3b, 0x3e, 0x2b, 0xc8, 0xdc, 0x68, 0x64, 0x43}
+
+// BorshDiscriminator returns the bytes that start the encoding of Deposited
+func (Deposited) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorDeposited)
+}
+
+// BorshTypeID returns the type ID written before Deposited in fields typed by an interface
+func (Deposited) BorshTypeID() uint64 {
+	return 0x69adade5de2ad39e
+}
+func init() {
+	Registry.Register(0x69adade5de2ad39e, func() borsh.BorshEncoder { return new(Deposited) })
+	Registry.RegisterDiscriminator(discriminatorDeposited, func() borsh.BorshEncoder { return new(Deposited) })
+}
+
+// BorshSchema describes the Borsh encoding of Deposited in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Deposited) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Deposited",
+		Definitions: map[string]borsh.Definition{
+			"Deposited": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Ping) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Ping) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Ping", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Ping to buf.
+// The FieldPath of a returned EncodeError is relative to Ping
+func (s Ping) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Ping) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Ping in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Ping) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Ping", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Ping in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Ping) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Ping", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Ping to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Ping) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Ping", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Ping to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Ping
+func (s Ping) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorPing)
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(s.Seq))
+		}
+	return nil
+}
+func (s *Ping) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Ping", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Ping like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Ping, without trailing bytes
+func (s *Ping) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Ping", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Ping in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Ping, so wrap unbuffered readers in a bufio.Reader
+func (s *Ping) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Ping", err)
+}
+
+// ReadBorsh decodes Ping nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Ping
+func (s *Ping) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Ping is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorPing); err != nil {
+		return err
+	}
+	
+	// FIELDS: Ping
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("Seq", err)
+	}
+	__m := uint32(__v)
+	s.Seq = (__m)
+		}
+	return err
+}
+func (s Ping) BinarySize() (int, error) {
+	size := 4
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Ping by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Ping) CompareBorsh(other any) int {
+	o := other.(Ping)
+	_ = o
+	if c := borsh.Compare(s.Seq, o.Seq); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorPing starts the encoding of Ping
+var discriminatorPing = []byte{0x0b, 0xad, 0xf0, 0x0d}
+
+// BorshDiscriminator returns the bytes that start the encoding of Ping
+func (Ping) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorPing)
+}
+
+// BorshTypeID returns the type ID written before Ping in fields typed by an interface
+func (Ping) BorshTypeID() uint64 {
+	return 0x620de6eea4f70e61
+}
+func init() {
+	Registry.Register(0x620de6eea4f70e61, func() borsh.BorshEncoder { return new(Ping) })
+	Registry.RegisterDiscriminator(discriminatorPing, func() borsh.BorshEncoder { return new(Ping) })
+}
+
+// BorshSchema describes the Borsh encoding of Ping in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Ping) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Ping",
+		Definitions: map[string]borsh.Definition{
+			"Ping": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 4]"},
+				borsh.Field{Name: "seq", Declaration: "u32"},),
+			"[u8; 4]": borsh.SequenceDef(0, 4, 4, "u8"),
+			"u32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Batch) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Batch) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Batch", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Batch to buf.
+// The FieldPath of a returned EncodeError is relative to Batch
+func (s Batch) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Batch) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Batch in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Batch) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Batch", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Batch in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Batch) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Batch", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Batch to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Batch) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Batch", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Batch to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Batch
+func (s Batch) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Pings (pings) - slice
+				// ElementType: []Ping
+				// Type: []Ping
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Pings: []Pings
+	if err := checkLength("slice", len((s.Pings)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Pings", err)
+	}
+	 appendLength(buf, len((s.Pings)))
+		for i, item := range (s.Pings) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Seq uint32 "msg:\"seq\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Pings", i), err)
+					}
+		}
+		}
+		{
+			if s.Vault == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPVault
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Vault (vault) - Pointer
+					// ElementType: struct{Owner [32]byte "msg:\"owner\""; Balance uint64 "msg:\"balance\""; Label string "msg:\"label\""}
+					// Type: Vault
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Vault
+					if err := writeNested(buf, s.Vault); err != nil {
+						return borsh.EncodeFieldError("Vault", err)
+					}
+		}
+					SKIPVault:
+	return nil
+}
+func (s *Batch) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Batch", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Batch like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Batch, without trailing bytes
+func (s *Batch) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Batch", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Batch in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Batch, so wrap unbuffered readers in a bufio.Reader
+func (s *Batch) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Batch", err)
+}
+
+// ReadBorsh decodes Batch nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Batch
+func (s *Batch) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Batch is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Batch
+    var err error
+		{
+		
+				// Pings (pings) - slice
+				// ElementType: []Ping
+				// Type: []Ping
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Pings: []Pings
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Pings", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Ping](r, length)
+		if err != nil {
+			return r.FieldError("Pings", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Seq uint32 "msg:\"seq\""}
+			// Element Ping
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Ping{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Pings", i), err)
+					}
+						p[i] = *m
+		}
+				s.Pings =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Vault", err)
+				}
+				if !present {
+					s.Vault = nil
+					goto SKIPVault
+				} 
+			}
+		{
+		
+					// Vault (vault) - Pointer
+					// ElementType: struct{Owner [32]byte "msg:\"owner\""; Balance uint64 "msg:\"balance\""; Label string "msg:\"label\""}
+					// Type: Vault
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Vault
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Vault{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Vault", err)
+					}
+						s.Vault = m
+		}
+					SKIPVault:
+	return err
+}
+func (s Batch) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Pings (pings) - slice
+				// ElementType: []Ping
+				// Type: []Ping
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Pings: []Pings
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Pings) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Seq uint32 "msg:\"seq\""}
+			// Element Ping
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Pings", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+		{
+			size++
+			if s.Vault == nil {
+				goto SKIPVault
+			}
+		}
+		{
+		
+					// Vault (vault) - Pointer
+					// ElementType: struct{Owner [32]byte "msg:\"owner\""; Balance uint64 "msg:\"balance\""; Label string "msg:\"label\""}
+					// Type: Vault
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Vault
+	
+		// ElementType: struct{Owner [32]byte "msg:\"owner\""; Balance uint64 "msg:\"balance\""; Label string "msg:\"label\""}
+		
+			// Element: false
+	
+		// ElementType: struct{Owner [32]byte "msg:\"owner\""; Balance uint64 "msg:\"balance\""; Label string "msg:\"label\""}
+			bs, err := borsh.SizeOf(s.Vault)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Vault", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPVault:
+	return size, nil
+}
+
+// CompareBorsh orders Batch by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Batch) CompareBorsh(other any) int {
+	o := other.(Batch)
+	_ = o
+	if c := borsh.Compare(s.Pings, o.Pings); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Vault, o.Vault); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Batch in fields typed by an interface
+func (Batch) BorshTypeID() uint64 {
+	return 0x74a81f06a0d4d2ad
+}
+func init() {
+	Registry.Register(0x74a81f06a0d4d2ad, func() borsh.BorshEncoder { return new(Batch) })
+}
+
+// BorshSchema describes the Borsh encoding of Batch in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Batch) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Batch",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Batch": borsh.StructDef(
+				borsh.Field{Name: "pings", Declaration: "Vec<Ping>"},
+				borsh.Field{Name: "vault", Declaration: "Option<Vault>"},),
+			"Option<Vault>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Vault"}),
+			"Ping": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 4]"},
+				borsh.Field{Name: "seq", Declaration: "u32"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vault": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "owner", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "balance", Declaration: "u64"},
+				borsh.Field{Name: "label", Declaration: "String"},),
+			"Vec<Ping>": borsh.SequenceDef(4, 0, 4294967295, "Ping"),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"[u8; 4]": borsh.SequenceDef(0, 4, 4, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package discriminator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+type discriminated interface {
+	BorshDiscriminator() []byte
+	MarshalBorsh() ([]byte, error)
+	BinarySize() (int, error)
+}
+
+// TestDiscriminators checks the discriminators against the first bytes of the sha256 hashes
+// Anchor computes, e.g. of "account:Vault"
+func TestDiscriminators(t *testing.T) {
+	cases := []struct {
+		value discriminated
+		want  string
+	}{
+		{Vault{}, "d308e82b02987577"},       // account:Vault
+		{DepositArgs{}, "f223c68952e1f2b6"}, // global:deposit
+		{Deposited{}, "3b3e2bc8dc686443"},   // event:VaultDeposited
+		{Ping{}, "0badf00d"},
+	}
+	for _, c := range cases {
+		if got := hex.EncodeToString(c.value.BorshDiscriminator()); got != c.want {
+			t.Errorf("%T.BorshDiscriminator() = %s, want %s", c.value, got, c.want)
+		}
+		data, err := c.value.MarshalBorsh()
+		if err != nil {
+			t.Fatalf("%T.MarshalBorsh() failed: %v", c.value, err)
+		}
+		if got := hex.EncodeToString(data); got[:len(c.want)] != c.want {
+			t.Errorf("%T.MarshalBorsh() = %s, want it to start with %s", c.value, got, c.want)
+		}
+		if size, err := c.value.BinarySize(); err != nil || size != len(data) {
+			t.Errorf("%T.BinarySize() = %d, %v, want %d", c.value, size, err, len(data))
+		}
+	}
+}
+
+func TestDiscriminatorMismatch(t *testing.T) {
+	data, err := Vault{Balance: 1}.MarshalBorsh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var args DepositArgs
+	err = args.UnmarshalBorsh(data)
+	var de *borsh.DecodeError
+	if !errors.As(err, &de) || de.Kind != borsh.UnknownDiscriminator || de.Offset != 0 || de.FieldPath != "DepositArgs" {
+		t.Fatalf("UnmarshalBorsh() of a Vault into DepositArgs = %v, want an UnknownDiscriminator error at offset 0", err)
+	}
+	if _, err := args.ReadBorshFrom(bytes.NewReader(data)); !errors.Is(err, borsh.UnknownDiscriminator) {
+		t.Fatalf("ReadBorshFrom() of a Vault into DepositArgs = %v, want an UnknownDiscriminator error", err)
+	}
+}
+
+// TestNestedDiscriminator checks that a struct writes its discriminator where it is nested too
+func TestNestedDiscriminator(t *testing.T) {
+	batch := Batch{Pings: []Ping{{Seq: 1}}}
+	data, err := batch.MarshalBorsh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		1, 0, 0, 0, // Pings: u32 count
+		0x0b, 0xad, 0xf0, 0x0d, 1, 0, 0, 0, // Ping: discriminator and Seq
+		0, // Vault: None
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %x, want %x", data, want)
+	}
+	var restored Batch
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored, batch) {
+		t.Fatalf("UnmarshalBorsh() = %+v, want %+v", restored, batch)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	memo := "rent"
+	values := []borsh.BorshEncoder{
+		&Vault{Owner: [32]byte{1}, Balance: 10, Label: "main"},
+		&DepositArgs{Amount: 5, Memo: &memo},
+		&Deposited{Amount: 5},
+		&Ping{Seq: 7},
+	}
+	for _, v := range values {
+		data, err := v.MarshalBorsh()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Registry.Decode(data)
+		if err != nil {
+			t.Fatalf("Registry.Decode() of %T failed: %v", v, err)
+		}
+		if !reflect.DeepEqual(got, v) {
+			t.Errorf("Registry.Decode() = %#v, want %#v", got, v)
+		}
+	}
+
+	data, err := Batch{}.MarshalBorsh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Registry.Decode(data); !errors.Is(err, borsh.UnknownDiscriminator) {
+		t.Fatalf("Registry.Decode() of a Batch = %v, want an UnknownDiscriminator error", err)
+	}
+}
+
+func TestRegistryConflict(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("RegisterDiscriminator() of a prefix of a registered discriminator did not panic")
+		}
+	}()
+	r := borsh.NewRegistry()
+	r.RegisterDiscriminator(Ping{}.BorshDiscriminator(), func() borsh.BorshEncoder { return new(Ping) })
+	r.RegisterDiscriminator([]byte{0x0b, 0xad}, func() borsh.BorshEncoder { return new(Vault) })
+}
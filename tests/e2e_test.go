@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"math"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/mlayerprotocol/go-borshgen/borsh"
 	"github.com/mlayerprotocol/go-borshgen/tests/configs"
 )
       
@@ -527,4 +529,52 @@ func BenchmarkBinaryEncoding(b *testing.B) {
 			}
 		}
 	})
-}
\ No newline at end of file
+}
+func TestLegacyWireLayout(t *testing.T) {
+	path := EventPath{EntityPath: EntityPath{Name: "ab"}, ID: 1, Timestamp: 2}
+	data, err := path.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	want := []byte{
+		4, 0, 2, 0, 'a', 'b', // EntityPath: uint16 length prefix, then Name with uint16 length prefix
+		1, 0, 0, 0, 0, 0, 0, 0, // ID
+		2, 0, 0, 0, 0, 0, 0, 0, // Timestamp
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+	if size, _ := path.BinarySize(); size != len(want) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(want))
+	}
+	if WireFormat != "legacy" || LengthPrefixSize != 2 {
+		t.Errorf("unexpected wire constants: %s %d", WireFormat, LengthPrefixSize)
+	}
+}
+
+func TestLegacyLengthLimits(t *testing.T) {
+	// Legacy length prefixes are u16, so longer values fail rather than wrap around
+	if MaxStringLen != 65535 || MaxSliceLen != 65535 {
+		t.Errorf("MaxStringLen = %d, MaxSliceLen = %d, want both limited to 65535", MaxStringLen, MaxSliceLen)
+	}
+	_, err := EntityPath{Name: strings.Repeat("x", 70000)}.MarshalBorsh()
+	if borsh.KindOf(err) != borsh.LengthLimit {
+		t.Errorf("MarshalBorsh() of a 70000 byte string = %v, want a LengthLimit error", err)
+	}
+
+	// A name within MaxStringLen still makes EntityPath too long for its own prefix
+	path := EventPath{EntityPath: EntityPath{Name: strings.Repeat("x", MaxStringLen)}}
+	_, err = path.MarshalBorsh()
+	if borsh.KindOf(err) != borsh.LengthLimit {
+		t.Errorf("MarshalBorsh() of a %d byte nested struct = %v, want a LengthLimit error", MaxStringLen+2, err)
+	}
+	path.Name = strings.Repeat("x", MaxStringLen-2)
+	data, err := path.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() of the longest nested struct failed: %v", err)
+	}
+	var got EventPath
+	if err := got.UnmarshalBorsh(data); err != nil || got != path {
+		t.Errorf("UnmarshalBorsh() = %v, want the value back", err)
+	}
+}
@@ -0,0 +1,7 @@
+package common
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Header struct {
+	Version uint8  `msg:"version"`
+	Chain   string `msg:"chain"`
+}
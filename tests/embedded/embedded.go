@@ -0,0 +1,51 @@
+package embedded
+
+import "github.com/mlayerprotocol/go-borshgen/tests/embedded/common"
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Base struct {
+	ID   uint64 `msg:"id"`
+	Name string `msg:"name"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Meta struct {
+	Note string `msg:"note"`
+}
+
+// Record flattens Base and common.Header and nests Meta as an option
+//
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Record struct {
+	Base          `msg:",inline"`
+	common.Header `msg:",inline"`
+	*Meta         `msg:"meta,nested"`
+	Label         string `msg:"label"`
+	Value         uint32 `msg:"value"`
+}
+
+// Wrapped flattens pointer embeds, which must not be nil when marshaling
+//
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Wrapped struct {
+	*Base          `msg:",inline"`
+	*common.Header `msg:",inline"`
+	Extra          string `msg:"extra"`
+}
+
+// Nested embeds its structs as fields, the default
+//
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Nested struct {
+	Base
+	common.Header
+	Flag bool `msg:"flag"`
+}
+
+// Deep flattens a struct that itself flattens Base
+//
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Deep struct {
+	Record `msg:",inline"`
+	Seq    uint16 `msg:"seq"`
+}
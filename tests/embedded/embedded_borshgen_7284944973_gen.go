@@ -0,0 +1,1916 @@
+package embedded
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/embedded/common"
+)
+var _ common.Header
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Base) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Base) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Base", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Base to buf.
+// The FieldPath of a returned EncodeError is relative to Base
+func (s Base) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Base) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Base in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Base) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Base", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Base in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Base) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Base", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Base to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Base) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Base", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Base to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Base
+func (s Base) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.ID))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Name
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Name", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Base) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Base", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Base like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Base, without trailing bytes
+func (s *Base) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Base", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Base in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Base, so wrap unbuffered readers in a bufio.Reader
+func (s *Base) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Base", err)
+}
+
+// ReadBorsh decodes Base nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Base
+func (s *Base) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Base is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Base
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("ID", err)
+	}
+	__m := uint64(__v)
+	s.ID = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Name", err)
+	}
+	__m := string(__s)
+	s.Name = (__m)
+		}
+	return err
+}
+func (s Base) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Name
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Base by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Base) CompareBorsh(other any) int {
+	o := other.(Base)
+	_ = o
+	if c := borsh.Compare(s.ID, o.ID); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Name, o.Name); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Base in fields typed by an interface
+func (Base) BorshTypeID() uint64 {
+	return 0xbc09e4387237db24
+}
+func init() {
+	Registry.Register(0xbc09e4387237db24, func() borsh.BorshEncoder { return new(Base) })
+}
+
+// BorshSchema describes the Borsh encoding of Base in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Base) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Base",
+		Definitions: map[string]borsh.Definition{
+			"Base": borsh.StructDef(
+				borsh.Field{Name: "id", Declaration: "u64"},
+				borsh.Field{Name: "name", Declaration: "String"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Meta) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Meta) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Meta", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Meta to buf.
+// The FieldPath of a returned EncodeError is relative to Meta
+func (s Meta) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Meta) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Meta in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Meta) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Meta", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Meta in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Meta) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Meta", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Meta to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Meta) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Meta", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Meta to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Meta
+func (s Meta) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Note
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Note", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Meta) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Meta", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Meta like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Meta, without trailing bytes
+func (s *Meta) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Meta", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Meta in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Meta, so wrap unbuffered readers in a bufio.Reader
+func (s *Meta) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Meta", err)
+}
+
+// ReadBorsh decodes Meta nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Meta
+func (s *Meta) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Meta is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Meta
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Note", err)
+	}
+	__m := string(__s)
+	s.Note = (__m)
+		}
+	return err
+}
+func (s Meta) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Note
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Meta by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Meta) CompareBorsh(other any) int {
+	o := other.(Meta)
+	_ = o
+	if c := borsh.Compare(s.Note, o.Note); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Meta in fields typed by an interface
+func (Meta) BorshTypeID() uint64 {
+	return 0xe311be894d98d147
+}
+func init() {
+	Registry.Register(0xe311be894d98d147, func() borsh.BorshEncoder { return new(Meta) })
+}
+
+// BorshSchema describes the Borsh encoding of Meta in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Meta) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Meta",
+		Definitions: map[string]borsh.Definition{
+			"Meta": borsh.StructDef(
+				borsh.Field{Name: "note", Declaration: "String"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Record) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Record) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Record", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Record to buf.
+// The FieldPath of a returned EncodeError is relative to Record
+func (s Record) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Record) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Record in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Record) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Record", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Record in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Record) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Record", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Record to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Record) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Record", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Record to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Record
+func (s Record) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Base.ID))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Base.Name
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Base.Name", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.Header.Version))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Header.Chain
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Header.Chain", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+			if s.Meta == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPMeta
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Meta (meta) - Pointer
+					// ElementType: struct{Note string "msg:\"note\""}
+					// Type: Meta
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Meta
+					if err := writeNested(buf, s.Meta); err != nil {
+						return borsh.EncodeFieldError("Meta", err)
+					}
+		}
+					SKIPMeta:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Label
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Label", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(s.Value))
+		}
+	return nil
+}
+func (s *Record) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Record", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Record like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Record, without trailing bytes
+func (s *Record) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Record", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Record in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Record, so wrap unbuffered readers in a bufio.Reader
+func (s *Record) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Record", err)
+}
+
+// ReadBorsh decodes Record nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Record
+func (s *Record) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Record is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Record
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Base.ID", err)
+	}
+	__m := uint64(__v)
+	s.Base.ID = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Base.Name", err)
+	}
+	__m := string(__s)
+	s.Base.Name = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Header.Version", err)
+	}
+	__m := uint8(__v)
+	s.Header.Version = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Header.Chain", err)
+	}
+	__m := string(__s)
+	s.Header.Chain = (__m)
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Meta", err)
+				}
+				if !present {
+					s.Meta = nil
+					goto SKIPMeta
+				} 
+			}
+		{
+		
+					// Meta (meta) - Pointer
+					// ElementType: struct{Note string "msg:\"note\""}
+					// Type: Meta
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Meta
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Meta{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Meta", err)
+					}
+						s.Meta = m
+		}
+					SKIPMeta:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Label", err)
+	}
+	__m := string(__s)
+	s.Label = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("Value", err)
+	}
+	__m := uint32(__v)
+	s.Value = (__m)
+		}
+	return err
+}
+func (s Record) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Base.Name
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Header.Chain
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+			size++
+			if s.Meta == nil {
+				goto SKIPMeta
+			}
+		}
+		{
+		
+					// Meta (meta) - Pointer
+					// ElementType: struct{Note string "msg:\"note\""}
+					// Type: Meta
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Meta
+	
+		// ElementType: struct{Note string "msg:\"note\""}
+		
+			// Element: false
+	
+		// ElementType: struct{Note string "msg:\"note\""}
+			bs, err := borsh.SizeOf(s.Meta)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Meta", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPMeta:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Label
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Record by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Record) CompareBorsh(other any) int {
+	o := other.(Record)
+	_ = o
+	if c := borsh.Compare(s.Base.ID, o.Base.ID); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Base.Name, o.Base.Name); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Header.Version, o.Header.Version); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Header.Chain, o.Header.Chain); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Meta, o.Meta); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Label, o.Label); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Value, o.Value); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Record in fields typed by an interface
+func (Record) BorshTypeID() uint64 {
+	return 0xed13de0ccb85b0c4
+}
+func init() {
+	Registry.Register(0xed13de0ccb85b0c4, func() borsh.BorshEncoder { return new(Record) })
+}
+
+// BorshSchema describes the Borsh encoding of Record in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Record) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Record",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Meta": borsh.StructDef(
+				borsh.Field{Name: "note", Declaration: "String"},),
+			"Option<Meta>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Meta"}),
+			"Record": borsh.StructDef(
+				borsh.Field{Name: "id", Declaration: "u64"},
+				borsh.Field{Name: "name", Declaration: "String"},
+				borsh.Field{Name: "version", Declaration: "u8"},
+				borsh.Field{Name: "chain", Declaration: "String"},
+				borsh.Field{Name: "meta", Declaration: "Option<Meta>"},
+				borsh.Field{Name: "label", Declaration: "String"},
+				borsh.Field{Name: "value", Declaration: "u32"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Wrapped) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Wrapped) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Wrapped", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Wrapped to buf.
+// The FieldPath of a returned EncodeError is relative to Wrapped
+func (s Wrapped) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Wrapped) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Wrapped in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Wrapped) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Wrapped", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Wrapped in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Wrapped) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Wrapped", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Wrapped to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Wrapped) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Wrapped", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Wrapped to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Wrapped
+func (s Wrapped) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		if s.Base == nil {
+			return borsh.EncodeFieldError("Base", fmt.Errorf("embedded Base is nil"))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Base.ID))
+		}
+		if s.Base == nil {
+			return borsh.EncodeFieldError("Base", fmt.Errorf("embedded Base is nil"))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Base.Name
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Base.Name", err)
+					}
+					appendString(buf, string(str))
+		}
+		if s.Header == nil {
+			return borsh.EncodeFieldError("Header", fmt.Errorf("embedded Header is nil"))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.Header.Version))
+		}
+		if s.Header == nil {
+			return borsh.EncodeFieldError("Header", fmt.Errorf("embedded Header is nil"))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Header.Chain
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Header.Chain", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Extra
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Extra", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Wrapped) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Wrapped", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Wrapped like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Wrapped, without trailing bytes
+func (s *Wrapped) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Wrapped", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Wrapped in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Wrapped, so wrap unbuffered readers in a bufio.Reader
+func (s *Wrapped) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Wrapped", err)
+}
+
+// ReadBorsh decodes Wrapped nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Wrapped
+func (s *Wrapped) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Wrapped is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Wrapped
+    var err error
+		if s.Base == nil {
+			s.Base = new(Base)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Base.ID", err)
+	}
+	__m := uint64(__v)
+	s.Base.ID = (__m)
+		}
+		if s.Base == nil {
+			s.Base = new(Base)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Base.Name", err)
+	}
+	__m := string(__s)
+	s.Base.Name = (__m)
+		}
+		if s.Header == nil {
+			s.Header = new(common.Header)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Header.Version", err)
+	}
+	__m := uint8(__v)
+	s.Header.Version = (__m)
+		}
+		if s.Header == nil {
+			s.Header = new(common.Header)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Header.Chain", err)
+	}
+	__m := string(__s)
+	s.Header.Chain = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Extra", err)
+	}
+	__m := string(__s)
+	s.Extra = (__m)
+		}
+	return err
+}
+func (s Wrapped) BinarySize() (int, error) {
+	size := 0
+		if s.Base == nil {
+			return 0, borsh.EncodeFieldError("Base", fmt.Errorf("embedded Base is nil"))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		if s.Base == nil {
+			return 0, borsh.EncodeFieldError("Base", fmt.Errorf("embedded Base is nil"))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Base.Name
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		if s.Header == nil {
+			return 0, borsh.EncodeFieldError("Header", fmt.Errorf("embedded Header is nil"))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		if s.Header == nil {
+			return 0, borsh.EncodeFieldError("Header", fmt.Errorf("embedded Header is nil"))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Header.Chain
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Extra
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Wrapped by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Wrapped) CompareBorsh(other any) int {
+	o := other.(Wrapped)
+	_ = o
+	if c := borsh.Compare(s.Base.ID, o.Base.ID); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Base.Name, o.Base.Name); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Header.Version, o.Header.Version); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Header.Chain, o.Header.Chain); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Extra, o.Extra); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Wrapped in fields typed by an interface
+func (Wrapped) BorshTypeID() uint64 {
+	return 0x582345003c9bae6f
+}
+func init() {
+	Registry.Register(0x582345003c9bae6f, func() borsh.BorshEncoder { return new(Wrapped) })
+}
+
+// BorshSchema describes the Borsh encoding of Wrapped in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Wrapped) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Wrapped",
+		Definitions: map[string]borsh.Definition{
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Wrapped": borsh.StructDef(
+				borsh.Field{Name: "id", Declaration: "u64"},
+				borsh.Field{Name: "name", Declaration: "String"},
+				borsh.Field{Name: "version", Declaration: "u8"},
+				borsh.Field{Name: "chain", Declaration: "String"},
+				borsh.Field{Name: "extra", Declaration: "String"},),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Nested) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Nested) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Nested", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Nested to buf.
+// The FieldPath of a returned EncodeError is relative to Nested
+func (s Nested) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Nested) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Nested in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Nested) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Nested", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Nested in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Nested) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Nested", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Nested to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Nested) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Nested", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Nested to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Nested
+func (s Nested) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Base); err != nil {
+						return borsh.EncodeFieldError("Base", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Header); err != nil {
+						return borsh.EncodeFieldError("Header", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if s.Flag {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+		}
+	return nil
+}
+func (s *Nested) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Nested", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Nested like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Nested, without trailing bytes
+func (s *Nested) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Nested", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Nested in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Nested, so wrap unbuffered readers in a bufio.Reader
+func (s *Nested) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Nested", err)
+}
+
+// ReadBorsh decodes Nested nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Nested
+func (s *Nested) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Nested is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Nested
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Base{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Base", err)
+					}
+						s.Base = *m
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &common.Header{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Header", err)
+					}
+						s.Header = *m
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadBool()
+	if err != nil {
+		return r.FieldError("Flag", err)
+	}
+	__m := bool(__v)
+	s.Flag = (__m)
+		}
+	return err
+}
+func (s Nested) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Base)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Base", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Header)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Header", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Nested by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Nested) CompareBorsh(other any) int {
+	o := other.(Nested)
+	_ = o
+	if c := borsh.Compare(s.Base, o.Base); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Header, o.Header); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Flag, o.Flag); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Nested in fields typed by an interface
+func (Nested) BorshTypeID() uint64 {
+	return 0x4bf795d25be299cd
+}
+func init() {
+	Registry.Register(0x4bf795d25be299cd, func() borsh.BorshEncoder { return new(Nested) })
+}
+
+// BorshSchema describes the Borsh encoding of Nested in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Nested) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Nested",
+		Definitions: map[string]borsh.Definition{
+			"Base": borsh.StructDef(
+				borsh.Field{Name: "id", Declaration: "u64"},
+				borsh.Field{Name: "name", Declaration: "String"},),
+			"Header": borsh.StructDef(
+				borsh.Field{Name: "version", Declaration: "u8"},
+				borsh.Field{Name: "chain", Declaration: "String"},),
+			"Nested": borsh.StructDef(
+				borsh.Field{Name: "base", Declaration: "Base"},
+				borsh.Field{Name: "header", Declaration: "Header"},
+				borsh.Field{Name: "flag", Declaration: "bool"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"bool": borsh.PrimitiveDef(1),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Deep) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Deep) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Deep", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Deep to buf.
+// The FieldPath of a returned EncodeError is relative to Deep
+func (s Deep) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Deep) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Deep in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Deep) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Deep", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Deep in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Deep) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Deep", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Deep to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Deep) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Deep", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Deep to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Deep
+func (s Deep) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Record.Base.ID))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Record.Base.Name
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Record.Base.Name", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.Record.Header.Version))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Record.Header.Chain
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Record.Header.Chain", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+			if s.Record.Meta == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPRecord_Meta
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Record.Meta (meta) - Pointer
+					// ElementType: struct{Note string "msg:\"note\""}
+					// Type: Meta
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Meta
+					if err := writeNested(buf, s.Record.Meta); err != nil {
+						return borsh.EncodeFieldError("Record.Meta", err)
+					}
+		}
+					SKIPRecord_Meta:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Record.Label
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Record.Label", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(s.Record.Value))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.Seq))
+		}
+	return nil
+}
+func (s *Deep) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Deep", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Deep like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Deep, without trailing bytes
+func (s *Deep) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Deep", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Deep in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Deep, so wrap unbuffered readers in a bufio.Reader
+func (s *Deep) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Deep", err)
+}
+
+// ReadBorsh decodes Deep nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Deep
+func (s *Deep) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Deep is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Deep
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Record.Base.ID", err)
+	}
+	__m := uint64(__v)
+	s.Record.Base.ID = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Record.Base.Name", err)
+	}
+	__m := string(__s)
+	s.Record.Base.Name = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Record.Header.Version", err)
+	}
+	__m := uint8(__v)
+	s.Record.Header.Version = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Record.Header.Chain", err)
+	}
+	__m := string(__s)
+	s.Record.Header.Chain = (__m)
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Record.Meta", err)
+				}
+				if !present {
+					s.Record.Meta = nil
+					goto SKIPRecord_Meta
+				} 
+			}
+		{
+		
+					// Record.Meta (meta) - Pointer
+					// ElementType: struct{Note string "msg:\"note\""}
+					// Type: Meta
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Meta
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Meta{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Record.Meta", err)
+					}
+						s.Record.Meta = m
+		}
+					SKIPRecord_Meta:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Record.Label", err)
+	}
+	__m := string(__s)
+	s.Record.Label = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("Record.Value", err)
+	}
+	__m := uint32(__v)
+	s.Record.Value = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError("Seq", err)
+	}
+	__m := uint16(__v)
+	s.Seq = (__m)
+		}
+	return err
+}
+func (s Deep) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Record.Base.Name
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Record.Header.Chain
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+			size++
+			if s.Record.Meta == nil {
+				goto SKIPRecord_Meta
+			}
+		}
+		{
+		
+					// Record.Meta (meta) - Pointer
+					// ElementType: struct{Note string "msg:\"note\""}
+					// Type: Meta
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Meta
+	
+		// ElementType: struct{Note string "msg:\"note\""}
+		
+			// Element: false
+	
+		// ElementType: struct{Note string "msg:\"note\""}
+			bs, err := borsh.SizeOf(s.Record.Meta)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Record.Meta", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPRecord_Meta:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Record.Label
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 2
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Deep by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Deep) CompareBorsh(other any) int {
+	o := other.(Deep)
+	_ = o
+	if c := borsh.Compare(s.Record.Base.ID, o.Record.Base.ID); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Record.Base.Name, o.Record.Base.Name); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Record.Header.Version, o.Record.Header.Version); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Record.Header.Chain, o.Record.Header.Chain); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Record.Meta, o.Record.Meta); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Record.Label, o.Record.Label); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Record.Value, o.Record.Value); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Seq, o.Seq); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Deep in fields typed by an interface
+func (Deep) BorshTypeID() uint64 {
+	return 0x8d0b8e3be19948d
+}
+func init() {
+	Registry.Register(0x8d0b8e3be19948d, func() borsh.BorshEncoder { return new(Deep) })
+}
+
+// BorshSchema describes the Borsh encoding of Deep in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Deep) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Deep",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Deep": borsh.StructDef(
+				borsh.Field{Name: "id", Declaration: "u64"},
+				borsh.Field{Name: "name", Declaration: "String"},
+				borsh.Field{Name: "version", Declaration: "u8"},
+				borsh.Field{Name: "chain", Declaration: "String"},
+				borsh.Field{Name: "meta", Declaration: "Option<Meta>"},
+				borsh.Field{Name: "label", Declaration: "String"},
+				borsh.Field{Name: "value", Declaration: "u32"},
+				borsh.Field{Name: "seq", Declaration: "u16"},),
+			"Meta": borsh.StructDef(
+				borsh.Field{Name: "note", Declaration: "String"},),
+			"Option<Meta>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Meta"}),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u16": borsh.PrimitiveDef(2),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package embedded
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh/borshtest"
+	"github.com/mlayerprotocol/go-borshgen/tests/embedded/common"
+)
+
+func TestInlineLayout(t *testing.T) {
+	v := Record{
+		Base:   Base{ID: 1, Name: "b"},
+		Header: common.Header{Version: 2, Chain: "c"},
+		Label:  "r",
+		Value:  3,
+	}
+
+	var want []byte
+	want = append(want, 1, 0, 0, 0, 0, 0, 0, 0) // Base.ID
+	want = append(want, 1, 0, 0, 0, 'b')        // Base.Name
+	want = append(want, 2)                      // Header.Version
+	want = append(want, 1, 0, 0, 0, 'c')        // Header.Chain
+	want = append(want, 0)                      // Meta: nil
+	want = append(want, 1, 0, 0, 0, 'r')        // Label
+	want = append(want, 3, 0, 0, 0)             // Value
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(want) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(want))
+	}
+}
+
+func TestEmbeddedRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		in   interface {
+			MarshalBorsh() ([]byte, error)
+		}
+		out interface{ UnmarshalBorsh([]byte) error }
+	}{
+		{"record", Record{Base: Base{ID: 1}, Header: common.Header{Version: 2, Chain: "c"}, Meta: &Meta{Note: "n"}, Label: "r", Value: 3}, &Record{}},
+		{"wrapped", Wrapped{Base: &Base{ID: 4, Name: "w"}, Header: &common.Header{Chain: "x"}, Extra: "e"}, &Wrapped{}},
+		{"nested", Nested{Base: Base{ID: 5, Name: "n"}, Header: common.Header{Version: 6}, Flag: true}, &Nested{}},
+		{"deep", Deep{Record: Record{Base: Base{ID: 9, Name: "b"}, Header: common.Header{Chain: "d"}, Label: "r", Value: 7}, Seq: 8}, &Deep{}},
+	}
+	for _, c := range cases {
+		data, err := c.in.MarshalBorsh()
+		if err != nil {
+			t.Fatalf("%s: MarshalBorsh() failed: %v", c.name, err)
+		}
+		if err := c.out.UnmarshalBorsh(data); err != nil {
+			t.Fatalf("%s: UnmarshalBorsh() failed: %v", c.name, err)
+		}
+		got := reflect.ValueOf(c.out).Elem().Interface()
+		if !reflect.DeepEqual(got, c.in) {
+			t.Errorf("%s: round trip mismatch:\n got %+v\nwant %+v", c.name, got, c.in)
+		}
+	}
+}
+
+func TestNilEmbeddedPointer(t *testing.T) {
+	v := Wrapped{Header: &common.Header{}}
+	if _, err := v.MarshalBorsh(); err == nil {
+		t.Error("MarshalBorsh() accepted a nil inline embed")
+	}
+}
+
+func TestBorshtestFollowsEmbeds(t *testing.T) {
+	opts := borshtest.Options{Tag: "msg", Fallback: "json"}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		var w Wrapped
+		borshtest.Fill(rng, &w, opts)
+		if w.Base == nil || w.Header == nil {
+			t.Fatalf("Fill() left an inline pointer embed nil: %+v", w)
+		}
+	}
+
+	want := Record{Label: "a", Meta: &Meta{Note: "n"}}
+	got := want
+	got.Meta = &Meta{Note: "m"}
+	if diff, wantDiff := borshtest.Diff(want, got, opts), "Record.Meta.Note = m, want n"; diff != wantDiff {
+		t.Errorf("Diff() = %q, want %q", diff, wantDiff)
+	}
+}
@@ -0,0 +1,55 @@
+package enums
+
+import "github.com/mlayerprotocol/go-borshgen/tests/constants"
+
+// Instruction is one of the operations a Transaction can carry
+//
+//borshgen:enum Instruction = Transfer | Mint | Burn
+type Instruction interface {
+	isInstruction()
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Transfer struct {
+	To     string `msg:"to"`
+	Amount uint64 `msg:"amount"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Mint struct {
+	Amount uint64 `msg:"amount"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Burn struct{}
+
+func (Transfer) isInstruction() {}
+func (*Mint) isInstruction()    {}
+func (Burn) isInstruction()     {}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Transaction struct {
+	Nonce        uint32        `msg:"nonce"`
+	Instruction  Instruction   `msg:"instruction"`
+	Instructions []Instruction `msg:"instructions"`
+	Memo         string        `msg:"memo"`
+}
+
+// Priority orders accounts; it is written as a u16
+//
+//borshgen:enum u16
+type Priority uint16
+
+const (
+	PriorityLow Priority = iota + 1
+	PriorityHigh
+	PriorityUrgent Priority = 300
+)
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Account struct {
+	Status   constants.Status   `msg:"status"`
+	Previous *constants.Status  `msg:"previous"`
+	History  []constants.Status `msg:"history"`
+	Priority Priority           `msg:"priority"`
+}
@@ -0,0 +1,1456 @@
+package enums
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/constants"
+)
+var _ constants.Status
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Transfer) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Transfer) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Transfer", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Transfer to buf.
+// The FieldPath of a returned EncodeError is relative to Transfer
+func (s Transfer) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Transfer) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Transfer in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Transfer) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Transfer", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Transfer in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Transfer) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Transfer", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Transfer to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Transfer) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Transfer", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Transfer to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Transfer
+func (s Transfer) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.To
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("To", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Amount))
+		}
+	return nil
+}
+func (s *Transfer) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Transfer", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Transfer like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Transfer, without trailing bytes
+func (s *Transfer) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Transfer", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Transfer in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Transfer, so wrap unbuffered readers in a bufio.Reader
+func (s *Transfer) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Transfer", err)
+}
+
+// ReadBorsh decodes Transfer nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Transfer
+func (s *Transfer) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Transfer is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Transfer
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("To", err)
+	}
+	__m := string(__s)
+	s.To = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+	__m := uint64(__v)
+	s.Amount = (__m)
+		}
+	return err
+}
+func (s Transfer) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.To
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Transfer by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Transfer) CompareBorsh(other any) int {
+	o := other.(Transfer)
+	_ = o
+	if c := borsh.Compare(s.To, o.To); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Transfer in fields typed by an interface
+func (Transfer) BorshTypeID() uint64 {
+	return 0xc282ceb7e5404b5d
+}
+func init() {
+	Registry.Register(0xc282ceb7e5404b5d, func() borsh.BorshEncoder { return new(Transfer) })
+}
+
+// BorshSchema describes the Borsh encoding of Transfer in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Transfer) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Transfer",
+		Definitions: map[string]borsh.Definition{
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Transfer": borsh.StructDef(
+				borsh.Field{Name: "to", Declaration: "String"},
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Mint) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Mint) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Mint", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Mint to buf.
+// The FieldPath of a returned EncodeError is relative to Mint
+func (s Mint) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Mint) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Mint in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Mint) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Mint", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Mint in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Mint) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Mint", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Mint to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Mint) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Mint", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Mint to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Mint
+func (s Mint) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Amount))
+		}
+	return nil
+}
+func (s *Mint) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Mint", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Mint like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Mint, without trailing bytes
+func (s *Mint) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Mint", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Mint in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Mint, so wrap unbuffered readers in a bufio.Reader
+func (s *Mint) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Mint", err)
+}
+
+// ReadBorsh decodes Mint nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Mint
+func (s *Mint) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Mint is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Mint
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+	__m := uint64(__v)
+	s.Amount = (__m)
+		}
+	return err
+}
+func (s Mint) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Mint by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Mint) CompareBorsh(other any) int {
+	o := other.(Mint)
+	_ = o
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Mint in fields typed by an interface
+func (Mint) BorshTypeID() uint64 {
+	return 0x357f4d5ffec0e273
+}
+func init() {
+	Registry.Register(0x357f4d5ffec0e273, func() borsh.BorshEncoder { return new(Mint) })
+}
+
+// BorshSchema describes the Borsh encoding of Mint in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Mint) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Mint",
+		Definitions: map[string]borsh.Definition{
+			"Mint": borsh.StructDef(
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"u64": borsh.PrimitiveDef(8),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Burn) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Burn) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Burn", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Burn to buf.
+// The FieldPath of a returned EncodeError is relative to Burn
+func (s Burn) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Burn) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Burn in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Burn) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Burn", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Burn in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Burn) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Burn", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Burn to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Burn) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Burn", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Burn to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Burn
+func (s Burn) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	return nil
+}
+func (s *Burn) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Burn", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Burn like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Burn, without trailing bytes
+func (s *Burn) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Burn", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Burn in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Burn, so wrap unbuffered readers in a bufio.Reader
+func (s *Burn) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Burn", err)
+}
+
+// ReadBorsh decodes Burn nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Burn
+func (s *Burn) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Burn is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Burn
+    var err error
+	return err
+}
+func (s Burn) BinarySize() (int, error) {
+	size := 0
+	return size, nil
+}
+
+// CompareBorsh orders Burn by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Burn) CompareBorsh(other any) int {
+	o := other.(Burn)
+	_ = o
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Burn in fields typed by an interface
+func (Burn) BorshTypeID() uint64 {
+	return 0x3f49a919a5045705
+}
+func init() {
+	Registry.Register(0x3f49a919a5045705, func() borsh.BorshEncoder { return new(Burn) })
+}
+
+// BorshSchema describes the Borsh encoding of Burn in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Burn) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Burn",
+		Definitions: map[string]borsh.Definition{
+			"Burn": borsh.StructDef(),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Transaction) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Transaction) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Transaction", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Transaction to buf.
+// The FieldPath of a returned EncodeError is relative to Transaction
+func (s Transaction) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Transaction) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Transaction in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Transaction) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Transaction", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Transaction in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Transaction) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Transaction", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Transaction to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Transaction) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Transaction", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Transaction to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Transaction
+func (s Transaction) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(s.Nonce))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum
+					if err := appendEnumInstruction(buf, s.Instruction); err != nil {
+						return borsh.EncodeFieldError("Instruction", err)
+					}
+		}
+		{
+		
+				// Instructions (instructions) - slice
+				// ElementType: []Instruction
+				// Type: []Instruction
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Instructions: []Instructions
+	if err := checkLength("slice", len((s.Instructions)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Instructions", err)
+	}
+	 appendLength(buf, len((s.Instructions)))
+		for i, item := range (s.Instructions) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum
+					if err := appendEnumInstruction(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Instructions", i), err)
+					}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Memo
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Memo", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Transaction) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Transaction", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Transaction like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Transaction, without trailing bytes
+func (s *Transaction) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Transaction", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Transaction in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Transaction, so wrap unbuffered readers in a bufio.Reader
+func (s *Transaction) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Transaction", err)
+}
+
+// ReadBorsh decodes Transaction nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Transaction
+func (s *Transaction) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Transaction is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Transaction
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("Nonce", err)
+	}
+	__m := uint32(__v)
+	s.Nonce = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	s.Instruction, err = readEnumInstruction(r, depth+1)
+	if err != nil {
+		return r.FieldError("Instruction", err)
+	}
+		}
+		{
+		
+				// Instructions (instructions) - slice
+				// ElementType: []Instruction
+				// Type: []Instruction
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Instructions: []Instructions
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Instructions", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Instruction](r, length)
+		if err != nil {
+			return r.FieldError("Instructions", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum
+			// Element Instruction
+	p[i], err = readEnumInstruction(r, depth+1)
+	if err != nil {
+		return r.FieldError(borsh.Index("Instructions", i), err)
+	}
+		}
+				s.Instructions =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Memo", err)
+	}
+	__m := string(__s)
+	s.Memo = (__m)
+		}
+	return err
+}
+func (s Transaction) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				{
+					_s, err := sizeEnumInstruction(s.Instruction)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Instruction", err)
+					}
+					size += _s
+				}
+		}
+		{
+		
+				// Instructions (instructions) - slice
+				// ElementType: []Instruction
+				// Type: []Instruction
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Instructions: []Instructions
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Instructions) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum
+			// Element Instruction
+				{
+					_s, err := sizeEnumInstruction(item)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Instructions", err)
+					}
+					size += _s
+				}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Memo
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Transaction by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Transaction) CompareBorsh(other any) int {
+	o := other.(Transaction)
+	_ = o
+	if c := borsh.Compare(s.Nonce, o.Nonce); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Instruction, o.Instruction); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Instructions, o.Instructions); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Memo, o.Memo); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Transaction in fields typed by an interface
+func (Transaction) BorshTypeID() uint64 {
+	return 0xe6277103f2042eb0
+}
+func init() {
+	Registry.Register(0xe6277103f2042eb0, func() borsh.BorshEncoder { return new(Transaction) })
+}
+
+// BorshSchema describes the Borsh encoding of Transaction in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Transaction) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Transaction",
+		Definitions: map[string]borsh.Definition{
+			"Burn": borsh.StructDef(),
+			"Instruction": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "Transfer", Declaration: "Transfer"},
+				borsh.Variant{Discriminant: 1, Name: "Mint", Declaration: "Mint"},
+				borsh.Variant{Discriminant: 2, Name: "Burn", Declaration: "Burn"}),
+			"Mint": borsh.StructDef(
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Transaction": borsh.StructDef(
+				borsh.Field{Name: "nonce", Declaration: "u32"},
+				borsh.Field{Name: "instruction", Declaration: "Instruction"},
+				borsh.Field{Name: "instructions", Declaration: "Vec<Instruction>"},
+				borsh.Field{Name: "memo", Declaration: "String"},),
+			"Transfer": borsh.StructDef(
+				borsh.Field{Name: "to", Declaration: "String"},
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"Vec<Instruction>": borsh.SequenceDef(4, 0, 4294967295, "Instruction"),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Account) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Account) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Account", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Account to buf.
+// The FieldPath of a returned EncodeError is relative to Account
+func (s Account) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Account) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Account in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Account) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Account", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Account in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Account) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Account", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Account to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Account) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Account", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Account to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Account
+func (s Account) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+			// s.Status - custom type
+			
+				// Element: enum_u8
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u8
+					if !(s.Status).Valid() {
+						return borsh.EncodeFieldError("Status", fmt.Errorf("invalid enum value %d", s.Status))
+					}
+					buf.WriteByte(byte(s.Status))
+		}
+		{
+			if s.Previous == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPPrevious
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Previous (previous) - Pointer
+					// ElementType: enum_u8
+					// Type: constants.Status
+					// ActualType: *github.com/mlayerprotocol/go-borshgen/tests/constants.Status
+					// BasicType: true
+			
+					// ElementType: constants.Status
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u8
+					if !(*s.Previous).Valid() {
+						return borsh.EncodeFieldError("Previous", fmt.Errorf("invalid enum value %d", *s.Previous))
+					}
+					buf.WriteByte(byte(*s.Previous))
+		}
+					SKIPPrevious:
+		{
+		
+				// History (history) - slice
+				// ElementType: []constants.Status
+				// Type: []constants.Status
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	if err := checkLength("slice", len((s.History)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("History", err)
+	}
+	 appendLength(buf, len((s.History)))
+		for i, item := range (s.History) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum_u8
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u8
+					if !(item).Valid() {
+						return borsh.EncodeFieldError(borsh.Index("History", i), fmt.Errorf("invalid enum value %d", item))
+					}
+					buf.WriteByte(byte(item))
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u16
+					if !(s.Priority).Valid() {
+						return borsh.EncodeFieldError("Priority", fmt.Errorf("invalid enum value %d", s.Priority))
+					}
+					buf.WriteUint16(uint16(s.Priority))
+		}
+	return nil
+}
+func (s *Account) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Account", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Account like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Account, without trailing bytes
+func (s *Account) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Account", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Account in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Account, so wrap unbuffered readers in a bufio.Reader
+func (s *Account) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Account", err)
+}
+
+// ReadBorsh decodes Account nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Account
+func (s *Account) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Account is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Account
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Status", err)
+	}
+	__m := constants.Status(__v)
+	if !__m.Valid() {
+		return r.FieldError("Status", fmt.Errorf("invalid Status value %d", __m))
+	}
+	s.Status = (__m)
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Previous", err)
+				}
+				if !present {
+					s.Previous = nil
+					goto SKIPPrevious
+				} 
+			}
+		{
+		
+					// Previous (previous) - Pointer
+					// ElementType: enum_u8
+					// Type: constants.Status
+					// ActualType: *github.com/mlayerprotocol/go-borshgen/tests/constants.Status
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: constants.Status
+					// IsPointer: true
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Previous", err)
+	}
+	__m := constants.Status(__v)
+	if !__m.Valid() {
+		return r.FieldError("Previous", fmt.Errorf("invalid Previous value %d", __m))
+	}
+	s.Previous = &(__m)
+		}
+					SKIPPrevious:
+		{
+		
+				// History (history) - slice
+				// ElementType: []constants.Status
+				// Type: []constants.Status
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("History", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]constants.Status](r, length)
+		if err != nil {
+			return r.FieldError("History", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum_u8
+			// Element constants.Status
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("History", i), err)
+	}
+	__m := constants.Status(__v)
+	if !__m.Valid() {
+		return r.FieldError(borsh.Index("History", i), fmt.Errorf("invalid History value %d", __m))
+	}
+	p[i] = (__m)
+		}
+				s.History =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError("Priority", err)
+	}
+	__m := Priority(__v)
+	if !__m.Valid() {
+		return r.FieldError("Priority", fmt.Errorf("invalid Priority value %d", __m))
+	}
+	s.Priority = (__m)
+		}
+	return err
+}
+func (s Account) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+			size++
+			if s.Previous == nil {
+				goto SKIPPrevious
+			}
+		}
+		{
+		
+					// Previous (previous) - Pointer
+					// ElementType: enum_u8
+					// Type: constants.Status
+					// ActualType: *github.com/mlayerprotocol/go-borshgen/tests/constants.Status
+					// BasicType: true
+			
+					// ElementType: constants.Status
+	
+		// ElementType: enum_u8
+		
+			// Element: true
+				size += 1
+		}
+			SKIPPrevious:
+		{
+		
+				// History (history) - slice
+				// ElementType: []constants.Status
+				// Type: []constants.Status
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.History) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum_u8
+			// Element constants.Status
+				size += 1
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 2
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Account by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Account) CompareBorsh(other any) int {
+	o := other.(Account)
+	_ = o
+	if c := borsh.Compare(s.Status, o.Status); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Previous, o.Previous); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.History, o.History); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Priority, o.Priority); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Account in fields typed by an interface
+func (Account) BorshTypeID() uint64 {
+	return 0x61dbf5cde17d8ea8
+}
+func init() {
+	Registry.Register(0x61dbf5cde17d8ea8, func() borsh.BorshEncoder { return new(Account) })
+}
+
+// BorshSchema describes the Borsh encoding of Account in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Account) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Account",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Account": borsh.StructDef(
+				borsh.Field{Name: "status", Declaration: "Status"},
+				borsh.Field{Name: "previous", Declaration: "Option<Status>"},
+				borsh.Field{Name: "history", Declaration: "Vec<u8>"},
+				borsh.Field{Name: "priority", Declaration: "Priority"},),
+			"Option<Status>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Status"}),
+			"Priority": borsh.EnumDef(2,
+				borsh.Variant{Discriminant: 1, Name: "PriorityLow", Declaration: "PriorityLow"},
+				borsh.Variant{Discriminant: 2, Name: "PriorityHigh", Declaration: "PriorityHigh"},
+				borsh.Variant{Discriminant: 300, Name: "PriorityUrgent", Declaration: "PriorityUrgent"}),
+			"PriorityHigh": borsh.StructDef(),
+			"PriorityLow": borsh.StructDef(),
+			"PriorityUrgent": borsh.StructDef(),
+			"Status": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "StatusPending", Declaration: "StatusPending"},
+				borsh.Variant{Discriminant: 1, Name: "StatusActive", Declaration: "StatusActive"},
+				borsh.Variant{Discriminant: 2, Name: "StatusClosed", Declaration: "StatusClosed"}),
+			"StatusActive": borsh.StructDef(),
+			"StatusClosed": borsh.StructDef(),
+			"StatusPending": borsh.StructDef(),
+			"Vec<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+// appendEnumInstruction writes the variant index of v followed by the variant
+func appendEnumInstruction(buf *borsh.Writer, v Instruction) error {
+	var err error
+	switch x := v.(type) {
+	case Transfer:
+		buf.WriteByte(0)
+		err = writeNested(buf, x)
+	case *Transfer:
+		if x == nil {
+			return fmt.Errorf("nil Instruction variant Transfer")
+		}
+		buf.WriteByte(0)
+		err = writeNested(buf, x)
+	case *Mint:
+		if x == nil {
+			return fmt.Errorf("nil Instruction variant Mint")
+		}
+		buf.WriteByte(1)
+		err = writeNested(buf, x)
+	case Burn:
+		buf.WriteByte(2)
+		err = writeNested(buf, x)
+	case *Burn:
+		if x == nil {
+			return fmt.Errorf("nil Instruction variant Burn")
+		}
+		buf.WriteByte(2)
+		err = writeNested(buf, x)
+	case nil:
+		return fmt.Errorf("nil Instruction")
+	default:
+		return fmt.Errorf("unknown Instruction variant %T", v)
+	}
+	return err
+}
+
+// readEnumInstruction reads the variant index and decodes the matching variant at depth
+func readEnumInstruction(r *borsh.Reader, depth int) (Instruction, error) {
+	index, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch index {
+	case 0:
+		m := &Transfer{}
+		if err := readNested(r, m, depth); err != nil {
+			return nil, err
+		}
+		return *m, nil
+	case 1:
+		m := &Mint{}
+		if err := readNested(r, m, depth); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case 2:
+		m := &Burn{}
+		if err := readNested(r, m, depth); err != nil {
+			return nil, err
+		}
+		return *m, nil
+	default:
+		return nil, r.Errorf(borsh.UnknownVariant, "unknown Instruction variant index %d", index)
+	}
+}
+
+// sizeEnumInstruction returns the encoded size of v including the variant index
+func sizeEnumInstruction(v Instruction) (int, error) {
+	var (
+		size int
+		err  error
+	)
+	switch x := v.(type) {
+	case Transfer:
+		size, err = x.BinarySize()
+	case *Transfer:
+		if x == nil {
+			return 0, fmt.Errorf("nil Instruction variant Transfer")
+		}
+		size, err = x.BinarySize()
+	case *Mint:
+		if x == nil {
+			return 0, fmt.Errorf("nil Instruction variant Mint")
+		}
+		size, err = x.BinarySize()
+	case Burn:
+		size, err = x.BinarySize()
+	case *Burn:
+		if x == nil {
+			return 0, fmt.Errorf("nil Instruction variant Burn")
+		}
+		size, err = x.BinarySize()
+	case nil:
+		return 0, fmt.Errorf("nil Instruction")
+	default:
+		return 0, fmt.Errorf("unknown Instruction variant %T", v)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return 1 + NestedPrefixSize + size, nil
+}
+
+// MarshalInstruction encodes v as a Borsh enum: a 1-byte variant index followed by the variant
+func MarshalInstruction(v Instruction) ([]byte, error) {
+	size, err := sizeEnumInstruction(v)
+	if err != nil {
+		return nil, borsh.EncodeFieldError("Instruction", err)
+	}
+	buf := borsh.NewBufferWriter(make([]byte, 0, size))
+	if err := appendEnumInstruction(buf, v); err != nil {
+		return nil, borsh.EncodeFieldError("Instruction", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalInstruction decodes a Borsh enum into the Instruction variant selected by its index
+func UnmarshalInstruction(data []byte) (Instruction, error) {
+	r := newReader(data, StrictDecoding)
+	v, err := readEnumInstruction(r, 0)
+	if err == nil && StrictDecoding && len(r.Remaining()) > 0 {
+		err = r.Errorf(borsh.TrailingBytes, "%d bytes after the value", len(r.Remaining()))
+	}
+	if err != nil {
+		return nil, r.FieldError("Instruction", err)
+	}
+	return v, nil
+}
+
+// InstructionBinarySize returns the encoded size of v
+func InstructionBinarySize(v Instruction) (int, error) {
+	size, err := sizeEnumInstruction(v)
+	if err != nil {
+		return 0, borsh.EncodeFieldError("Instruction", err)
+	}
+	return size, nil
+}
+
+// String returns the name of the Priority constant
+func (v Priority) String() string {
+	switch v {
+	case PriorityLow:
+		return "PriorityLow"
+	case PriorityHigh:
+		return "PriorityHigh"
+	case PriorityUrgent:
+		return "PriorityUrgent"
+	}
+	return fmt.Sprintf("Priority(%d)", v)
+}
+
+// Valid reports whether v is one of the declared Priority constants
+func (v Priority) Valid() bool {
+	switch v {
+	case PriorityLow, PriorityHigh, PriorityUrgent:
+		return true
+	}
+	return false
+}
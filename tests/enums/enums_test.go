@@ -0,0 +1,206 @@
+package enums
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/constants"
+)
+
+func TestEnumLayout(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Instruction
+		want []byte
+	}{
+		{"Transfer", Transfer{To: "ab", Amount: 5}, []byte{0, 2, 0, 0, 0, 'a', 'b', 5, 0, 0, 0, 0, 0, 0, 0}},
+		{"Mint", &Mint{Amount: 1}, []byte{1, 1, 0, 0, 0, 0, 0, 0, 0}},
+		{"Burn", Burn{}, []byte{2}},
+		{"Transfer pointer", &Transfer{To: "", Amount: 0}, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := MarshalInstruction(tt.v)
+			if err != nil {
+				t.Fatalf("MarshalInstruction() failed: %v", err)
+			}
+			if !bytes.Equal(data, tt.want) {
+				t.Fatalf("MarshalInstruction() = %v, want %v", data, tt.want)
+			}
+			size, err := InstructionBinarySize(tt.v)
+			if err != nil {
+				t.Fatalf("InstructionBinarySize() failed: %v", err)
+			}
+			if size != len(tt.want) {
+				t.Errorf("InstructionBinarySize() = %d, want %d", size, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestEnumRoundTrip(t *testing.T) {
+	for _, v := range []Instruction{Transfer{To: "x", Amount: 9}, &Mint{Amount: 3}, Burn{}} {
+		data, err := MarshalInstruction(v)
+		if err != nil {
+			t.Fatalf("MarshalInstruction(%T) failed: %v", v, err)
+		}
+		restored, err := UnmarshalInstruction(data)
+		if err != nil {
+			t.Fatalf("UnmarshalInstruction(%T) failed: %v", v, err)
+		}
+		if !reflect.DeepEqual(restored, v) {
+			t.Errorf("round trip mismatch: got %#v, want %#v", restored, v)
+		}
+	}
+}
+
+func TestEnumField(t *testing.T) {
+	v := Transaction{
+		Nonce:        7,
+		Instruction:  Burn{},
+		Instructions: []Instruction{Transfer{To: "a", Amount: 1}, &Mint{Amount: 2}},
+		Memo:         "m",
+	}
+	want := []byte{
+		7, 0, 0, 0, // Nonce
+		2,          // Instruction: Burn
+		2, 0, 0, 0, // Instructions: u32 count
+		0, 1, 0, 0, 0, 'a', 1, 0, 0, 0, 0, 0, 0, 0, // Transfer
+		1, 2, 0, 0, 0, 0, 0, 0, 0, // Mint
+		1, 0, 0, 0, 'm', // Memo
+	}
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(want) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(want))
+	}
+
+	var restored Transaction
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(restored, v) {
+		t.Errorf("round trip mismatch: got %#v, want %#v", restored, v)
+	}
+}
+
+func TestEnumErrors(t *testing.T) {
+	if _, err := UnmarshalInstruction([]byte{3}); err == nil || !strings.Contains(err.Error(), "unknown Instruction variant index 3") {
+		t.Errorf("UnmarshalInstruction() error = %v, want unknown variant error", err)
+	}
+	if _, err := UnmarshalInstruction(nil); err == nil {
+		t.Errorf("UnmarshalInstruction(nil) should fail")
+	}
+	if _, err := UnmarshalInstruction([]byte{1, 1, 0}); err == nil {
+		t.Errorf("UnmarshalInstruction() on truncated variant should fail")
+	}
+	if _, err := MarshalInstruction(nil); err == nil {
+		t.Errorf("MarshalInstruction(nil) should fail")
+	}
+	if _, err := MarshalInstruction((*Mint)(nil)); err == nil {
+		t.Errorf("MarshalInstruction() of a nil variant pointer should fail")
+	}
+	if _, err := (Transaction{}).MarshalBorsh(); err == nil {
+		t.Errorf("MarshalBorsh() with a nil enum field should fail")
+	}
+
+	var restored Transaction
+	err := restored.UnmarshalBorsh([]byte{0, 0, 0, 0, 9})
+	if err == nil || !strings.Contains(err.Error(), "Instruction") {
+		t.Errorf("UnmarshalBorsh() error = %v, want unknown variant error", err)
+	}
+	var de *borsh.DecodeError
+	if !errors.As(err, &de) || de.Kind != borsh.UnknownVariant || de.FieldPath != "Transaction.Instruction" || de.Offset != 5 {
+		t.Errorf("UnmarshalBorsh() error = %#v, want UnknownVariant at Transaction.Instruction", err)
+	}
+}
+
+func TestValueEnumLayout(t *testing.T) {
+	prev := constants.StatusClosed
+	v := Account{
+		Status:   constants.StatusActive,
+		Previous: &prev,
+		History:  []constants.Status{constants.StatusPending, constants.StatusActive},
+		Priority: PriorityUrgent,
+	}
+	want := []byte{
+		1,    // Status: u8
+		1, 2, // Previous: Some + u8
+		2, 0, 0, 0, // History: u32 count
+		0, 1,
+		44, 1, // Priority: u16 300
+	}
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(want) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(want))
+	}
+
+	var restored Account
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(restored, v) {
+		t.Errorf("round trip mismatch: got %#v, want %#v", restored, v)
+	}
+}
+
+func TestValueEnumValidation(t *testing.T) {
+	invalid := []Account{
+		{Status: 3, Priority: PriorityLow},
+		{Status: constants.StatusActive, Priority: 0},
+		{History: []constants.Status{7}, Priority: PriorityLow},
+	}
+	for _, v := range invalid {
+		if _, err := v.MarshalBorsh(); err == nil || !strings.Contains(err.Error(), "invalid enum value") {
+			t.Errorf("MarshalBorsh(%+v) error = %v, want invalid enum value", v, err)
+		}
+	}
+
+	var restored Account
+	if err := restored.UnmarshalBorsh([]byte{9, 0, 0, 0, 0, 0, 1, 0}); err == nil || !strings.Contains(err.Error(), "invalid Status value 9") {
+		t.Errorf("UnmarshalBorsh() error = %v, want invalid Status value", err)
+	}
+	if err := restored.UnmarshalBorsh([]byte{0, 0, 0, 0, 0, 0, 3, 0}); err == nil || !strings.Contains(err.Error(), "invalid Priority value 3") {
+		t.Errorf("UnmarshalBorsh() error = %v, want invalid Priority value", err)
+	}
+}
+
+func TestValueEnumHelpers(t *testing.T) {
+	if got := constants.StatusClosed.String(); got != "StatusClosed" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := constants.StatusDefault.String(); got != "StatusPending" {
+		t.Errorf("String() of an alias = %q", got)
+	}
+	if got := constants.Status(9).String(); got != "Status(9)" {
+		t.Errorf("String() of an unknown value = %q", got)
+	}
+	if !PriorityUrgent.Valid() || Priority(3).Valid() {
+		t.Errorf("Valid() mismatch")
+	}
+}
@@ -0,0 +1,38 @@
+package generics
+
+import "github.com/mlayerprotocol/go-borshgen/borsh"
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Transfer struct {
+	To     string `msg:"to"`
+	Amount uint64 `msg:"amount"`
+}
+
+// Envelope wraps any generated payload
+//
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Envelope[T borsh.Marshaler] struct {
+	Nonce    uint64       `msg:"nonce"`
+	Payload  T            `msg:"payload"`
+	Batch    []T          `msg:"batch"`
+	Optional *T           `msg:"optional"`
+	ByName   map[string]T `msg:"by_name"`
+}
+
+// Pair has more than one type parameter
+//
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Pair[K, V borsh.Marshaler] struct {
+	Key   K `msg:"key"`
+	Value V `msg:"value"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Block struct {
+	Height  uint64                    `msg:"height"`
+	Head    Envelope[Transfer]        `msg:"head"`
+	Signed  Envelope[*Transfer]       `msg:"signed"`
+	Pending []Envelope[Transfer]      `msg:"pending"`
+	Last    *Envelope[Transfer]       `msg:"last"`
+	Swap    Pair[Transfer, *Transfer] `msg:"swap"`
+}
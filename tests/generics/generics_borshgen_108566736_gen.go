@@ -0,0 +1,1390 @@
+package generics
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Transfer) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Transfer) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Transfer", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Transfer to buf.
+// The FieldPath of a returned EncodeError is relative to Transfer
+func (s Transfer) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Transfer) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Transfer in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Transfer) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Transfer", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Transfer in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Transfer) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Transfer", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Transfer to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Transfer) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Transfer", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Transfer to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Transfer
+func (s Transfer) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.To
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("To", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Amount))
+		}
+	return nil
+}
+func (s *Transfer) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Transfer", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Transfer like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Transfer, without trailing bytes
+func (s *Transfer) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Transfer", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Transfer in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Transfer, so wrap unbuffered readers in a bufio.Reader
+func (s *Transfer) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Transfer", err)
+}
+
+// ReadBorsh decodes Transfer nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Transfer
+func (s *Transfer) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Transfer is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Transfer
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("To", err)
+	}
+	__m := string(__s)
+	s.To = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+	__m := uint64(__v)
+	s.Amount = (__m)
+		}
+	return err
+}
+func (s Transfer) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.To
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Transfer by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Transfer) CompareBorsh(other any) int {
+	o := other.(Transfer)
+	_ = o
+	if c := borsh.Compare(s.To, o.To); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Transfer in fields typed by an interface
+func (Transfer) BorshTypeID() uint64 {
+	return 0x139806ee057a4bd6
+}
+func init() {
+	Registry.Register(0x139806ee057a4bd6, func() borsh.BorshEncoder { return new(Transfer) })
+}
+
+// BorshSchema describes the Borsh encoding of Transfer in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Transfer) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Transfer",
+		Definitions: map[string]borsh.Definition{
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Transfer": borsh.StructDef(
+				borsh.Field{Name: "to", Declaration: "String"},
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Envelope[T]) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Envelope[T]) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Envelope", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Envelope to buf.
+// The FieldPath of a returned EncodeError is relative to Envelope
+func (s Envelope[T]) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Envelope[T]) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Envelope in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Envelope[T]) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Envelope", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Envelope in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Envelope[T]) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Envelope", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Envelope to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Envelope[T]) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Envelope", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Envelope to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Envelope
+func (s Envelope[T]) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Nonce))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: param
+					if err := appendParam(buf, s.Payload); err != nil {
+						return borsh.EncodeFieldError("Payload", err)
+					}
+		}
+		{
+		
+				// Batch (batch) - slice
+				// ElementType: []T
+				// Type: []T
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Batch: []Batch
+	if err := checkLength("slice", len((s.Batch)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Batch", err)
+	}
+	 appendLength(buf, len((s.Batch)))
+		for i, item := range (s.Batch) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType param
+	
+					// BASICTYPE: true
+					// ELNTYPE: param
+					if err := appendParam(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Batch", i), err)
+					}
+		}
+		}
+		{
+			if s.Optional == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPOptional
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Optional (optional) - Pointer
+					// ElementType: param
+					// Type: T
+					// ActualType: *T
+					// BasicType: true
+			
+					// ElementType: T
+	
+					// BASICTYPE: true
+					// ELNTYPE: param
+					if err := appendParam(buf, *s.Optional); err != nil {
+						return borsh.EncodeFieldError("Optional", err)
+					}
+		}
+					SKIPOptional:
+		{
+		
+				// ByName (by_name) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.ByName)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("ByName", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.ByName)))
+			for mk, mv := range (s.ByName) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("ByName", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType param
+	
+					// BASICTYPE: true
+					// ELNTYPE: param
+					if err := appendParam(buf, mv); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("ByName", mk), err)
+					}
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("ByName", err)
+			}
+		}
+		}
+	return nil
+}
+func (s *Envelope[T]) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Envelope", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Envelope like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Envelope, without trailing bytes
+func (s *Envelope[T]) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Envelope", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Envelope in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Envelope, so wrap unbuffered readers in a bufio.Reader
+func (s *Envelope[T]) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Envelope", err)
+}
+
+// ReadBorsh decodes Envelope nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Envelope
+func (s *Envelope[T]) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Envelope is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Envelope
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Nonce", err)
+	}
+	__m := uint64(__v)
+	s.Nonce = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	err = readParam(r, &s.Payload, depth+1)
+	if err != nil {
+		return r.FieldError("Payload", err)
+	}
+		}
+		{
+		
+				// Batch (batch) - slice
+				// ElementType: []T
+				// Type: []T
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Batch: []Batch
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Batch", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]T](r, length)
+		if err != nil {
+			return r.FieldError("Batch", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType param
+			// Element T
+	err = readParam(r, &p[i], depth+1)
+	if err != nil {
+		return r.FieldError(borsh.Index("Batch", i), err)
+	}
+		}
+				s.Batch =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Optional", err)
+				}
+				if !present {
+					s.Optional = nil
+					goto SKIPOptional
+				} 
+			}
+		{
+		
+					// Optional (optional) - Pointer
+					// ElementType: param
+					// Type: T
+					// ActualType: *T
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: T
+					// IsPointer: true
+	s.Optional = new(T)
+	err = readParam(r, s.Optional, depth+1)
+	if err != nil {
+		return r.FieldError("Optional", err)
+	}
+		}
+					SKIPOptional:
+		{
+		
+				// ByName (by_name) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("ByName", err)
+			}
+			mp, err := borsh.MakeMap[map[string]T](r, mapLen)
+			if err != nil {
+				return r.FieldError("ByName", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv T
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("ByName", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("ByName", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType param
+			// Element T
+	err = readParam(r, &mv, depth+1)
+	if err != nil {
+		return r.FieldError(borsh.Key("ByName", mk), err)
+	}
+				}
+				mp[mk] = mv
+			}
+			s.ByName = mp
+		}
+		}
+	return err
+}
+func (s Envelope[T]) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				{
+					_s, err := sizeParam(s.Payload)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Payload", err)
+					}
+					size += _s
+				}
+		}
+		{
+		
+				// Batch (batch) - slice
+				// ElementType: []T
+				// Type: []T
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Batch: []Batch
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Batch) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType param
+			// Element T
+				{
+					_s, err := sizeParam(item)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Batch", err)
+					}
+					size += _s
+				}
+		}
+		}
+		{
+			size++
+			if s.Optional == nil {
+				goto SKIPOptional
+			}
+		}
+		{
+		
+					// Optional (optional) - Pointer
+					// ElementType: param
+					// Type: T
+					// ActualType: *T
+					// BasicType: true
+			
+					// ElementType: T
+				{
+					_s, err := sizeParam(*s.Optional)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Optional", err)
+					}
+					size += _s
+				}
+		}
+			SKIPOptional:
+		{
+		
+				// ByName (by_name) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.ByName) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType param
+			// Element T
+				{
+					_s, err := sizeParam(mv)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("ByName", err)
+					}
+					size += _s
+				}
+			}
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Envelope by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Envelope[T]) CompareBorsh(other any) int {
+	o := other.(Envelope[T])
+	_ = o
+	if c := borsh.Compare(s.Nonce, o.Nonce); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Payload, o.Payload); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Batch, o.Batch); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Optional, o.Optional); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.ByName, o.ByName); c != 0 {
+		return c
+	}
+	return 0
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Pair[K, V]) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Pair[K, V]) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Pair", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Pair to buf.
+// The FieldPath of a returned EncodeError is relative to Pair
+func (s Pair[K, V]) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Pair[K, V]) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Pair in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Pair[K, V]) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Pair", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Pair in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Pair[K, V]) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Pair", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Pair to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Pair[K, V]) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Pair", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Pair to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Pair
+func (s Pair[K, V]) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: param
+					if err := appendParam(buf, s.Key); err != nil {
+						return borsh.EncodeFieldError("Key", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: param
+					if err := appendParam(buf, s.Value); err != nil {
+						return borsh.EncodeFieldError("Value", err)
+					}
+		}
+	return nil
+}
+func (s *Pair[K, V]) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Pair", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Pair like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Pair, without trailing bytes
+func (s *Pair[K, V]) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Pair", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Pair in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Pair, so wrap unbuffered readers in a bufio.Reader
+func (s *Pair[K, V]) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Pair", err)
+}
+
+// ReadBorsh decodes Pair nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Pair
+func (s *Pair[K, V]) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Pair is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Pair
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	err = readParam(r, &s.Key, depth+1)
+	if err != nil {
+		return r.FieldError("Key", err)
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	err = readParam(r, &s.Value, depth+1)
+	if err != nil {
+		return r.FieldError("Value", err)
+	}
+		}
+	return err
+}
+func (s Pair[K, V]) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				{
+					_s, err := sizeParam(s.Key)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Key", err)
+					}
+					size += _s
+				}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				{
+					_s, err := sizeParam(s.Value)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Value", err)
+					}
+					size += _s
+				}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Pair by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Pair[K, V]) CompareBorsh(other any) int {
+	o := other.(Pair[K, V])
+	_ = o
+	if c := borsh.Compare(s.Key, o.Key); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Value, o.Value); c != 0 {
+		return c
+	}
+	return 0
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Block) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Block) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Block", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Block to buf.
+// The FieldPath of a returned EncodeError is relative to Block
+func (s Block) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Block) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Block in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Block) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Block", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Block in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Block) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Block", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Block to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Block) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Block", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Block to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Block
+func (s Block) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Height))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Head); err != nil {
+						return borsh.EncodeFieldError("Head", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Signed); err != nil {
+						return borsh.EncodeFieldError("Signed", err)
+					}
+		}
+		{
+		
+				// Pending (pending) - slice
+				// ElementType: []Envelope[Transfer]
+				// Type: []Envelope[Transfer]
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Pending: []Pending
+	if err := checkLength("slice", len((s.Pending)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Pending", err)
+	}
+	 appendLength(buf, len((s.Pending)))
+		for i, item := range (s.Pending) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Nonce uint64 "msg:\"nonce\""; Payload github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"payload\""; Batch []github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"batch\""; Optional *github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"optional\""; ByName map[string]Transfer "msg:\"by_name\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Pending", i), err)
+					}
+		}
+		}
+		{
+			if s.Last == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPLast
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Last (last) - Pointer
+					// ElementType: struct{Nonce uint64 "msg:\"nonce\""; Payload github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"payload\""; Batch []github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"batch\""; Optional *github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"optional\""; ByName map[string]Transfer "msg:\"by_name\""}
+					// Type: Envelope[Transfer]
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Envelope[Transfer]
+					if err := writeNested(buf, s.Last); err != nil {
+						return borsh.EncodeFieldError("Last", err)
+					}
+		}
+					SKIPLast:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Swap); err != nil {
+						return borsh.EncodeFieldError("Swap", err)
+					}
+		}
+	return nil
+}
+func (s *Block) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Block", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Block like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Block, without trailing bytes
+func (s *Block) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Block", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Block in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Block, so wrap unbuffered readers in a bufio.Reader
+func (s *Block) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Block", err)
+}
+
+// ReadBorsh decodes Block nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Block
+func (s *Block) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Block is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Block
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Height", err)
+	}
+	__m := uint64(__v)
+	s.Height = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Envelope[Transfer]{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Head", err)
+					}
+						s.Head = *m
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Envelope[*Transfer]{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Signed", err)
+					}
+						s.Signed = *m
+		}
+		{
+		
+				// Pending (pending) - slice
+				// ElementType: []github.com/mlayerprotocol/go-borshgen/tests/Envelope[github.com/mlayerprotocol/go-borshgen/tests/Transfer]Transfer]
+				// Type: []Envelope[Transfer]
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Pending: []Pending
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Pending", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Envelope[Transfer]](r, length)
+		if err != nil {
+			return r.FieldError("Pending", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Nonce uint64 "msg:\"nonce\""; Payload github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"payload\""; Batch []github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"batch\""; Optional *github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"optional\""; ByName map[string]Transfer "msg:\"by_name\""}
+			// Element Envelope[Transfer]
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Envelope[Transfer]{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Pending", i), err)
+					}
+						p[i] = *m
+		}
+				s.Pending =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Last", err)
+				}
+				if !present {
+					s.Last = nil
+					goto SKIPLast
+				} 
+			}
+		{
+		
+					// Last (last) - Pointer
+					// ElementType: struct{Nonce uint64 "msg:\"nonce\""; Payload github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"payload\""; Batch []github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"batch\""; Optional *github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"optional\""; ByName map[string]Transfer "msg:\"by_name\""}
+					// Type: Envelope[Transfer]
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Envelope[Transfer]
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Envelope[Transfer]{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Last", err)
+					}
+						s.Last = m
+		}
+					SKIPLast:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Pair[Transfer, *Transfer]{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Swap", err)
+					}
+						s.Swap = *m
+		}
+	return err
+}
+func (s Block) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Head)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Head", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Signed)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Signed", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+		
+				// Pending (pending) - slice
+				// ElementType: []Envelope[Transfer]
+				// Type: []Envelope[Transfer]
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Pending: []Pending
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Pending) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Nonce uint64 "msg:\"nonce\""; Payload github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"payload\""; Batch []github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"batch\""; Optional *github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"optional\""; ByName map[string]Transfer "msg:\"by_name\""}
+			// Element Envelope[Transfer]
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Pending", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+		{
+			size++
+			if s.Last == nil {
+				goto SKIPLast
+			}
+		}
+		{
+		
+					// Last (last) - Pointer
+					// ElementType: struct{Nonce uint64 "msg:\"nonce\""; Payload github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"payload\""; Batch []github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"batch\""; Optional *github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"optional\""; ByName map[string]Transfer "msg:\"by_name\""}
+					// Type: Envelope[Transfer]
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Envelope[Transfer]
+	
+		// ElementType: struct{Nonce uint64 "msg:\"nonce\""; Payload github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"payload\""; Batch []github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"batch\""; Optional *github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"optional\""; ByName map[string]Transfer "msg:\"by_name\""}
+		
+			// Element: false
+	
+		// ElementType: struct{Nonce uint64 "msg:\"nonce\""; Payload github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"payload\""; Batch []github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"batch\""; Optional *github.com/mlayerprotocol/go-borshgen/tests/Transfer "msg:\"optional\""; ByName map[string]Transfer "msg:\"by_name\""}
+			bs, err := borsh.SizeOf(s.Last)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Last", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPLast:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Swap)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Swap", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Block by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Block) CompareBorsh(other any) int {
+	o := other.(Block)
+	_ = o
+	if c := borsh.Compare(s.Height, o.Height); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Head, o.Head); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Signed, o.Signed); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Pending, o.Pending); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Last, o.Last); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Swap, o.Swap); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Block in fields typed by an interface
+func (Block) BorshTypeID() uint64 {
+	return 0x9e5b463c34b61e58
+}
+func init() {
+	Registry.Register(0x9e5b463c34b61e58, func() borsh.BorshEncoder { return new(Block) })
+}
+
+// BorshSchema describes the Borsh encoding of Block in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Block) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Block",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"(String, Transfer)": borsh.TupleDef("String", "Transfer"),
+			"BTreeMap<String, Transfer>": borsh.SequenceDef(4, 0, 4294967295, "(String, Transfer)"),
+			"Block": borsh.StructDef(
+				borsh.Field{Name: "height", Declaration: "u64"},
+				borsh.Field{Name: "head", Declaration: "Envelope<Transfer>"},
+				borsh.Field{Name: "signed", Declaration: "Envelope<Transfer>"},
+				borsh.Field{Name: "pending", Declaration: "Vec<Envelope<Transfer>>"},
+				borsh.Field{Name: "last", Declaration: "Option<Envelope<Transfer>>"},
+				borsh.Field{Name: "swap", Declaration: "Pair<Transfer, Transfer>"},),
+			"Envelope<Transfer>": borsh.StructDef(
+				borsh.Field{Name: "nonce", Declaration: "u64"},
+				borsh.Field{Name: "payload", Declaration: "Transfer"},
+				borsh.Field{Name: "batch", Declaration: "Vec<Transfer>"},
+				borsh.Field{Name: "optional", Declaration: "Option<Transfer>"},
+				borsh.Field{Name: "by_name", Declaration: "BTreeMap<String, Transfer>"},),
+			"Option<Envelope<Transfer>>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Envelope<Transfer>"}),
+			"Option<Transfer>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Transfer"}),
+			"Pair<Transfer, Transfer>": borsh.StructDef(
+				borsh.Field{Name: "key", Declaration: "Transfer"},
+				borsh.Field{Name: "value", Declaration: "Transfer"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Transfer": borsh.StructDef(
+				borsh.Field{Name: "to", Declaration: "String"},
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"Vec<Envelope<Transfer>>": borsh.SequenceDef(4, 0, 4294967295, "Envelope<Transfer>"),
+			"Vec<Transfer>": borsh.SequenceDef(4, 0, 4294967295, "Transfer"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package generics
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestGenericLayout(t *testing.T) {
+	v := Envelope[Transfer]{
+		Nonce:   7,
+		Payload: Transfer{To: "a", Amount: 1},
+	}
+
+	var want []byte
+	want = append(want, 7, 0, 0, 0, 0, 0, 0, 0)                  // Nonce
+	want = append(want, 1, 0, 0, 0, 'a', 1, 0, 0, 0, 0, 0, 0, 0) // Payload, inline
+	want = append(want, 0, 0, 0, 0)                              // Batch
+	want = append(want, 0)                                       // Optional: nil
+	want = append(want, 0, 0, 0, 0)                              // ByName
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(want) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(want))
+	}
+}
+
+func TestGenericRoundTrip(t *testing.T) {
+	opt := Transfer{To: "opt", Amount: 3}
+	signedOpt := &Transfer{To: "frank", Amount: 6}
+	v := Block{
+		Height: 42,
+		Head: Envelope[Transfer]{
+			Nonce:    1,
+			Payload:  Transfer{To: "alice", Amount: 10},
+			Batch:    []Transfer{{To: "bob", Amount: 2}},
+			Optional: &opt,
+			ByName:   map[string]Transfer{"carol": {To: "carol", Amount: 5}},
+		},
+		Signed: Envelope[*Transfer]{
+			Nonce:    2,
+			Payload:  &Transfer{To: "dave", Amount: 20},
+			Batch:    []*Transfer{{To: "erin", Amount: 4}},
+			Optional: &signedOpt,
+			ByName:   map[string]*Transfer{},
+		},
+		Pending: []Envelope[Transfer]{{Nonce: 3, Batch: []Transfer{}, ByName: map[string]Transfer{}}},
+		Last:    &Envelope[Transfer]{Nonce: 4, Batch: []Transfer{}, ByName: map[string]Transfer{}},
+		Swap: Pair[Transfer, *Transfer]{
+			Key:   Transfer{To: "k"},
+			Value: &Transfer{To: "v"},
+		},
+	}
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(data) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(data))
+	}
+
+	var got Block
+	if err := got.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("round trip mismatch:\n got %+v\nwant %+v", got, v)
+	}
+}
+
+func TestGenericNilTypeArgument(t *testing.T) {
+	v := Envelope[*Transfer]{}
+	if _, err := v.MarshalBorsh(); err == nil {
+		t.Error("MarshalBorsh() accepted a nil type argument")
+	}
+}
@@ -0,0 +1,4471 @@
+package imported
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Primitives) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Primitives) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Primitives", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Primitives to buf.
+// The FieldPath of a returned EncodeError is relative to Primitives
+func (s Primitives) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Primitives) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Primitives in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Primitives) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Primitives", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Primitives in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Primitives) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Primitives", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Primitives to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Primitives) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Primitives", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Primitives to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Primitives
+func (s Primitives) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if s.Flag {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.U8))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.U16))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(s.U32))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.U64))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint128
+					_v128 := s.U128
+					buf.Write(_v128[:])
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int8
+					buf.WriteByte(byte(s.I8))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int16
+					buf.WriteUint16(uint16(s.I16))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(s.I32))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(s.I64))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int128
+					_v128 := s.I128
+					buf.Write(_v128[:])
+		}
+	return nil
+}
+func (s *Primitives) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Primitives", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Primitives like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Primitives, without trailing bytes
+func (s *Primitives) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Primitives", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Primitives in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Primitives, so wrap unbuffered readers in a bufio.Reader
+func (s *Primitives) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Primitives", err)
+}
+
+// ReadBorsh decodes Primitives nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Primitives
+func (s *Primitives) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Primitives is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Primitives
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadBool()
+	if err != nil {
+		return r.FieldError("Flag", err)
+	}
+	__m := bool(__v)
+	s.Flag = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("U8", err)
+	}
+	__m := uint8(__v)
+	s.U8 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError("U16", err)
+	}
+	__m := uint16(__v)
+	s.U16 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("U32", err)
+	}
+	__m := uint32(__v)
+	s.U32 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("U64", err)
+	}
+	__m := uint64(__v)
+	s.U64 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError("U128", err)
+	}
+	__m := borsh.Uint128{}
+	copy(__m[:], __b)
+	s.U128 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("I8", err)
+	}
+	__m := int8(__v)
+	s.I8 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError("I16", err)
+	}
+	__m := int16(__v)
+	s.I16 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("I32", err)
+	}
+	__m := int32(__v)
+	s.I32 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("I64", err)
+	}
+	__m := int64(__v)
+	s.I64 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError("I128", err)
+	}
+	__m := borsh.Int128{}
+	copy(__m[:], __b)
+	s.I128 = (__m)
+		}
+	return err
+}
+func (s Primitives) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 2
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 2
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Primitives by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Primitives) CompareBorsh(other any) int {
+	o := other.(Primitives)
+	_ = o
+	if c := borsh.Compare(s.Flag, o.Flag); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.U8, o.U8); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.U16, o.U16); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.U32, o.U32); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.U64, o.U64); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.U128, o.U128); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.I8, o.I8); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.I16, o.I16); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.I32, o.I32); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.I64, o.I64); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.I128, o.I128); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Primitives in fields typed by an interface
+func (Primitives) BorshTypeID() uint64 {
+	return 0x6f5cc8d952d06d2c
+}
+func init() {
+	Registry.Register(0x6f5cc8d952d06d2c, func() borsh.BorshEncoder { return new(Primitives) })
+}
+
+// BorshSchema describes the Borsh encoding of Primitives in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Primitives) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Primitives",
+		Definitions: map[string]borsh.Definition{
+			"Primitives": borsh.StructDef(
+				borsh.Field{Name: "flag", Declaration: "bool"},
+				borsh.Field{Name: "u8", Declaration: "u8"},
+				borsh.Field{Name: "u16", Declaration: "u16"},
+				borsh.Field{Name: "u32", Declaration: "u32"},
+				borsh.Field{Name: "u64", Declaration: "u64"},
+				borsh.Field{Name: "u128", Declaration: "u128"},
+				borsh.Field{Name: "i8", Declaration: "i8"},
+				borsh.Field{Name: "i16", Declaration: "i16"},
+				borsh.Field{Name: "i32", Declaration: "i32"},
+				borsh.Field{Name: "i64", Declaration: "i64"},
+				borsh.Field{Name: "i128", Declaration: "i128"},),
+			"bool": borsh.PrimitiveDef(1),
+			"i128": borsh.PrimitiveDef(16),
+			"i16": borsh.PrimitiveDef(2),
+			"i32": borsh.PrimitiveDef(4),
+			"i64": borsh.PrimitiveDef(8),
+			"i8": borsh.PrimitiveDef(1),
+			"u128": borsh.PrimitiveDef(16),
+			"u16": borsh.PrimitiveDef(2),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Floats) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Floats) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Floats", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Floats to buf.
+// The FieldPath of a returned EncodeError is relative to Floats
+func (s Floats) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Floats) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Floats in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Floats) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Floats", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Floats in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Floats) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Floats", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Floats to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Floats) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Floats", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Floats to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Floats
+func (s Floats) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float32
+					buf.WriteFloat32(s.F32)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(s.F64)
+		}
+	return nil
+}
+func (s *Floats) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Floats", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Floats like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Floats, without trailing bytes
+func (s *Floats) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Floats", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Floats in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Floats, so wrap unbuffered readers in a bufio.Reader
+func (s *Floats) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Floats", err)
+}
+
+// ReadBorsh decodes Floats nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Floats
+func (s *Floats) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Floats is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Floats
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadFloat32()
+	if err != nil {
+		return r.FieldError("F32", err)
+	}
+	__m := float32(__v)
+	s.F32 = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadFloat64()
+	if err != nil {
+		return r.FieldError("F64", err)
+	}
+	__m := float64(__v)
+	s.F64 = (__m)
+		}
+	return err
+}
+func (s Floats) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Floats by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Floats) CompareBorsh(other any) int {
+	o := other.(Floats)
+	_ = o
+	if c := borsh.Compare(s.F32, o.F32); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.F64, o.F64); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Floats in fields typed by an interface
+func (Floats) BorshTypeID() uint64 {
+	return 0x8db1bc03581c942a
+}
+func init() {
+	Registry.Register(0x8db1bc03581c942a, func() borsh.BorshEncoder { return new(Floats) })
+}
+
+// BorshSchema describes the Borsh encoding of Floats in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Floats) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Floats",
+		Definitions: map[string]borsh.Definition{
+			"Floats": borsh.StructDef(
+				borsh.Field{Name: "f32", Declaration: "f32"},
+				borsh.Field{Name: "f64", Declaration: "f64"},),
+			"f32": borsh.PrimitiveDef(4),
+			"f64": borsh.PrimitiveDef(8),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Strings) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Strings) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Strings", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Strings to buf.
+// The FieldPath of a returned EncodeError is relative to Strings
+func (s Strings) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Strings) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Strings in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Strings) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Strings", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Strings in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Strings) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Strings", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Strings to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Strings) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Strings", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Strings to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Strings
+func (s Strings) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Empty
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Empty", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.ASCII
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("ASCII", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.UTF8
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("UTF8", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Strings) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Strings", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Strings like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Strings, without trailing bytes
+func (s *Strings) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Strings", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Strings in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Strings, so wrap unbuffered readers in a bufio.Reader
+func (s *Strings) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Strings", err)
+}
+
+// ReadBorsh decodes Strings nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Strings
+func (s *Strings) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Strings is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Strings
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Empty", err)
+	}
+	__m := string(__s)
+	s.Empty = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("ASCII", err)
+	}
+	__m := string(__s)
+	s.ASCII = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("UTF8", err)
+	}
+	__m := string(__s)
+	s.UTF8 = (__m)
+		}
+	return err
+}
+func (s Strings) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Empty
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.ASCII
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.UTF8
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Strings by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Strings) CompareBorsh(other any) int {
+	o := other.(Strings)
+	_ = o
+	if c := borsh.Compare(s.Empty, o.Empty); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.ASCII, o.ASCII); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.UTF8, o.UTF8); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Strings in fields typed by an interface
+func (Strings) BorshTypeID() uint64 {
+	return 0x25a544fcc216eea2
+}
+func init() {
+	Registry.Register(0x25a544fcc216eea2, func() borsh.BorshEncoder { return new(Strings) })
+}
+
+// BorshSchema describes the Borsh encoding of Strings in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Strings) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Strings",
+		Definitions: map[string]borsh.Definition{
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Strings": borsh.StructDef(
+				borsh.Field{Name: "empty", Declaration: "String"},
+				borsh.Field{Name: "ascii", Declaration: "String"},
+				borsh.Field{Name: "utf8", Declaration: "String"},),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Arrays) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Arrays) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Arrays", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Arrays to buf.
+// The FieldPath of a returned EncodeError is relative to Arrays
+func (s Arrays) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Arrays) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Arrays in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Arrays) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Arrays", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Arrays in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Arrays) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Arrays", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Arrays to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Arrays) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Arrays", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Arrays to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Arrays
+func (s Arrays) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Bytes (bytes) - slice
+				// ElementType: [4]byte
+				// Type: [4]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [4]Bytes
+	for i := 0; i < 4; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Bytes[i]))
+	}
+		}
+		{
+		
+				// Words (words) - slice
+				// ElementType: [2]uint16
+				// Type: [2]uint16
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [2]Words
+	for i := 0; i < 2; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.Words[i]))
+	}
+		}
+		{
+		
+				// Names (names) - slice
+				// ElementType: [2]string
+				// Type: [2]string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [2]Names
+	for i := 0; i < 2; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Names[i]
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Names", i), err)
+					}
+					appendString(buf, string(str))
+	}
+		}
+	return nil
+}
+func (s *Arrays) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Arrays", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Arrays like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Arrays, without trailing bytes
+func (s *Arrays) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Arrays", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Arrays in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Arrays, so wrap unbuffered readers in a bufio.Reader
+func (s *Arrays) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Arrays", err)
+}
+
+// ReadBorsh decodes Arrays nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Arrays
+func (s *Arrays) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Arrays is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Arrays
+    var err error
+		{
+		
+				// Bytes (bytes) - slice
+				// ElementType: [4]byte
+				// Type: [4]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [4]byte{}
+	length := 4
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Bytes", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Bytes = (p)
+		}
+		{
+		
+				// Words (words) - slice
+				// ElementType: [2]uint16
+				// Type: [2]uint16
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [2]uint16{}
+	length := 2
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError(borsh.Index("Words", i), err)
+	}
+	__m := uint16(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Words = (p)
+		}
+		{
+		
+				// Names (names) - slice
+				// ElementType: [2]string
+				// Type: [2]string
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [2]string{}
+	length := 2
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Names", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Names = (p)
+		}
+	return err
+}
+func (s Arrays) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Bytes (bytes) - slice
+				// ElementType: [4]byte
+				// Type: [4]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [4]Bytes
+	for _, item := range  (s.Bytes) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+		{
+		
+				// Words (words) - slice
+				// ElementType: [2]uint16
+				// Type: [2]uint16
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [2]Words
+	for _, item := range  (s.Words) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+				size += 2
+	}
+		}
+		{
+		
+				// Names (names) - slice
+				// ElementType: [2]string
+				// Type: [2]string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [2]Names
+	for _, item := range  (s.Names) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+	}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Arrays by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Arrays) CompareBorsh(other any) int {
+	o := other.(Arrays)
+	_ = o
+	if c := borsh.Compare(s.Bytes, o.Bytes); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Words, o.Words); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Names, o.Names); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Arrays in fields typed by an interface
+func (Arrays) BorshTypeID() uint64 {
+	return 0xdd1c031f8b8fe980
+}
+func init() {
+	Registry.Register(0xdd1c031f8b8fe980, func() borsh.BorshEncoder { return new(Arrays) })
+}
+
+// BorshSchema describes the Borsh encoding of Arrays in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Arrays) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Arrays",
+		Definitions: map[string]borsh.Definition{
+			"Arrays": borsh.StructDef(
+				borsh.Field{Name: "bytes", Declaration: "[u8; 4]"},
+				borsh.Field{Name: "words", Declaration: "[u16; 2]"},
+				borsh.Field{Name: "names", Declaration: "[String; 2]"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"[String; 2]": borsh.SequenceDef(0, 2, 2, "String"),
+			"[u16; 2]": borsh.SequenceDef(0, 2, 2, "u16"),
+			"[u8; 4]": borsh.SequenceDef(0, 4, 4, "u8"),
+			"u16": borsh.PrimitiveDef(2),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Vectors) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Vectors) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Vectors", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Vectors to buf.
+// The FieldPath of a returned EncodeError is relative to Vectors
+func (s Vectors) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Vectors) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Vectors in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Vectors) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Vectors", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Vectors in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Vectors) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Vectors", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Vectors to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Vectors) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Vectors", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Vectors to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Vectors
+func (s Vectors) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Bytes (bytes) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+	data, err := _DefaultByteArrayEncoder.MarshalBorsh((s.Bytes), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("Bytes", err)
+		}
+		 appendBytes(buf, data)
+		}
+		{
+		
+				// Numbers (numbers) - slice
+				// ElementType: []uint32
+				// Type: []uint32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Numbers: []Numbers
+	if err := checkLength("slice", len((s.Numbers)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Numbers", err)
+	}
+	 appendLength(buf, len((s.Numbers)))
+		for i, item := range (s.Numbers) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(item))
+		}
+		}
+		{
+		
+				// Names (names) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Names: []Names
+	if err := checkLength("slice", len((s.Names)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Names", err)
+	}
+	 appendLength(buf, len((s.Names)))
+		for i, item := range (s.Names) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Names", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+		{
+		
+				// Empty (empty) - slice
+				// ElementType: []uint64
+				// Type: []uint64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Empty: []Empty
+	if err := checkLength("slice", len((s.Empty)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Empty", err)
+	}
+	 appendLength(buf, len((s.Empty)))
+		for i, item := range (s.Empty) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(item))
+		}
+		}
+	return nil
+}
+func (s *Vectors) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Vectors", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Vectors like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Vectors, without trailing bytes
+func (s *Vectors) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Vectors", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Vectors in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Vectors, so wrap unbuffered readers in a bufio.Reader
+func (s *Vectors) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Vectors", err)
+}
+
+// ReadBorsh decodes Vectors nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Vectors
+func (s *Vectors) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Vectors is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Vectors
+    var err error
+		{
+		
+				// Bytes (bytes) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+			itemData, err := readByteSlice(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Bytes", err)
+			}
+			if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+				return r.FieldError("Bytes", err)
+			} else {
+				_m := (_v).([]byte)
+				s.Bytes = _m
+			}
+		}
+		{
+		
+				// Numbers (numbers) - slice
+				// ElementType: []uint32
+				// Type: []uint32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Numbers: []Numbers
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Numbers", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]uint32](r, length)
+		if err != nil {
+			return r.FieldError("Numbers", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError(borsh.Index("Numbers", i), err)
+	}
+	__m := uint32(__v)
+	p[i] = (__m)
+		}
+				s.Numbers =  p
+		}
+		{
+		
+				// Names (names) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Names: []Names
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Names", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]string](r, length)
+		if err != nil {
+			return r.FieldError("Names", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Names", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+		}
+				s.Names =  p
+		}
+		{
+		
+				// Empty (empty) - slice
+				// ElementType: []uint64
+				// Type: []uint64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Empty: []Empty
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Empty", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]uint64](r, length)
+		if err != nil {
+			return r.FieldError("Empty", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+			// Element uint64
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError(borsh.Index("Empty", i), err)
+	}
+	__m := uint64(__v)
+	p[i] = (__m)
+		}
+				s.Empty =  p
+		}
+	return err
+}
+func (s Vectors) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Bytes (bytes) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+
+// Var s.Bytes
+		_s, err := _DefaultByteArrayEncoder.BinarySize(s.Bytes, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder s.Bytes: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		{
+		
+				// Numbers (numbers) - slice
+				// ElementType: []uint32
+				// Type: []uint32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Numbers: []Numbers
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Numbers) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+				size += 4
+		}
+		}
+		{
+		
+				// Names (names) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Names: []Names
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Names) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+		{
+		
+				// Empty (empty) - slice
+				// ElementType: []uint64
+				// Type: []uint64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Empty: []Empty
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Empty) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+			// Element uint64
+				size += 8
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Vectors by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Vectors) CompareBorsh(other any) int {
+	o := other.(Vectors)
+	_ = o
+	if c := borsh.Compare(s.Bytes, o.Bytes); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Numbers, o.Numbers); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Names, o.Names); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Empty, o.Empty); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Vectors in fields typed by an interface
+func (Vectors) BorshTypeID() uint64 {
+	return 0xa4758f9b4961b0d9
+}
+func init() {
+	Registry.Register(0xa4758f9b4961b0d9, func() borsh.BorshEncoder { return new(Vectors) })
+}
+
+// BorshSchema describes the Borsh encoding of Vectors in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Vectors) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Vectors",
+		Definitions: map[string]borsh.Definition{
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<String>": borsh.SequenceDef(4, 0, 4294967295, "String"),
+			"Vec<u32>": borsh.SequenceDef(4, 0, 4294967295, "u32"),
+			"Vec<u64>": borsh.SequenceDef(4, 0, 4294967295, "u64"),
+			"Vec<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vectors": borsh.StructDef(
+				borsh.Field{Name: "bytes", Declaration: "Vec<u8>"},
+				borsh.Field{Name: "numbers", Declaration: "Vec<u32>"},
+				borsh.Field{Name: "names", Declaration: "Vec<String>"},
+				borsh.Field{Name: "empty", Declaration: "Vec<u64>"},),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Point) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Point) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Point", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Point to buf.
+// The FieldPath of a returned EncodeError is relative to Point
+func (s Point) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Point) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Point in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Point) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Point", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Point in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Point) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Point", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Point to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Point) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Point", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Point to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Point
+func (s Point) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(s.X))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(s.Y))
+		}
+	return nil
+}
+func (s *Point) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Point", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Point like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Point, without trailing bytes
+func (s *Point) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Point", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Point in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Point, so wrap unbuffered readers in a bufio.Reader
+func (s *Point) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Point", err)
+}
+
+// ReadBorsh decodes Point nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Point
+func (s *Point) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Point is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Point
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("X", err)
+	}
+	__m := int32(__v)
+	s.X = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("Y", err)
+	}
+	__m := int32(__v)
+	s.Y = (__m)
+		}
+	return err
+}
+func (s Point) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Point by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Point) CompareBorsh(other any) int {
+	o := other.(Point)
+	_ = o
+	if c := borsh.Compare(s.X, o.X); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Y, o.Y); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Point in fields typed by an interface
+func (Point) BorshTypeID() uint64 {
+	return 0xb813a1b4014d0c09
+}
+func init() {
+	Registry.Register(0xb813a1b4014d0c09, func() borsh.BorshEncoder { return new(Point) })
+}
+
+// BorshSchema describes the Borsh encoding of Point in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Point) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Point",
+		Definitions: map[string]borsh.Definition{
+			"Point": borsh.StructDef(
+				borsh.Field{Name: "x", Declaration: "i32"},
+				borsh.Field{Name: "y", Declaration: "i32"},),
+			"i32": borsh.PrimitiveDef(4),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Options) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Options) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Options", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Options to buf.
+// The FieldPath of a returned EncodeError is relative to Options
+func (s Options) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Options) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Options in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Options) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Options", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Options in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Options) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Options", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Options to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Options) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Options", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Options to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Options
+func (s Options) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+			if s.Some == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPSome
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Some (some) - Pointer
+					// ElementType: uint32
+					// Type: uint32
+					// ActualType: *uint32
+					// BasicType: true
+			
+					// ElementType: uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(*s.Some))
+		}
+					SKIPSome:
+		{
+			if s.None == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPNone
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// None (none) - Pointer
+					// ElementType: uint32
+					// Type: uint32
+					// ActualType: *uint32
+					// BasicType: true
+			
+					// ElementType: uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(*s.None))
+		}
+					SKIPNone:
+		{
+			if s.Name == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPName
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Name (name) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := *s.Name
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Name", err)
+					}
+					appendString(buf, string(str))
+		}
+					SKIPName:
+		{
+			if s.Point == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPPoint
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Point (point) - Pointer
+					// ElementType: struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+					// Type: Point
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Point
+					if err := writeNested(buf, s.Point); err != nil {
+						return borsh.EncodeFieldError("Point", err)
+					}
+		}
+					SKIPPoint:
+	return nil
+}
+func (s *Options) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Options", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Options like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Options, without trailing bytes
+func (s *Options) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Options", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Options in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Options, so wrap unbuffered readers in a bufio.Reader
+func (s *Options) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Options", err)
+}
+
+// ReadBorsh decodes Options nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Options
+func (s *Options) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Options is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Options
+    var err error
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Some", err)
+				}
+				if !present {
+					s.Some = nil
+					goto SKIPSome
+				} 
+			}
+		{
+		
+					// Some (some) - Pointer
+					// ElementType: uint32
+					// Type: uint32
+					// ActualType: *uint32
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: uint32
+					// IsPointer: true
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("Some", err)
+	}
+	__m := uint32(__v)
+	s.Some = &(__m)
+		}
+					SKIPSome:
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("None", err)
+				}
+				if !present {
+					s.None = nil
+					goto SKIPNone
+				} 
+			}
+		{
+		
+					// None (none) - Pointer
+					// ElementType: uint32
+					// Type: uint32
+					// ActualType: *uint32
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: uint32
+					// IsPointer: true
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("None", err)
+	}
+	__m := uint32(__v)
+	s.None = &(__m)
+		}
+					SKIPNone:
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Name", err)
+				}
+				if !present {
+					s.Name = nil
+					goto SKIPName
+				} 
+			}
+		{
+		
+					// Name (name) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: string
+					// IsPointer: true
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Name", err)
+	}
+	__m := string(__s)
+	s.Name = &(__m)
+		}
+					SKIPName:
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Point", err)
+				}
+				if !present {
+					s.Point = nil
+					goto SKIPPoint
+				} 
+			}
+		{
+		
+					// Point (point) - Pointer
+					// ElementType: struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+					// Type: Point
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Point
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Point{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Point", err)
+					}
+						s.Point = m
+		}
+					SKIPPoint:
+	return err
+}
+func (s Options) BinarySize() (int, error) {
+	size := 0
+		{
+			size++
+			if s.Some == nil {
+				goto SKIPSome
+			}
+		}
+		{
+		
+					// Some (some) - Pointer
+					// ElementType: uint32
+					// Type: uint32
+					// ActualType: *uint32
+					// BasicType: true
+			
+					// ElementType: uint32
+	
+		// ElementType: uint32
+		
+			// Element: true
+				size += 4
+		}
+			SKIPSome:
+		{
+			size++
+			if s.None == nil {
+				goto SKIPNone
+			}
+		}
+		{
+		
+					// None (none) - Pointer
+					// ElementType: uint32
+					// Type: uint32
+					// ActualType: *uint32
+					// BasicType: true
+			
+					// ElementType: uint32
+	
+		// ElementType: uint32
+		
+			// Element: true
+				size += 4
+		}
+			SKIPNone:
+		{
+			size++
+			if s.Name == nil {
+				goto SKIPName
+			}
+		}
+		{
+		
+					// Name (name) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+		// ElementType: string
+		
+			// Element: true
+				ptr := *s.Name
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+			SKIPName:
+		{
+			size++
+			if s.Point == nil {
+				goto SKIPPoint
+			}
+		}
+		{
+		
+					// Point (point) - Pointer
+					// ElementType: struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+					// Type: Point
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Point
+	
+		// ElementType: struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+		
+			// Element: false
+	
+		// ElementType: struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+			bs, err := borsh.SizeOf(s.Point)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Point", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPPoint:
+	return size, nil
+}
+
+// CompareBorsh orders Options by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Options) CompareBorsh(other any) int {
+	o := other.(Options)
+	_ = o
+	if c := borsh.Compare(s.Some, o.Some); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.None, o.None); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Name, o.Name); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Point, o.Point); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Options in fields typed by an interface
+func (Options) BorshTypeID() uint64 {
+	return 0x57ebd67752a8afc6
+}
+func init() {
+	Registry.Register(0x57ebd67752a8afc6, func() borsh.BorshEncoder { return new(Options) })
+}
+
+// BorshSchema describes the Borsh encoding of Options in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Options) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Options",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Option<Point>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Point"}),
+			"Option<String>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "String"}),
+			"Option<u32>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "u32"}),
+			"Options": borsh.StructDef(
+				borsh.Field{Name: "some", Declaration: "Option<u32>"},
+				borsh.Field{Name: "none", Declaration: "Option<u32>"},
+				borsh.Field{Name: "name", Declaration: "Option<String>"},
+				borsh.Field{Name: "point", Declaration: "Option<Point>"},),
+			"Point": borsh.StructDef(
+				borsh.Field{Name: "x", Declaration: "i32"},
+				borsh.Field{Name: "y", Declaration: "i32"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"i32": borsh.PrimitiveDef(4),
+			"u32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Nested) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Nested) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Nested", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Nested to buf.
+// The FieldPath of a returned EncodeError is relative to Nested
+func (s Nested) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Nested) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Nested in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Nested) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Nested", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Nested in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Nested) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Nested", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Nested to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Nested) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Nested", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Nested to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Nested
+func (s Nested) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Origin); err != nil {
+						return borsh.EncodeFieldError("Origin", err)
+					}
+		}
+		{
+		
+				// Path (path) - slice
+				// ElementType: []Point
+				// Type: []Point
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Path: []Path
+	if err := checkLength("slice", len((s.Path)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Path", err)
+	}
+	 appendLength(buf, len((s.Path)))
+		for i, item := range (s.Path) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Path", i), err)
+					}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Label
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Label", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Nested) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Nested", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Nested like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Nested, without trailing bytes
+func (s *Nested) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Nested", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Nested in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Nested, so wrap unbuffered readers in a bufio.Reader
+func (s *Nested) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Nested", err)
+}
+
+// ReadBorsh decodes Nested nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Nested
+func (s *Nested) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Nested is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Nested
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Point{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Origin", err)
+					}
+						s.Origin = *m
+		}
+		{
+		
+				// Path (path) - slice
+				// ElementType: []Point
+				// Type: []Point
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Path: []Path
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Path", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Point](r, length)
+		if err != nil {
+			return r.FieldError("Path", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+			// Element Point
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Point{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Path", i), err)
+					}
+						p[i] = *m
+		}
+				s.Path =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Label", err)
+	}
+	__m := string(__s)
+	s.Label = (__m)
+		}
+	return err
+}
+func (s Nested) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Origin)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Origin", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+		
+				// Path (path) - slice
+				// ElementType: []Point
+				// Type: []Point
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Path: []Path
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Path) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+			// Element Point
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Path", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Label
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Nested by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Nested) CompareBorsh(other any) int {
+	o := other.(Nested)
+	_ = o
+	if c := borsh.Compare(s.Origin, o.Origin); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Path, o.Path); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Label, o.Label); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Nested in fields typed by an interface
+func (Nested) BorshTypeID() uint64 {
+	return 0xfc8d50be57f6820f
+}
+func init() {
+	Registry.Register(0xfc8d50be57f6820f, func() borsh.BorshEncoder { return new(Nested) })
+}
+
+// BorshSchema describes the Borsh encoding of Nested in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Nested) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Nested",
+		Definitions: map[string]borsh.Definition{
+			"Nested": borsh.StructDef(
+				borsh.Field{Name: "origin", Declaration: "Point"},
+				borsh.Field{Name: "path", Declaration: "Vec<Point>"},
+				borsh.Field{Name: "label", Declaration: "String"},),
+			"Point": borsh.StructDef(
+				borsh.Field{Name: "x", Declaration: "i32"},
+				borsh.Field{Name: "y", Declaration: "i32"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<Point>": borsh.SequenceDef(4, 0, 4294967295, "Point"),
+			"i32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Maps) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Maps) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Maps", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Maps to buf.
+// The FieldPath of a returned EncodeError is relative to Maps
+func (s Maps) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Maps) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Maps in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Maps) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Maps", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Maps in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Maps) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Maps", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Maps to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Maps) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Maps", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Maps to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Maps
+func (s Maps) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Counts (counts) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Counts)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Counts", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Counts)))
+			for mk, mv := range (s.Counts) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(mk))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Counts", err)
+			}
+		}
+		}
+		{
+		
+				// Names (names) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Names)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Names", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Names)))
+			for mk, mv := range (s.Names) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Names", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Names", err)
+			}
+		}
+		}
+		{
+		
+				// Empty (empty) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Empty)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Empty", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Empty)))
+			for mk, mv := range (s.Empty) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(mk))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType bool
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if mv {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Empty", err)
+			}
+		}
+		}
+	return nil
+}
+func (s *Maps) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Maps", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Maps like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Maps, without trailing bytes
+func (s *Maps) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Maps", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Maps in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Maps, so wrap unbuffered readers in a bufio.Reader
+func (s *Maps) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Maps", err)
+}
+
+// ReadBorsh decodes Maps nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Maps
+func (s *Maps) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Maps is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Maps
+    var err error
+		{
+		
+				// Counts (counts) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Counts", err)
+			}
+			mp, err := borsh.MakeMap[map[uint8]uint16](r, mapLen)
+			if err != nil {
+				return r.FieldError("Counts", err)
+			}
+			var prevKey uint8
+			for mi := 0; mi < mapLen; mi++ {
+				var mk uint8
+				var mv uint16
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Counts", mi), err)
+	}
+	__m := uint8(__v)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Counts", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError(borsh.Key("Counts", mk), err)
+	}
+	__m := uint16(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Counts = mp
+		}
+		}
+		{
+		
+				// Names (names) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Names", err)
+			}
+			mp, err := borsh.MakeMap[map[string]uint8](r, mapLen)
+			if err != nil {
+				return r.FieldError("Names", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv uint8
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Names", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Names", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Key("Names", mk), err)
+	}
+	__m := uint8(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Names = mp
+		}
+		}
+		{
+		
+				// Empty (empty) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Empty", err)
+			}
+			mp, err := borsh.MakeMap[map[uint32]bool](r, mapLen)
+			if err != nil {
+				return r.FieldError("Empty", err)
+			}
+			var prevKey uint32
+			for mi := 0; mi < mapLen; mi++ {
+				var mk uint32
+				var mv bool
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError(borsh.Index("Empty", mi), err)
+	}
+	__m := uint32(__v)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Empty", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType bool
+			// Element bool
+	__v, err := r.ReadBool()
+	if err != nil {
+		return r.FieldError(borsh.Key("Empty", mk), err)
+	}
+	__m := bool(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Empty = mp
+		}
+		}
+	return err
+}
+func (s Maps) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Counts (counts) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Counts) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+				size += 1
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+				size += 2
+			}
+		}
+		}
+		{
+		
+				// Names (names) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Names) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+				size += 1
+			}
+		}
+		}
+		{
+		
+				// Empty (empty) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Empty) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+				size += 4
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType bool
+			// Element bool
+				size += 1
+			}
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Maps by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Maps) CompareBorsh(other any) int {
+	o := other.(Maps)
+	_ = o
+	if c := borsh.Compare(s.Counts, o.Counts); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Names, o.Names); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Empty, o.Empty); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Maps in fields typed by an interface
+func (Maps) BorshTypeID() uint64 {
+	return 0xbf4afa0f46e196b4
+}
+func init() {
+	Registry.Register(0xbf4afa0f46e196b4, func() borsh.BorshEncoder { return new(Maps) })
+}
+
+// BorshSchema describes the Borsh encoding of Maps in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Maps) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Maps",
+		Definitions: map[string]borsh.Definition{
+			"(String, u8)": borsh.TupleDef("String", "u8"),
+			"(u32, bool)": borsh.TupleDef("u32", "bool"),
+			"(u8, u16)": borsh.TupleDef("u8", "u16"),
+			"BTreeMap<String, u8>": borsh.SequenceDef(4, 0, 4294967295, "(String, u8)"),
+			"BTreeMap<u32, bool>": borsh.SequenceDef(4, 0, 4294967295, "(u32, bool)"),
+			"BTreeMap<u8, u16>": borsh.SequenceDef(4, 0, 4294967295, "(u8, u16)"),
+			"Maps": borsh.StructDef(
+				borsh.Field{Name: "counts", Declaration: "BTreeMap<u8, u16>"},
+				borsh.Field{Name: "names", Declaration: "BTreeMap<String, u8>"},
+				borsh.Field{Name: "empty", Declaration: "BTreeMap<u32, bool>"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"bool": borsh.PrimitiveDef(1),
+			"u16": borsh.PrimitiveDef(2),
+			"u32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Sets) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Sets) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Sets", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Sets to buf.
+// The FieldPath of a returned EncodeError is relative to Sets
+func (s Sets) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Sets) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Sets in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Sets) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Sets", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Sets in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Sets) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Sets", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Sets to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Sets) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Sets", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Sets to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Sets
+func (s Sets) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Flags (flags) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Flags)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Flags", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Flags)))
+			for mk, mv := range (s.Flags) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(mk))
+					entry.Key = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Flags", err)
+			}
+		}
+		}
+		{
+		
+				// Tags (tags) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Tags)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Tags", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Tags)))
+			for mk, mv := range (s.Tags) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Tags", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Tags", err)
+			}
+		}
+		}
+	return nil
+}
+func (s *Sets) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Sets", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Sets like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Sets, without trailing bytes
+func (s *Sets) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Sets", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Sets in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Sets, so wrap unbuffered readers in a bufio.Reader
+func (s *Sets) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Sets", err)
+}
+
+// ReadBorsh decodes Sets nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Sets
+func (s *Sets) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Sets is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Sets
+    var err error
+		{
+		
+				// Flags (flags) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Flags", err)
+			}
+			mp, err := borsh.MakeMap[map[uint8]struct{}](r, mapLen)
+			if err != nil {
+				return r.FieldError("Flags", err)
+			}
+			var prevKey uint8
+			for mi := 0; mi < mapLen; mi++ {
+				var mk uint8
+				var mv struct{}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Flags", mi), err)
+	}
+	__m := uint8(__v)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Flags", mk), err)
+					}
+					prevKey = mk
+				}
+				mp[mk] = mv
+			}
+			s.Flags = mp
+		}
+		}
+		{
+		
+				// Tags (tags) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Tags", err)
+			}
+			mp, err := borsh.MakeMap[map[string]struct{}](r, mapLen)
+			if err != nil {
+				return r.FieldError("Tags", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv struct{}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Tags", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Tags", mk), err)
+					}
+					prevKey = mk
+				}
+				mp[mk] = mv
+			}
+			s.Tags = mp
+		}
+		}
+	return err
+}
+func (s Sets) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Flags (flags) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Flags) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+				size += 1
+			}
+		}
+		}
+		{
+		
+				// Tags (tags) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Tags) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Sets by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Sets) CompareBorsh(other any) int {
+	o := other.(Sets)
+	_ = o
+	if c := borsh.Compare(s.Flags, o.Flags); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Tags, o.Tags); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Sets in fields typed by an interface
+func (Sets) BorshTypeID() uint64 {
+	return 0x6c36ec4563afbfe7
+}
+func init() {
+	Registry.Register(0x6c36ec4563afbfe7, func() borsh.BorshEncoder { return new(Sets) })
+}
+
+// BorshSchema describes the Borsh encoding of Sets in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Sets) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Sets",
+		Definitions: map[string]borsh.Definition{
+			"BTreeSet<String>": borsh.SequenceDef(4, 0, 4294967295, "String"),
+			"BTreeSet<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Sets": borsh.StructDef(
+				borsh.Field{Name: "flags", Declaration: "BTreeSet<u8>"},
+				borsh.Field{Name: "tags", Declaration: "BTreeSet<String>"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Circle) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Circle) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Circle", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Circle to buf.
+// The FieldPath of a returned EncodeError is relative to Circle
+func (s Circle) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Circle) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Circle in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Circle) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Circle", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Circle in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Circle) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Circle", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Circle to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Circle) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Circle", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Circle to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Circle
+func (s Circle) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(s.Radius))
+		}
+	return nil
+}
+func (s *Circle) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Circle", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Circle like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Circle, without trailing bytes
+func (s *Circle) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Circle", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Circle in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Circle, so wrap unbuffered readers in a bufio.Reader
+func (s *Circle) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Circle", err)
+}
+
+// ReadBorsh decodes Circle nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Circle
+func (s *Circle) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Circle is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Circle
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("Radius", err)
+	}
+	__m := uint32(__v)
+	s.Radius = (__m)
+		}
+	return err
+}
+func (s Circle) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Circle by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Circle) CompareBorsh(other any) int {
+	o := other.(Circle)
+	_ = o
+	if c := borsh.Compare(s.Radius, o.Radius); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Circle in fields typed by an interface
+func (Circle) BorshTypeID() uint64 {
+	return 0x410dd53cf33a07f4
+}
+func init() {
+	Registry.Register(0x410dd53cf33a07f4, func() borsh.BorshEncoder { return new(Circle) })
+}
+
+// BorshSchema describes the Borsh encoding of Circle in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Circle) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Circle",
+		Definitions: map[string]borsh.Definition{
+			"Circle": borsh.StructDef(
+				borsh.Field{Name: "radius", Declaration: "u32"},),
+			"u32": borsh.PrimitiveDef(4),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Rect) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Rect) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Rect", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Rect to buf.
+// The FieldPath of a returned EncodeError is relative to Rect
+func (s Rect) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Rect) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Rect in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Rect) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Rect", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Rect in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Rect) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Rect", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Rect to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Rect) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Rect", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Rect to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Rect
+func (s Rect) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.W))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.H))
+		}
+	return nil
+}
+func (s *Rect) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Rect", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Rect like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Rect, without trailing bytes
+func (s *Rect) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Rect", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Rect in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Rect, so wrap unbuffered readers in a bufio.Reader
+func (s *Rect) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Rect", err)
+}
+
+// ReadBorsh decodes Rect nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Rect
+func (s *Rect) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Rect is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Rect
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError("W", err)
+	}
+	__m := uint16(__v)
+	s.W = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError("H", err)
+	}
+	__m := uint16(__v)
+	s.H = (__m)
+		}
+	return err
+}
+func (s Rect) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 2
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 2
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Rect by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Rect) CompareBorsh(other any) int {
+	o := other.(Rect)
+	_ = o
+	if c := borsh.Compare(s.W, o.W); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.H, o.H); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Rect in fields typed by an interface
+func (Rect) BorshTypeID() uint64 {
+	return 0x4576d63693454cb3
+}
+func init() {
+	Registry.Register(0x4576d63693454cb3, func() borsh.BorshEncoder { return new(Rect) })
+}
+
+// BorshSchema describes the Borsh encoding of Rect in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Rect) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Rect",
+		Definitions: map[string]borsh.Definition{
+			"Rect": borsh.StructDef(
+				borsh.Field{Name: "w", Declaration: "u16"},
+				borsh.Field{Name: "h", Declaration: "u16"},),
+			"u16": borsh.PrimitiveDef(2),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Enums) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Enums) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Enums", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Enums to buf.
+// The FieldPath of a returned EncodeError is relative to Enums
+func (s Enums) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Enums) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Enums in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Enums) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Enums", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Enums in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Enums) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Enums", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Enums to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Enums) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Enums", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Enums to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Enums
+func (s Enums) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum
+					if err := appendEnumShape(buf, s.First); err != nil {
+						return borsh.EncodeFieldError("First", err)
+					}
+		}
+		{
+		
+				// All (all) - slice
+				// ElementType: []Shape
+				// Type: []Shape
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of All: []All
+	if err := checkLength("slice", len((s.All)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("All", err)
+	}
+	 appendLength(buf, len((s.All)))
+		for i, item := range (s.All) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum
+					if err := appendEnumShape(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("All", i), err)
+					}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u8
+					if !(s.Color).Valid() {
+						return borsh.EncodeFieldError("Color", fmt.Errorf("invalid enum value %d", s.Color))
+					}
+					buf.WriteByte(byte(s.Color))
+		}
+	return nil
+}
+func (s *Enums) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Enums", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Enums like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Enums, without trailing bytes
+func (s *Enums) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Enums", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Enums in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Enums, so wrap unbuffered readers in a bufio.Reader
+func (s *Enums) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Enums", err)
+}
+
+// ReadBorsh decodes Enums nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Enums
+func (s *Enums) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Enums is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Enums
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	s.First, err = readEnumShape(r, depth+1)
+	if err != nil {
+		return r.FieldError("First", err)
+	}
+		}
+		{
+		
+				// All (all) - slice
+				// ElementType: []Shape
+				// Type: []Shape
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of All: []All
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("All", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Shape](r, length)
+		if err != nil {
+			return r.FieldError("All", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum
+			// Element Shape
+	p[i], err = readEnumShape(r, depth+1)
+	if err != nil {
+		return r.FieldError(borsh.Index("All", i), err)
+	}
+		}
+				s.All =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Color", err)
+	}
+	__m := Color(__v)
+	if !__m.Valid() {
+		return r.FieldError("Color", fmt.Errorf("invalid Color value %d", __m))
+	}
+	s.Color = (__m)
+		}
+	return err
+}
+func (s Enums) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				{
+					_s, err := sizeEnumShape(s.First)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("First", err)
+					}
+					size += _s
+				}
+		}
+		{
+		
+				// All (all) - slice
+				// ElementType: []Shape
+				// Type: []Shape
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of All: []All
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.All) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum
+			// Element Shape
+				{
+					_s, err := sizeEnumShape(item)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("All", err)
+					}
+					size += _s
+				}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Enums by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Enums) CompareBorsh(other any) int {
+	o := other.(Enums)
+	_ = o
+	if c := borsh.Compare(s.First, o.First); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.All, o.All); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Color, o.Color); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Enums in fields typed by an interface
+func (Enums) BorshTypeID() uint64 {
+	return 0xf31a8e2e89defe10
+}
+func init() {
+	Registry.Register(0xf31a8e2e89defe10, func() borsh.BorshEncoder { return new(Enums) })
+}
+
+// BorshSchema describes the Borsh encoding of Enums in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Enums) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Enums",
+		Definitions: map[string]borsh.Definition{
+			"Circle": borsh.StructDef(
+				borsh.Field{Name: "radius", Declaration: "u32"},),
+			"Color": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "ColorRed", Declaration: "ColorRed"},
+				borsh.Variant{Discriminant: 1, Name: "ColorGreen", Declaration: "ColorGreen"},
+				borsh.Variant{Discriminant: 2, Name: "ColorBlue", Declaration: "ColorBlue"}),
+			"ColorBlue": borsh.StructDef(),
+			"ColorGreen": borsh.StructDef(),
+			"ColorRed": borsh.StructDef(),
+			"Enums": borsh.StructDef(
+				borsh.Field{Name: "first", Declaration: "Shape"},
+				borsh.Field{Name: "all", Declaration: "Vec<Shape>"},
+				borsh.Field{Name: "color", Declaration: "Color"},),
+			"Rect": borsh.StructDef(
+				borsh.Field{Name: "w", Declaration: "u16"},
+				borsh.Field{Name: "h", Declaration: "u16"},),
+			"Shape": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "Circle", Declaration: "Circle"},
+				borsh.Variant{Discriminant: 1, Name: "Rect", Declaration: "Rect"}),
+			"Vec<Shape>": borsh.SequenceDef(4, 0, 4294967295, "Shape"),
+			"u16": borsh.PrimitiveDef(2),
+			"u32": borsh.PrimitiveDef(4),
+		},
+	}
+}
+
+// appendEnumShape writes the variant index of v followed by the variant
+func appendEnumShape(buf *borsh.Writer, v Shape) error {
+	var err error
+	switch x := v.(type) {
+	case Circle:
+		buf.WriteByte(0)
+		err = writeNested(buf, x)
+	case *Circle:
+		if x == nil {
+			return fmt.Errorf("nil Shape variant Circle")
+		}
+		buf.WriteByte(0)
+		err = writeNested(buf, x)
+	case Rect:
+		buf.WriteByte(1)
+		err = writeNested(buf, x)
+	case *Rect:
+		if x == nil {
+			return fmt.Errorf("nil Shape variant Rect")
+		}
+		buf.WriteByte(1)
+		err = writeNested(buf, x)
+	case nil:
+		return fmt.Errorf("nil Shape")
+	default:
+		return fmt.Errorf("unknown Shape variant %T", v)
+	}
+	return err
+}
+
+// readEnumShape reads the variant index and decodes the matching variant at depth
+func readEnumShape(r *borsh.Reader, depth int) (Shape, error) {
+	index, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch index {
+	case 0:
+		m := &Circle{}
+		if err := readNested(r, m, depth); err != nil {
+			return nil, err
+		}
+		return *m, nil
+	case 1:
+		m := &Rect{}
+		if err := readNested(r, m, depth); err != nil {
+			return nil, err
+		}
+		return *m, nil
+	default:
+		return nil, r.Errorf(borsh.UnknownVariant, "unknown Shape variant index %d", index)
+	}
+}
+
+// sizeEnumShape returns the encoded size of v including the variant index
+func sizeEnumShape(v Shape) (int, error) {
+	var (
+		size int
+		err  error
+	)
+	switch x := v.(type) {
+	case Circle:
+		size, err = x.BinarySize()
+	case *Circle:
+		if x == nil {
+			return 0, fmt.Errorf("nil Shape variant Circle")
+		}
+		size, err = x.BinarySize()
+	case Rect:
+		size, err = x.BinarySize()
+	case *Rect:
+		if x == nil {
+			return 0, fmt.Errorf("nil Shape variant Rect")
+		}
+		size, err = x.BinarySize()
+	case nil:
+		return 0, fmt.Errorf("nil Shape")
+	default:
+		return 0, fmt.Errorf("unknown Shape variant %T", v)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return 1 + NestedPrefixSize + size, nil
+}
+
+// MarshalShape encodes v as a Borsh enum: a 1-byte variant index followed by the variant
+func MarshalShape(v Shape) ([]byte, error) {
+	size, err := sizeEnumShape(v)
+	if err != nil {
+		return nil, borsh.EncodeFieldError("Shape", err)
+	}
+	buf := borsh.NewBufferWriter(make([]byte, 0, size))
+	if err := appendEnumShape(buf, v); err != nil {
+		return nil, borsh.EncodeFieldError("Shape", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalShape decodes a Borsh enum into the Shape variant selected by its index
+func UnmarshalShape(data []byte) (Shape, error) {
+	r := newReader(data, StrictDecoding)
+	v, err := readEnumShape(r, 0)
+	if err == nil && StrictDecoding && len(r.Remaining()) > 0 {
+		err = r.Errorf(borsh.TrailingBytes, "%d bytes after the value", len(r.Remaining()))
+	}
+	if err != nil {
+		return nil, r.FieldError("Shape", err)
+	}
+	return v, nil
+}
+
+// ShapeBinarySize returns the encoded size of v
+func ShapeBinarySize(v Shape) (int, error) {
+	size, err := sizeEnumShape(v)
+	if err != nil {
+		return 0, borsh.EncodeFieldError("Shape", err)
+	}
+	return size, nil
+}
+
+// String returns the name of the Color constant
+func (v Color) String() string {
+	switch v {
+	case ColorRed:
+		return "ColorRed"
+	case ColorGreen:
+		return "ColorGreen"
+	case ColorBlue:
+		return "ColorBlue"
+	}
+	return fmt.Sprintf("Color(%d)", v)
+}
+
+// Valid reports whether v is one of the declared Color constants
+func (v Color) Valid() bool {
+	switch v {
+	case ColorRed, ColorGreen, ColorBlue:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,1324 @@
+package imported
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Offer) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Offer) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Offer", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Offer to buf.
+// The FieldPath of a returned EncodeError is relative to Offer
+func (s Offer) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Offer) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Offer in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Offer) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Offer", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Offer in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Offer) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Offer", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Offer to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Offer) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Offer", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Offer to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Offer
+func (s Offer) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorOffer)
+		{
+		
+				// Maker (maker) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [32]Maker
+	for i := 0; i < 32; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Maker[i]))
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.OfferId))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u8
+					if !(s.Status).Valid() {
+						return borsh.EncodeFieldError("Status", fmt.Errorf("invalid enum value %d", s.Status))
+					}
+					buf.WriteByte(byte(s.Status))
+		}
+		{
+		
+				// Legs (legs) - slice
+				// ElementType: []Leg
+				// Type: []Leg
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Legs: []Legs
+	if err := checkLength("slice", len((s.Legs)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Legs", err)
+	}
+	 appendLength(buf, len((s.Legs)))
+		for i, item := range (s.Legs) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Mint [32]byte "msg:\"mint\""; Amount uint64 "msg:\"amount\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Legs", i), err)
+					}
+		}
+		}
+		{
+			if s.ExpiresAt == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPExpiresAt
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// ExpiresAt (expiresAt) - Pointer
+					// ElementType: int64
+					// Type: int64
+					// ActualType: *int64
+					// BasicType: true
+			
+					// ElementType: int64
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(*s.ExpiresAt))
+		}
+					SKIPExpiresAt:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(s.Price)
+		}
+	return nil
+}
+func (s *Offer) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Offer", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Offer like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Offer, without trailing bytes
+func (s *Offer) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Offer", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Offer in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Offer, so wrap unbuffered readers in a bufio.Reader
+func (s *Offer) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Offer", err)
+}
+
+// ReadBorsh decodes Offer nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Offer
+func (s *Offer) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Offer is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorOffer); err != nil {
+		return err
+	}
+	
+	// FIELDS: Offer
+    var err error
+		{
+		
+				// Maker (maker) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [32]byte{}
+	length := 32
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Maker", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Maker = (p)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("OfferId", err)
+	}
+	__m := uint64(__v)
+	s.OfferId = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Status", err)
+	}
+	__m := OfferStatus(__v)
+	if !__m.Valid() {
+		return r.FieldError("Status", fmt.Errorf("invalid Status value %d", __m))
+	}
+	s.Status = (__m)
+		}
+		{
+		
+				// Legs (legs) - slice
+				// ElementType: []Leg
+				// Type: []Leg
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Legs: []Legs
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Legs", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Leg](r, length)
+		if err != nil {
+			return r.FieldError("Legs", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Mint [32]byte "msg:\"mint\""; Amount uint64 "msg:\"amount\""}
+			// Element Leg
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Leg{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Legs", i), err)
+					}
+						p[i] = *m
+		}
+				s.Legs =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("ExpiresAt", err)
+				}
+				if !present {
+					s.ExpiresAt = nil
+					goto SKIPExpiresAt
+				} 
+			}
+		{
+		
+					// ExpiresAt (expiresAt) - Pointer
+					// ElementType: int64
+					// Type: int64
+					// ActualType: *int64
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: int64
+					// IsPointer: true
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("ExpiresAt", err)
+	}
+	__m := int64(__v)
+	s.ExpiresAt = &(__m)
+		}
+					SKIPExpiresAt:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadFloat64()
+	if err != nil {
+		return r.FieldError("Price", err)
+	}
+	__m := float64(__v)
+	s.Price = (__m)
+		}
+	return err
+}
+func (s Offer) BinarySize() (int, error) {
+	size := 8
+		{
+		
+				// Maker (maker) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [32]Maker
+	for _, item := range  (s.Maker) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+				// Legs (legs) - slice
+				// ElementType: []Leg
+				// Type: []Leg
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Legs: []Legs
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Legs) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Mint [32]byte "msg:\"mint\""; Amount uint64 "msg:\"amount\""}
+			// Element Leg
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Legs", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+		{
+			size++
+			if s.ExpiresAt == nil {
+				goto SKIPExpiresAt
+			}
+		}
+		{
+		
+					// ExpiresAt (expiresAt) - Pointer
+					// ElementType: int64
+					// Type: int64
+					// ActualType: *int64
+					// BasicType: true
+			
+					// ElementType: int64
+	
+		// ElementType: int64
+		
+			// Element: true
+				size += 8
+		}
+			SKIPExpiresAt:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Offer by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Offer) CompareBorsh(other any) int {
+	o := other.(Offer)
+	_ = o
+	if c := borsh.Compare(s.Maker, o.Maker); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.OfferId, o.OfferId); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Status, o.Status); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Legs, o.Legs); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.ExpiresAt, o.ExpiresAt); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Price, o.Price); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorOffer starts the encoding of Offer
+var discriminatorOffer = []byte{0xd7, 0x58, 0x3c, 0x47, 0xaa, 0xa2, 0x49, 0xe5}
+
+// BorshDiscriminator returns the bytes that start the encoding of Offer
+func (Offer) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorOffer)
+}
+
+// BorshTypeID returns the type ID written before Offer in fields typed by an interface
+func (Offer) BorshTypeID() uint64 {
+	return 0x2b87b442d1b019a6
+}
+func init() {
+	Registry.Register(0x2b87b442d1b019a6, func() borsh.BorshEncoder { return new(Offer) })
+	Registry.RegisterDiscriminator(discriminatorOffer, func() borsh.BorshEncoder { return new(Offer) })
+}
+
+// BorshSchema describes the Borsh encoding of Offer in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Offer) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Offer",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Leg": borsh.StructDef(
+				borsh.Field{Name: "mint", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"Offer": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "maker", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "offerId", Declaration: "u64"},
+				borsh.Field{Name: "status", Declaration: "OfferStatus"},
+				borsh.Field{Name: "legs", Declaration: "Vec<Leg>"},
+				borsh.Field{Name: "expiresAt", Declaration: "Option<i64>"},
+				borsh.Field{Name: "price", Declaration: "f64"},),
+			"OfferStatus": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "OfferStatusOpen", Declaration: "OfferStatusOpen"},
+				borsh.Variant{Discriminant: 1, Name: "OfferStatusFilled", Declaration: "OfferStatusFilled"},
+				borsh.Variant{Discriminant: 2, Name: "OfferStatusCancelled", Declaration: "OfferStatusCancelled"}),
+			"OfferStatusCancelled": borsh.StructDef(),
+			"OfferStatusFilled": borsh.StructDef(),
+			"OfferStatusOpen": borsh.StructDef(),
+			"Option<i64>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "i64"}),
+			"Vec<Leg>": borsh.SequenceDef(4, 0, 4294967295, "Leg"),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"f64": borsh.PrimitiveDef(8),
+			"i64": borsh.PrimitiveDef(8),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Leg) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Leg) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Leg", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Leg to buf.
+// The FieldPath of a returned EncodeError is relative to Leg
+func (s Leg) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Leg) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Leg in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Leg) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Leg", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Leg in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Leg) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Leg", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Leg to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Leg) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Leg", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Leg to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Leg
+func (s Leg) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Mint (mint) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [32]Mint
+	for i := 0; i < 32; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Mint[i]))
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Amount))
+		}
+	return nil
+}
+func (s *Leg) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Leg", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Leg like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Leg, without trailing bytes
+func (s *Leg) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Leg", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Leg in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Leg, so wrap unbuffered readers in a bufio.Reader
+func (s *Leg) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Leg", err)
+}
+
+// ReadBorsh decodes Leg nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Leg
+func (s *Leg) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Leg is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Leg
+    var err error
+		{
+		
+				// Mint (mint) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [32]byte{}
+	length := 32
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Mint", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Mint = (p)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+	__m := uint64(__v)
+	s.Amount = (__m)
+		}
+	return err
+}
+func (s Leg) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Mint (mint) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [32]Mint
+	for _, item := range  (s.Mint) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Leg by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Leg) CompareBorsh(other any) int {
+	o := other.(Leg)
+	_ = o
+	if c := borsh.Compare(s.Mint, o.Mint); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Leg in fields typed by an interface
+func (Leg) BorshTypeID() uint64 {
+	return 0xd31788d784442773
+}
+func init() {
+	Registry.Register(0xd31788d784442773, func() borsh.BorshEncoder { return new(Leg) })
+}
+
+// BorshSchema describes the Borsh encoding of Leg in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Leg) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Leg",
+		Definitions: map[string]borsh.Definition{
+			"Leg": borsh.StructDef(
+				borsh.Field{Name: "mint", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s OfferMade) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s OfferMade) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("OfferMade", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of OfferMade to buf.
+// The FieldPath of a returned EncodeError is relative to OfferMade
+func (s OfferMade) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s OfferMade) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends OfferMade in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s OfferMade) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("OfferMade", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes OfferMade in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s OfferMade) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "OfferMade", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes OfferMade to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s OfferMade) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("OfferMade", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes OfferMade to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to OfferMade
+func (s OfferMade) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorOfferMade)
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.OfferId))
+		}
+		{
+		
+				// Maker (maker) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [32]Maker
+	for i := 0; i < 32; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Maker[i]))
+	}
+		}
+	return nil
+}
+func (s *OfferMade) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("OfferMade", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to OfferMade like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of OfferMade, without trailing bytes
+func (s *OfferMade) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("OfferMade", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads OfferMade in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of OfferMade, so wrap unbuffered readers in a bufio.Reader
+func (s *OfferMade) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("OfferMade", err)
+}
+
+// ReadBorsh decodes OfferMade nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to OfferMade
+func (s *OfferMade) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "OfferMade is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorOfferMade); err != nil {
+		return err
+	}
+	
+	// FIELDS: OfferMade
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("OfferId", err)
+	}
+	__m := uint64(__v)
+	s.OfferId = (__m)
+		}
+		{
+		
+				// Maker (maker) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [32]byte{}
+	length := 32
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Maker", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Maker = (p)
+		}
+	return err
+}
+func (s OfferMade) BinarySize() (int, error) {
+	size := 8
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+				// Maker (maker) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [32]Maker
+	for _, item := range  (s.Maker) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders OfferMade by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s OfferMade) CompareBorsh(other any) int {
+	o := other.(OfferMade)
+	_ = o
+	if c := borsh.Compare(s.OfferId, o.OfferId); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Maker, o.Maker); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorOfferMade starts the encoding of OfferMade
+var discriminatorOfferMade = []byte{0x0b, 0x05, 0x56, 0xd2, 0x03, 0x04, 0x19, 0x9a}
+
+// BorshDiscriminator returns the bytes that start the encoding of OfferMade
+func (OfferMade) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorOfferMade)
+}
+
+// BorshTypeID returns the type ID written before OfferMade in fields typed by an interface
+func (OfferMade) BorshTypeID() uint64 {
+	return 0x6679b691013aea07
+}
+func init() {
+	Registry.Register(0x6679b691013aea07, func() borsh.BorshEncoder { return new(OfferMade) })
+	Registry.RegisterDiscriminator(discriminatorOfferMade, func() borsh.BorshEncoder { return new(OfferMade) })
+}
+
+// BorshSchema describes the Borsh encoding of OfferMade in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (OfferMade) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "OfferMade",
+		Definitions: map[string]borsh.Definition{
+			"OfferMade": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "offerId", Declaration: "u64"},
+				borsh.Field{Name: "maker", Declaration: "[u8; 32]"},),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s MakeOfferArgs) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s MakeOfferArgs) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("MakeOfferArgs", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of MakeOfferArgs to buf.
+// The FieldPath of a returned EncodeError is relative to MakeOfferArgs
+func (s MakeOfferArgs) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s MakeOfferArgs) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends MakeOfferArgs in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s MakeOfferArgs) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("MakeOfferArgs", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes MakeOfferArgs in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s MakeOfferArgs) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "MakeOfferArgs", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes MakeOfferArgs to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s MakeOfferArgs) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("MakeOfferArgs", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes MakeOfferArgs to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to MakeOfferArgs
+func (s MakeOfferArgs) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorMakeOfferArgs)
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.OfferId))
+		}
+		{
+		
+				// Wanted (wanted) - slice
+				// ElementType: []Leg
+				// Type: []Leg
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Wanted: []Wanted
+	if err := checkLength("slice", len((s.Wanted)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Wanted", err)
+	}
+	 appendLength(buf, len((s.Wanted)))
+		for i, item := range (s.Wanted) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Mint [32]byte "msg:\"mint\""; Amount uint64 "msg:\"amount\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Wanted", i), err)
+					}
+		}
+		}
+	return nil
+}
+func (s *MakeOfferArgs) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("MakeOfferArgs", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to MakeOfferArgs like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of MakeOfferArgs, without trailing bytes
+func (s *MakeOfferArgs) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("MakeOfferArgs", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads MakeOfferArgs in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of MakeOfferArgs, so wrap unbuffered readers in a bufio.Reader
+func (s *MakeOfferArgs) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("MakeOfferArgs", err)
+}
+
+// ReadBorsh decodes MakeOfferArgs nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to MakeOfferArgs
+func (s *MakeOfferArgs) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "MakeOfferArgs is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorMakeOfferArgs); err != nil {
+		return err
+	}
+	
+	// FIELDS: MakeOfferArgs
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("OfferId", err)
+	}
+	__m := uint64(__v)
+	s.OfferId = (__m)
+		}
+		{
+		
+				// Wanted (wanted) - slice
+				// ElementType: []Leg
+				// Type: []Leg
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Wanted: []Wanted
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Wanted", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Leg](r, length)
+		if err != nil {
+			return r.FieldError("Wanted", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Mint [32]byte "msg:\"mint\""; Amount uint64 "msg:\"amount\""}
+			// Element Leg
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Leg{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Wanted", i), err)
+					}
+						p[i] = *m
+		}
+				s.Wanted =  p
+		}
+	return err
+}
+func (s MakeOfferArgs) BinarySize() (int, error) {
+	size := 8
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+				// Wanted (wanted) - slice
+				// ElementType: []Leg
+				// Type: []Leg
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Wanted: []Wanted
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Wanted) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Mint [32]byte "msg:\"mint\""; Amount uint64 "msg:\"amount\""}
+			// Element Leg
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Wanted", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders MakeOfferArgs by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s MakeOfferArgs) CompareBorsh(other any) int {
+	o := other.(MakeOfferArgs)
+	_ = o
+	if c := borsh.Compare(s.OfferId, o.OfferId); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Wanted, o.Wanted); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorMakeOfferArgs starts the encoding of MakeOfferArgs
+var discriminatorMakeOfferArgs = []byte{0xd6, 0x62, 0x61, 0x23, 0x3b, 0x0c, 0x2c, 0xb2}
+
+// BorshDiscriminator returns the bytes that start the encoding of MakeOfferArgs
+func (MakeOfferArgs) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorMakeOfferArgs)
+}
+
+// BorshTypeID returns the type ID written before MakeOfferArgs in fields typed by an interface
+func (MakeOfferArgs) BorshTypeID() uint64 {
+	return 0xc2f8cf1ab5250c6e
+}
+func init() {
+	Registry.Register(0xc2f8cf1ab5250c6e, func() borsh.BorshEncoder { return new(MakeOfferArgs) })
+	Registry.RegisterDiscriminator(discriminatorMakeOfferArgs, func() borsh.BorshEncoder { return new(MakeOfferArgs) })
+}
+
+// BorshSchema describes the Borsh encoding of MakeOfferArgs in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (MakeOfferArgs) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "MakeOfferArgs",
+		Definitions: map[string]borsh.Definition{
+			"Leg": borsh.StructDef(
+				borsh.Field{Name: "mint", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "amount", Declaration: "u64"},),
+			"MakeOfferArgs": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "offerId", Declaration: "u64"},
+				borsh.Field{Name: "wanted", Declaration: "Vec<Leg>"},),
+			"Vec<Leg>": borsh.SequenceDef(4, 0, 4294967295, "Leg"),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+// String returns the name of the OfferStatus constant
+func (v OfferStatus) String() string {
+	switch v {
+	case OfferStatusOpen:
+		return "OfferStatusOpen"
+	case OfferStatusFilled:
+		return "OfferStatusFilled"
+	case OfferStatusCancelled:
+		return "OfferStatusCancelled"
+	}
+	return fmt.Sprintf("OfferStatus(%d)", v)
+}
+
+// Valid reports whether v is one of the declared OfferStatus constants
+func (v OfferStatus) Valid() bool {
+	switch v {
+	case OfferStatusOpen, OfferStatusFilled, OfferStatusCancelled:
+		return true
+	}
+	return false
+}
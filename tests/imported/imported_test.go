@@ -0,0 +1,115 @@
+package imported
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+type schemer interface {
+	BorshSchema() *borsh.Schema
+}
+
+// TestImportedSchemas checks that the types imported from the schema of tests/conformance describe
+// themselves with the same schema
+func TestImportedSchemas(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "conformance.schema.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []borsh.Schema
+	if err := json.Unmarshal(data, &want); err != nil {
+		t.Fatal(err)
+	}
+	types := map[string]schemer{
+		"Primitives": Primitives{}, "Floats": Floats{}, "Strings": Strings{}, "Arrays": Arrays{},
+		"Vectors": Vectors{}, "Point": Point{}, "Options": Options{}, "Nested": Nested{},
+		"Maps": Maps{}, "Sets": Sets{}, "Circle": Circle{}, "Rect": Rect{}, "Enums": Enums{},
+	}
+	for _, schema := range want {
+		value, ok := types[schema.Declaration]
+		if !ok {
+			continue
+		}
+		delete(types, schema.Declaration)
+		wantJSON, _ := json.Marshal(schema)
+		gotJSON, err := json.Marshal(value.BorshSchema())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(gotJSON, wantJSON) {
+			t.Errorf("%s: schema\n%s\nwant\n%s", schema.Declaration, gotJSON, wantJSON)
+		}
+	}
+	for name := range types {
+		t.Errorf("%s is not in the schema", name)
+	}
+}
+
+// TestImportedAnchor checks the bytes of instruction arguments imported from an Anchor IDL, which
+// start with the discriminator of the instruction
+func TestImportedAnchor(t *testing.T) {
+	validator := [32]byte{31: 9}
+	args := DepositArgs{Amount: 5, Action: ActionStake{Validator: validator, Epochs: 0x0102}}
+	deposit := []byte{0xf2, 0x23, 0xc6, 0x89, 0x52, 0xe1, 0xf2, 0xb6} // global:deposit
+	want := append(slices.Clone(deposit), 5, 0, 0, 0, 0, 0, 0, 0, 1)
+	want = append(want, validator[:]...)
+	want = append(want, 0x02, 0x01)
+
+	data, err := args.MarshalBorsh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %x, want %x", data, want)
+	}
+	var got DepositArgs
+	if err := got.UnmarshalBorsh(data); err != nil {
+		t.Fatal(err)
+	}
+	if got != args {
+		t.Fatalf("UnmarshalBorsh() = %+v, want %+v", got, args)
+	}
+
+	hold, err := DepositArgs{Amount: 1, Action: ActionHold{}}.MarshalBorsh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := append(slices.Clone(deposit), 1, 0, 0, 0, 0, 0, 0, 0, 0); !bytes.Equal(hold, want) {
+		t.Fatalf("MarshalBorsh() = %x, want %x", hold, want)
+	}
+}
+
+// TestImportedDiscriminators checks that the accounts, events and instructions of an IDL decode by
+// the discriminators it lists, including the one-byte discriminator of Config
+func TestImportedDiscriminators(t *testing.T) {
+	values := []borsh.BorshEncoder{
+		&Vault{Bump: 1, Tags: []string{"a"}, History: []Deposit{{Slot: 2}}, Memo: []byte{3}},
+		&Config{Admin: [32]byte{1}},
+		&Deposited{Amount: 3, Action: ActionHold{}},
+		&InitializeArgs{},
+		&MakeOfferArgs{OfferId: 4, Wanted: []Leg{}},
+	}
+	for _, v := range values {
+		data, err := v.MarshalBorsh()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Registry.Decode(data)
+		if err != nil {
+			t.Fatalf("Registry.Decode() of %T failed: %v", v, err)
+		}
+		if !reflect.DeepEqual(got, v) {
+			t.Errorf("Registry.Decode() = %#v, want %#v", got, v)
+		}
+	}
+	if got := (Config{}).BorshDiscriminator(); !bytes.Equal(got, []byte{1}) {
+		t.Errorf("Config.BorshDiscriminator() = %x, want 01", got)
+	}
+}
@@ -0,0 +1,2856 @@
+package imported
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Vault) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Vault) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Vault", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Vault to buf.
+// The FieldPath of a returned EncodeError is relative to Vault
+func (s Vault) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Vault) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Vault in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Vault) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Vault", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Vault in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Vault) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Vault", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Vault to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Vault) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Vault", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Vault to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Vault
+func (s Vault) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorVault)
+		{
+		
+				// Owner (owner) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [32]Owner
+	for i := 0; i < 32; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Owner[i]))
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.Bump))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Balance))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint128
+					_v128 := s.TotalIn
+					buf.Write(_v128[:])
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Label
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Label", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+			if s.Delegate == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPDelegate
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+				// Delegate (delegate) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [32]Delegate
+	for i := 0; i < 32; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Delegate[i]))
+	}
+		}
+					SKIPDelegate:
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	if err := checkLength("slice", len((s.Tags)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Tags", err)
+	}
+	 appendLength(buf, len((s.Tags)))
+		for i, item := range (s.Tags) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Tags", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u8
+					if !(s.Side).Valid() {
+						return borsh.EncodeFieldError("Side", fmt.Errorf("invalid enum value %d", s.Side))
+					}
+					buf.WriteByte(byte(s.Side))
+		}
+		{
+		
+				// History (history) - slice
+				// ElementType: []Deposit
+				// Type: []Deposit
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	if err := checkLength("slice", len((s.History)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("History", err)
+	}
+	 appendLength(buf, len((s.History)))
+		for i, item := range (s.History) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Slot uint64 "msg:\"slot\""; Amount int64 "msg:\"amount\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("History", i), err)
+					}
+		}
+		}
+		{
+		
+				// Limits (limits) - slice
+				// ElementType: [3]uint32
+				// Type: [3]uint32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [3]Limits
+	for i := 0; i < 3; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(s.Limits[i]))
+	}
+		}
+		{
+		
+				// Memo (memo) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+	data, err := _DefaultByteArrayEncoder.MarshalBorsh((s.Memo), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("Memo", err)
+		}
+		 appendBytes(buf, data)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.Rate))
+		}
+	return nil
+}
+func (s *Vault) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Vault", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Vault like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Vault, without trailing bytes
+func (s *Vault) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Vault", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Vault in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Vault, so wrap unbuffered readers in a bufio.Reader
+func (s *Vault) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Vault", err)
+}
+
+// ReadBorsh decodes Vault nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Vault
+func (s *Vault) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Vault is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorVault); err != nil {
+		return err
+	}
+	
+	// FIELDS: Vault
+    var err error
+		{
+		
+				// Owner (owner) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [32]byte{}
+	length := 32
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Owner", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Owner = (p)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Bump", err)
+	}
+	__m := uint8(__v)
+	s.Bump = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Balance", err)
+	}
+	__m := uint64(__v)
+	s.Balance = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError("TotalIn", err)
+	}
+	__m := borsh.Uint128{}
+	copy(__m[:], __b)
+	s.TotalIn = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Label", err)
+	}
+	__m := string(__s)
+	s.Label = (__m)
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Delegate", err)
+				}
+				if !present {
+					s.Delegate = nil
+					goto SKIPDelegate
+				} 
+			}
+		{
+		
+				// Delegate (delegate) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [32]byte{}
+	length := 32
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Delegate", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (&p)
+		s.Delegate = (&p)
+		}
+					SKIPDelegate:
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Tags", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]string](r, length)
+		if err != nil {
+			return r.FieldError("Tags", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Tags", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+		}
+				s.Tags =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Side", err)
+	}
+	__m := Side(__v)
+	if !__m.Valid() {
+		return r.FieldError("Side", fmt.Errorf("invalid Side value %d", __m))
+	}
+	s.Side = (__m)
+		}
+		{
+		
+				// History (history) - slice
+				// ElementType: []Deposit
+				// Type: []Deposit
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("History", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Deposit](r, length)
+		if err != nil {
+			return r.FieldError("History", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Slot uint64 "msg:\"slot\""; Amount int64 "msg:\"amount\""}
+			// Element Deposit
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Deposit{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("History", i), err)
+					}
+						p[i] = *m
+		}
+				s.History =  p
+		}
+		{
+		
+				// Limits (limits) - slice
+				// ElementType: [3]uint32
+				// Type: [3]uint32
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [3]uint32{}
+	length := 3
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError(borsh.Index("Limits", i), err)
+	}
+	__m := uint32(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Limits = (p)
+		}
+		{
+		
+				// Memo (memo) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+			itemData, err := readByteSlice(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Memo", err)
+			}
+			if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+				return r.FieldError("Memo", err)
+			} else {
+				_m := (_v).([]byte)
+				s.Memo = _m
+			}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError("Rate", err)
+	}
+	__m := uint16(__v)
+	s.Rate = (__m)
+		}
+	return err
+}
+func (s Vault) BinarySize() (int, error) {
+	size := 8
+		{
+		
+				// Owner (owner) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [32]Owner
+	for _, item := range  (s.Owner) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Label
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+			size++
+			if s.Delegate == nil {
+				goto SKIPDelegate
+			}
+		}
+		{
+		
+				// Delegate (delegate) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [32]Delegate
+	for _, item := range  *(s.Delegate) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+			SKIPDelegate:
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Tags) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+				// History (history) - slice
+				// ElementType: []Deposit
+				// Type: []Deposit
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.History) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Slot uint64 "msg:\"slot\""; Amount int64 "msg:\"amount\""}
+			// Element Deposit
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("History", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+		{
+		
+				// Limits (limits) - slice
+				// ElementType: [3]uint32
+				// Type: [3]uint32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [3]Limits
+	for _, item := range  (s.Limits) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+				size += 4
+	}
+		}
+		{
+		
+				// Memo (memo) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+
+// Var s.Memo
+		_s, err := _DefaultByteArrayEncoder.BinarySize(s.Memo, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder s.Memo: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 2
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Vault by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Vault) CompareBorsh(other any) int {
+	o := other.(Vault)
+	_ = o
+	if c := borsh.Compare(s.Owner, o.Owner); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Bump, o.Bump); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Balance, o.Balance); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.TotalIn, o.TotalIn); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Label, o.Label); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Delegate, o.Delegate); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Tags, o.Tags); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Side, o.Side); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.History, o.History); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Limits, o.Limits); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Memo, o.Memo); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Rate, o.Rate); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorVault starts the encoding of Vault
+var discriminatorVault = []byte{0xd3, 0x08, 0xe8, 0x2b, 0x02, 0x98, 0x75, 0x77}
+
+// BorshDiscriminator returns the bytes that start the encoding of Vault
+func (Vault) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorVault)
+}
+
+// BorshTypeID returns the type ID written before Vault in fields typed by an interface
+func (Vault) BorshTypeID() uint64 {
+	return 0xe426c7ab61359a96
+}
+func init() {
+	Registry.Register(0xe426c7ab61359a96, func() borsh.BorshEncoder { return new(Vault) })
+	Registry.RegisterDiscriminator(discriminatorVault, func() borsh.BorshEncoder { return new(Vault) })
+}
+
+// BorshSchema describes the Borsh encoding of Vault in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Vault) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Vault",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Deposit": borsh.StructDef(
+				borsh.Field{Name: "slot", Declaration: "u64"},
+				borsh.Field{Name: "amount", Declaration: "i64"},),
+			"Option<[u8; 32]>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "[u8; 32]"}),
+			"Side": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "SideBid", Declaration: "SideBid"},
+				borsh.Variant{Discriminant: 1, Name: "SideAsk", Declaration: "SideAsk"}),
+			"SideAsk": borsh.StructDef(),
+			"SideBid": borsh.StructDef(),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vault": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "owner", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "bump", Declaration: "u8"},
+				borsh.Field{Name: "balance", Declaration: "u64"},
+				borsh.Field{Name: "total_in", Declaration: "u128"},
+				borsh.Field{Name: "label", Declaration: "String"},
+				borsh.Field{Name: "delegate", Declaration: "Option<[u8; 32]>"},
+				borsh.Field{Name: "tags", Declaration: "Vec<String>"},
+				borsh.Field{Name: "side", Declaration: "Side"},
+				borsh.Field{Name: "history", Declaration: "Vec<Deposit>"},
+				borsh.Field{Name: "limits", Declaration: "[u32; 3]"},
+				borsh.Field{Name: "memo", Declaration: "Vec<u8>"},
+				borsh.Field{Name: "rate", Declaration: "u16"},),
+			"Vec<Deposit>": borsh.SequenceDef(4, 0, 4294967295, "Deposit"),
+			"Vec<String>": borsh.SequenceDef(4, 0, 4294967295, "String"),
+			"Vec<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"[u32; 3]": borsh.SequenceDef(0, 3, 3, "u32"),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"i64": borsh.PrimitiveDef(8),
+			"u128": borsh.PrimitiveDef(16),
+			"u16": borsh.PrimitiveDef(2),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Deposit) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Deposit) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Deposit", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Deposit to buf.
+// The FieldPath of a returned EncodeError is relative to Deposit
+func (s Deposit) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Deposit) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Deposit in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Deposit) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Deposit", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Deposit in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Deposit) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Deposit", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Deposit to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Deposit) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Deposit", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Deposit to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Deposit
+func (s Deposit) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Slot))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(s.Amount))
+		}
+	return nil
+}
+func (s *Deposit) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Deposit", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Deposit like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Deposit, without trailing bytes
+func (s *Deposit) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Deposit", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Deposit in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Deposit, so wrap unbuffered readers in a bufio.Reader
+func (s *Deposit) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Deposit", err)
+}
+
+// ReadBorsh decodes Deposit nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Deposit
+func (s *Deposit) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Deposit is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Deposit
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Slot", err)
+	}
+	__m := uint64(__v)
+	s.Slot = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+	__m := int64(__v)
+	s.Amount = (__m)
+		}
+	return err
+}
+func (s Deposit) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Deposit by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Deposit) CompareBorsh(other any) int {
+	o := other.(Deposit)
+	_ = o
+	if c := borsh.Compare(s.Slot, o.Slot); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Deposit in fields typed by an interface
+func (Deposit) BorshTypeID() uint64 {
+	return 0xee6ec6822b3592bb
+}
+func init() {
+	Registry.Register(0xee6ec6822b3592bb, func() borsh.BorshEncoder { return new(Deposit) })
+}
+
+// BorshSchema describes the Borsh encoding of Deposit in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Deposit) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Deposit",
+		Definitions: map[string]borsh.Definition{
+			"Deposit": borsh.StructDef(
+				borsh.Field{Name: "slot", Declaration: "u64"},
+				borsh.Field{Name: "amount", Declaration: "i64"},),
+			"i64": borsh.PrimitiveDef(8),
+			"u64": borsh.PrimitiveDef(8),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Config) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Config) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Config", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Config to buf.
+// The FieldPath of a returned EncodeError is relative to Config
+func (s Config) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Config) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Config in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Config) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Config", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Config in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Config) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Config", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Config to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Config) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Config", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Config to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Config
+func (s Config) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorConfig)
+		{
+		
+				// Admin (admin) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [32]Admin
+	for i := 0; i < 32; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Admin[i]))
+	}
+		}
+	return nil
+}
+func (s *Config) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Config", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Config like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Config, without trailing bytes
+func (s *Config) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Config", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Config in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Config, so wrap unbuffered readers in a bufio.Reader
+func (s *Config) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Config", err)
+}
+
+// ReadBorsh decodes Config nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Config
+func (s *Config) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Config is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorConfig); err != nil {
+		return err
+	}
+	
+	// FIELDS: Config
+    var err error
+		{
+		
+				// Admin (admin) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [32]byte{}
+	length := 32
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Admin", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Admin = (p)
+		}
+	return err
+}
+func (s Config) BinarySize() (int, error) {
+	size := 1
+		{
+		
+				// Admin (admin) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [32]Admin
+	for _, item := range  (s.Admin) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Config by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Config) CompareBorsh(other any) int {
+	o := other.(Config)
+	_ = o
+	if c := borsh.Compare(s.Admin, o.Admin); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorConfig starts the encoding of Config
+var discriminatorConfig = []byte{0x01}
+
+// BorshDiscriminator returns the bytes that start the encoding of Config
+func (Config) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorConfig)
+}
+
+// BorshTypeID returns the type ID written before Config in fields typed by an interface
+func (Config) BorshTypeID() uint64 {
+	return 0x9f10dfc2e772143f
+}
+func init() {
+	Registry.Register(0x9f10dfc2e772143f, func() borsh.BorshEncoder { return new(Config) })
+	Registry.RegisterDiscriminator(discriminatorConfig, func() borsh.BorshEncoder { return new(Config) })
+}
+
+// BorshSchema describes the Borsh encoding of Config in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Config) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Config",
+		Definitions: map[string]borsh.Definition{
+			"Config": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 1]"},
+				borsh.Field{Name: "admin", Declaration: "[u8; 32]"},),
+			"[u8; 1]": borsh.SequenceDef(0, 1, 1, "u8"),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Deposited) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Deposited) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Deposited", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Deposited to buf.
+// The FieldPath of a returned EncodeError is relative to Deposited
+func (s Deposited) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Deposited) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Deposited in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Deposited) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Deposited", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Deposited in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Deposited) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Deposited", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Deposited to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Deposited) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Deposited", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Deposited to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Deposited
+func (s Deposited) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorDeposited)
+		{
+		
+				// Vault (vault) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [32]Vault
+	for i := 0; i < 32; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Vault[i]))
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Amount))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum
+					if err := appendEnumAction(buf, s.Action); err != nil {
+						return borsh.EncodeFieldError("Action", err)
+					}
+		}
+	return nil
+}
+func (s *Deposited) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Deposited", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Deposited like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Deposited, without trailing bytes
+func (s *Deposited) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Deposited", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Deposited in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Deposited, so wrap unbuffered readers in a bufio.Reader
+func (s *Deposited) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Deposited", err)
+}
+
+// ReadBorsh decodes Deposited nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Deposited
+func (s *Deposited) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Deposited is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorDeposited); err != nil {
+		return err
+	}
+	
+	// FIELDS: Deposited
+    var err error
+		{
+		
+				// Vault (vault) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [32]byte{}
+	length := 32
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Vault", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Vault = (p)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+	__m := uint64(__v)
+	s.Amount = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	s.Action, err = readEnumAction(r, depth+1)
+	if err != nil {
+		return r.FieldError("Action", err)
+	}
+		}
+	return err
+}
+func (s Deposited) BinarySize() (int, error) {
+	size := 8
+		{
+		
+				// Vault (vault) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [32]Vault
+	for _, item := range  (s.Vault) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				{
+					_s, err := sizeEnumAction(s.Action)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Action", err)
+					}
+					size += _s
+				}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Deposited by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Deposited) CompareBorsh(other any) int {
+	o := other.(Deposited)
+	_ = o
+	if c := borsh.Compare(s.Vault, o.Vault); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Action, o.Action); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorDeposited starts the encoding of Deposited
+var discriminatorDeposited = []byte{0x6f, 0x8d, 0x1a, 0x2d, 0xa1, 0x23, 0x64, 0x39}
+
+// BorshDiscriminator returns the bytes that start the encoding of Deposited
+func (Deposited) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorDeposited)
+}
+
+// BorshTypeID returns the type ID written before Deposited in fields typed by an interface
+func (Deposited) BorshTypeID() uint64 {
+	return 0xc346ac0500d237c9
+}
+func init() {
+	Registry.Register(0xc346ac0500d237c9, func() borsh.BorshEncoder { return new(Deposited) })
+	Registry.RegisterDiscriminator(discriminatorDeposited, func() borsh.BorshEncoder { return new(Deposited) })
+}
+
+// BorshSchema describes the Borsh encoding of Deposited in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Deposited) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Deposited",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Action": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "ActionHold", Declaration: "ActionHold"},
+				borsh.Variant{Discriminant: 1, Name: "ActionStake", Declaration: "ActionStake"},
+				borsh.Variant{Discriminant: 2, Name: "ActionSwap", Declaration: "ActionSwap"}),
+			"ActionHold": borsh.StructDef(),
+			"ActionStake": borsh.StructDef(
+				borsh.Field{Name: "validator", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "epochs", Declaration: "u16"},),
+			"ActionSwap": borsh.StructDef(
+				borsh.Field{Name: "0", Declaration: "u64"},
+				borsh.Field{Name: "1", Declaration: "Option<String>"},),
+			"Deposited": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "vault", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "amount", Declaration: "u64"},
+				borsh.Field{Name: "action", Declaration: "Action"},),
+			"Option<String>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "String"}),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"u16": borsh.PrimitiveDef(2),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s ActionHold) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s ActionHold) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("ActionHold", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of ActionHold to buf.
+// The FieldPath of a returned EncodeError is relative to ActionHold
+func (s ActionHold) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s ActionHold) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends ActionHold in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s ActionHold) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("ActionHold", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes ActionHold in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s ActionHold) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "ActionHold", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes ActionHold to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s ActionHold) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("ActionHold", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes ActionHold to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to ActionHold
+func (s ActionHold) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	return nil
+}
+func (s *ActionHold) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("ActionHold", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to ActionHold like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of ActionHold, without trailing bytes
+func (s *ActionHold) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("ActionHold", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads ActionHold in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of ActionHold, so wrap unbuffered readers in a bufio.Reader
+func (s *ActionHold) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("ActionHold", err)
+}
+
+// ReadBorsh decodes ActionHold nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to ActionHold
+func (s *ActionHold) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "ActionHold is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: ActionHold
+    var err error
+	return err
+}
+func (s ActionHold) BinarySize() (int, error) {
+	size := 0
+	return size, nil
+}
+
+// CompareBorsh orders ActionHold by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s ActionHold) CompareBorsh(other any) int {
+	o := other.(ActionHold)
+	_ = o
+	return 0
+}
+
+// BorshTypeID returns the type ID written before ActionHold in fields typed by an interface
+func (ActionHold) BorshTypeID() uint64 {
+	return 0x949ed9548e46101a
+}
+func init() {
+	Registry.Register(0x949ed9548e46101a, func() borsh.BorshEncoder { return new(ActionHold) })
+}
+
+// BorshSchema describes the Borsh encoding of ActionHold in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (ActionHold) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "ActionHold",
+		Definitions: map[string]borsh.Definition{
+			"ActionHold": borsh.StructDef(),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s ActionStake) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s ActionStake) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("ActionStake", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of ActionStake to buf.
+// The FieldPath of a returned EncodeError is relative to ActionStake
+func (s ActionStake) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s ActionStake) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends ActionStake in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s ActionStake) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("ActionStake", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes ActionStake in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s ActionStake) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "ActionStake", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes ActionStake to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s ActionStake) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("ActionStake", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes ActionStake to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to ActionStake
+func (s ActionStake) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Validator (validator) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [32]Validator
+	for i := 0; i < 32; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Validator[i]))
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.Epochs))
+		}
+	return nil
+}
+func (s *ActionStake) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("ActionStake", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to ActionStake like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of ActionStake, without trailing bytes
+func (s *ActionStake) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("ActionStake", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads ActionStake in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of ActionStake, so wrap unbuffered readers in a bufio.Reader
+func (s *ActionStake) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("ActionStake", err)
+}
+
+// ReadBorsh decodes ActionStake nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to ActionStake
+func (s *ActionStake) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "ActionStake is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: ActionStake
+    var err error
+		{
+		
+				// Validator (validator) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [32]byte{}
+	length := 32
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Validator", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Validator = (p)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError("Epochs", err)
+	}
+	__m := uint16(__v)
+	s.Epochs = (__m)
+		}
+	return err
+}
+func (s ActionStake) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Validator (validator) - slice
+				// ElementType: [32]byte
+				// Type: [32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [32]Validator
+	for _, item := range  (s.Validator) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 2
+		}
+	return size, nil
+}
+
+// CompareBorsh orders ActionStake by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s ActionStake) CompareBorsh(other any) int {
+	o := other.(ActionStake)
+	_ = o
+	if c := borsh.Compare(s.Validator, o.Validator); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Epochs, o.Epochs); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before ActionStake in fields typed by an interface
+func (ActionStake) BorshTypeID() uint64 {
+	return 0xc8774ea07e5c9c57
+}
+func init() {
+	Registry.Register(0xc8774ea07e5c9c57, func() borsh.BorshEncoder { return new(ActionStake) })
+}
+
+// BorshSchema describes the Borsh encoding of ActionStake in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (ActionStake) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "ActionStake",
+		Definitions: map[string]borsh.Definition{
+			"ActionStake": borsh.StructDef(
+				borsh.Field{Name: "validator", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "epochs", Declaration: "u16"},),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"u16": borsh.PrimitiveDef(2),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s ActionSwap) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s ActionSwap) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("ActionSwap", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of ActionSwap to buf.
+// The FieldPath of a returned EncodeError is relative to ActionSwap
+func (s ActionSwap) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s ActionSwap) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends ActionSwap in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s ActionSwap) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("ActionSwap", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes ActionSwap in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s ActionSwap) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "ActionSwap", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes ActionSwap to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s ActionSwap) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("ActionSwap", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes ActionSwap to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to ActionSwap
+func (s ActionSwap) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.F0))
+		}
+		{
+			if s.F1 == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPF1
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// F1 (1) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := *s.F1
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("F1", err)
+					}
+					appendString(buf, string(str))
+		}
+					SKIPF1:
+	return nil
+}
+func (s *ActionSwap) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("ActionSwap", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to ActionSwap like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of ActionSwap, without trailing bytes
+func (s *ActionSwap) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("ActionSwap", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads ActionSwap in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of ActionSwap, so wrap unbuffered readers in a bufio.Reader
+func (s *ActionSwap) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("ActionSwap", err)
+}
+
+// ReadBorsh decodes ActionSwap nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to ActionSwap
+func (s *ActionSwap) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "ActionSwap is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: ActionSwap
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("F0", err)
+	}
+	__m := uint64(__v)
+	s.F0 = (__m)
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("F1", err)
+				}
+				if !present {
+					s.F1 = nil
+					goto SKIPF1
+				} 
+			}
+		{
+		
+					// F1 (1) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: string
+					// IsPointer: true
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("F1", err)
+	}
+	__m := string(__s)
+	s.F1 = &(__m)
+		}
+					SKIPF1:
+	return err
+}
+func (s ActionSwap) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+			size++
+			if s.F1 == nil {
+				goto SKIPF1
+			}
+		}
+		{
+		
+					// F1 (1) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+		// ElementType: string
+		
+			// Element: true
+				ptr := *s.F1
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+			SKIPF1:
+	return size, nil
+}
+
+// CompareBorsh orders ActionSwap by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s ActionSwap) CompareBorsh(other any) int {
+	o := other.(ActionSwap)
+	_ = o
+	if c := borsh.Compare(s.F0, o.F0); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.F1, o.F1); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before ActionSwap in fields typed by an interface
+func (ActionSwap) BorshTypeID() uint64 {
+	return 0xc64b81e4c40b7d62
+}
+func init() {
+	Registry.Register(0xc64b81e4c40b7d62, func() borsh.BorshEncoder { return new(ActionSwap) })
+}
+
+// BorshSchema describes the Borsh encoding of ActionSwap in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (ActionSwap) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "ActionSwap",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"ActionSwap": borsh.StructDef(
+				borsh.Field{Name: "0", Declaration: "u64"},
+				borsh.Field{Name: "1", Declaration: "Option<String>"},),
+			"Option<String>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "String"}),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s InitializeArgs) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s InitializeArgs) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("InitializeArgs", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of InitializeArgs to buf.
+// The FieldPath of a returned EncodeError is relative to InitializeArgs
+func (s InitializeArgs) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s InitializeArgs) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends InitializeArgs in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s InitializeArgs) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("InitializeArgs", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes InitializeArgs in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s InitializeArgs) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "InitializeArgs", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes InitializeArgs to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s InitializeArgs) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("InitializeArgs", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes InitializeArgs to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to InitializeArgs
+func (s InitializeArgs) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorInitializeArgs)
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.Bump))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Label
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Label", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *InitializeArgs) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("InitializeArgs", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to InitializeArgs like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of InitializeArgs, without trailing bytes
+func (s *InitializeArgs) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("InitializeArgs", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads InitializeArgs in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of InitializeArgs, so wrap unbuffered readers in a bufio.Reader
+func (s *InitializeArgs) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("InitializeArgs", err)
+}
+
+// ReadBorsh decodes InitializeArgs nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to InitializeArgs
+func (s *InitializeArgs) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "InitializeArgs is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorInitializeArgs); err != nil {
+		return err
+	}
+	
+	// FIELDS: InitializeArgs
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Bump", err)
+	}
+	__m := uint8(__v)
+	s.Bump = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Label", err)
+	}
+	__m := string(__s)
+	s.Label = (__m)
+		}
+	return err
+}
+func (s InitializeArgs) BinarySize() (int, error) {
+	size := 8
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Label
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders InitializeArgs by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s InitializeArgs) CompareBorsh(other any) int {
+	o := other.(InitializeArgs)
+	_ = o
+	if c := borsh.Compare(s.Bump, o.Bump); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Label, o.Label); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorInitializeArgs starts the encoding of InitializeArgs
+var discriminatorInitializeArgs = []byte{0xaf, 0xaf, 0x6d, 0x1f, 0x0d, 0x98, 0x9b, 0xed}
+
+// BorshDiscriminator returns the bytes that start the encoding of InitializeArgs
+func (InitializeArgs) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorInitializeArgs)
+}
+
+// BorshTypeID returns the type ID written before InitializeArgs in fields typed by an interface
+func (InitializeArgs) BorshTypeID() uint64 {
+	return 0x868cbd924de3eb5f
+}
+func init() {
+	Registry.Register(0x868cbd924de3eb5f, func() borsh.BorshEncoder { return new(InitializeArgs) })
+	Registry.RegisterDiscriminator(discriminatorInitializeArgs, func() borsh.BorshEncoder { return new(InitializeArgs) })
+}
+
+// BorshSchema describes the Borsh encoding of InitializeArgs in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (InitializeArgs) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "InitializeArgs",
+		Definitions: map[string]borsh.Definition{
+			"InitializeArgs": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "bump", Declaration: "u8"},
+				borsh.Field{Name: "label", Declaration: "String"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s DepositArgs) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s DepositArgs) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("DepositArgs", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of DepositArgs to buf.
+// The FieldPath of a returned EncodeError is relative to DepositArgs
+func (s DepositArgs) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s DepositArgs) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends DepositArgs in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s DepositArgs) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("DepositArgs", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes DepositArgs in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s DepositArgs) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "DepositArgs", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes DepositArgs to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s DepositArgs) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("DepositArgs", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes DepositArgs to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to DepositArgs
+func (s DepositArgs) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+	buf.Write(discriminatorDepositArgs)
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Amount))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum
+					if err := appendEnumAction(buf, s.Action); err != nil {
+						return borsh.EncodeFieldError("Action", err)
+					}
+		}
+	return nil
+}
+func (s *DepositArgs) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("DepositArgs", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to DepositArgs like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of DepositArgs, without trailing bytes
+func (s *DepositArgs) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("DepositArgs", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads DepositArgs in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of DepositArgs, so wrap unbuffered readers in a bufio.Reader
+func (s *DepositArgs) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("DepositArgs", err)
+}
+
+// ReadBorsh decodes DepositArgs nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to DepositArgs
+func (s *DepositArgs) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "DepositArgs is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	if err := r.ReadDiscriminator(discriminatorDepositArgs); err != nil {
+		return err
+	}
+	
+	// FIELDS: DepositArgs
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+	__m := uint64(__v)
+	s.Amount = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	s.Action, err = readEnumAction(r, depth+1)
+	if err != nil {
+		return r.FieldError("Action", err)
+	}
+		}
+	return err
+}
+func (s DepositArgs) BinarySize() (int, error) {
+	size := 8
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				{
+					_s, err := sizeEnumAction(s.Action)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Action", err)
+					}
+					size += _s
+				}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders DepositArgs by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s DepositArgs) CompareBorsh(other any) int {
+	o := other.(DepositArgs)
+	_ = o
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Action, o.Action); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// discriminatorDepositArgs starts the encoding of DepositArgs
+var discriminatorDepositArgs = []byte{0xf2, 0x23, 0xc6, 0x89, 0x52, 0xe1, 0xf2, 0xb6}
+
+// BorshDiscriminator returns the bytes that start the encoding of DepositArgs
+func (DepositArgs) BorshDiscriminator() []byte {
+	return slices.Clone(discriminatorDepositArgs)
+}
+
+// BorshTypeID returns the type ID written before DepositArgs in fields typed by an interface
+func (DepositArgs) BorshTypeID() uint64 {
+	return 0x60c9234c98abb26c
+}
+func init() {
+	Registry.Register(0x60c9234c98abb26c, func() borsh.BorshEncoder { return new(DepositArgs) })
+	Registry.RegisterDiscriminator(discriminatorDepositArgs, func() borsh.BorshEncoder { return new(DepositArgs) })
+}
+
+// BorshSchema describes the Borsh encoding of DepositArgs in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (DepositArgs) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "DepositArgs",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Action": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "ActionHold", Declaration: "ActionHold"},
+				borsh.Variant{Discriminant: 1, Name: "ActionStake", Declaration: "ActionStake"},
+				borsh.Variant{Discriminant: 2, Name: "ActionSwap", Declaration: "ActionSwap"}),
+			"ActionHold": borsh.StructDef(),
+			"ActionStake": borsh.StructDef(
+				borsh.Field{Name: "validator", Declaration: "[u8; 32]"},
+				borsh.Field{Name: "epochs", Declaration: "u16"},),
+			"ActionSwap": borsh.StructDef(
+				borsh.Field{Name: "0", Declaration: "u64"},
+				borsh.Field{Name: "1", Declaration: "Option<String>"},),
+			"DepositArgs": borsh.StructDef(
+				borsh.Field{Name: "discriminator", Declaration: "[u8; 8]"},
+				borsh.Field{Name: "amount", Declaration: "u64"},
+				borsh.Field{Name: "action", Declaration: "Action"},),
+			"Option<String>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "String"}),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"[u8; 32]": borsh.SequenceDef(0, 32, 32, "u8"),
+			"[u8; 8]": borsh.SequenceDef(0, 8, 8, "u8"),
+			"u16": borsh.PrimitiveDef(2),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+// appendEnumAction writes the variant index of v followed by the variant
+func appendEnumAction(buf *borsh.Writer, v Action) error {
+	var err error
+	switch x := v.(type) {
+	case ActionHold:
+		buf.WriteByte(0)
+		err = writeNested(buf, x)
+	case *ActionHold:
+		if x == nil {
+			return fmt.Errorf("nil Action variant ActionHold")
+		}
+		buf.WriteByte(0)
+		err = writeNested(buf, x)
+	case ActionStake:
+		buf.WriteByte(1)
+		err = writeNested(buf, x)
+	case *ActionStake:
+		if x == nil {
+			return fmt.Errorf("nil Action variant ActionStake")
+		}
+		buf.WriteByte(1)
+		err = writeNested(buf, x)
+	case ActionSwap:
+		buf.WriteByte(2)
+		err = writeNested(buf, x)
+	case *ActionSwap:
+		if x == nil {
+			return fmt.Errorf("nil Action variant ActionSwap")
+		}
+		buf.WriteByte(2)
+		err = writeNested(buf, x)
+	case nil:
+		return fmt.Errorf("nil Action")
+	default:
+		return fmt.Errorf("unknown Action variant %T", v)
+	}
+	return err
+}
+
+// readEnumAction reads the variant index and decodes the matching variant at depth
+func readEnumAction(r *borsh.Reader, depth int) (Action, error) {
+	index, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch index {
+	case 0:
+		m := &ActionHold{}
+		if err := readNested(r, m, depth); err != nil {
+			return nil, err
+		}
+		return *m, nil
+	case 1:
+		m := &ActionStake{}
+		if err := readNested(r, m, depth); err != nil {
+			return nil, err
+		}
+		return *m, nil
+	case 2:
+		m := &ActionSwap{}
+		if err := readNested(r, m, depth); err != nil {
+			return nil, err
+		}
+		return *m, nil
+	default:
+		return nil, r.Errorf(borsh.UnknownVariant, "unknown Action variant index %d", index)
+	}
+}
+
+// sizeEnumAction returns the encoded size of v including the variant index
+func sizeEnumAction(v Action) (int, error) {
+	var (
+		size int
+		err  error
+	)
+	switch x := v.(type) {
+	case ActionHold:
+		size, err = x.BinarySize()
+	case *ActionHold:
+		if x == nil {
+			return 0, fmt.Errorf("nil Action variant ActionHold")
+		}
+		size, err = x.BinarySize()
+	case ActionStake:
+		size, err = x.BinarySize()
+	case *ActionStake:
+		if x == nil {
+			return 0, fmt.Errorf("nil Action variant ActionStake")
+		}
+		size, err = x.BinarySize()
+	case ActionSwap:
+		size, err = x.BinarySize()
+	case *ActionSwap:
+		if x == nil {
+			return 0, fmt.Errorf("nil Action variant ActionSwap")
+		}
+		size, err = x.BinarySize()
+	case nil:
+		return 0, fmt.Errorf("nil Action")
+	default:
+		return 0, fmt.Errorf("unknown Action variant %T", v)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return 1 + NestedPrefixSize + size, nil
+}
+
+// MarshalAction encodes v as a Borsh enum: a 1-byte variant index followed by the variant
+func MarshalAction(v Action) ([]byte, error) {
+	size, err := sizeEnumAction(v)
+	if err != nil {
+		return nil, borsh.EncodeFieldError("Action", err)
+	}
+	buf := borsh.NewBufferWriter(make([]byte, 0, size))
+	if err := appendEnumAction(buf, v); err != nil {
+		return nil, borsh.EncodeFieldError("Action", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalAction decodes a Borsh enum into the Action variant selected by its index
+func UnmarshalAction(data []byte) (Action, error) {
+	r := newReader(data, StrictDecoding)
+	v, err := readEnumAction(r, 0)
+	if err == nil && StrictDecoding && len(r.Remaining()) > 0 {
+		err = r.Errorf(borsh.TrailingBytes, "%d bytes after the value", len(r.Remaining()))
+	}
+	if err != nil {
+		return nil, r.FieldError("Action", err)
+	}
+	return v, nil
+}
+
+// ActionBinarySize returns the encoded size of v
+func ActionBinarySize(v Action) (int, error) {
+	size, err := sizeEnumAction(v)
+	if err != nil {
+		return 0, borsh.EncodeFieldError("Action", err)
+	}
+	return size, nil
+}
+
+// String returns the name of the Side constant
+func (v Side) String() string {
+	switch v {
+	case SideBid:
+		return "SideBid"
+	case SideAsk:
+		return "SideAsk"
+	}
+	return fmt.Sprintf("Side(%d)", v)
+}
+
+// Valid reports whether v is one of the declared Side constants
+func (v Side) Valid() bool {
+	switch v {
+	case SideBid, SideAsk:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package limits
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -max-alloc=4096 -gen-tests
+type Profile struct {
+	Name   string            `msg:"name" max:"16"`
+	Tags   []string          `msg:"tags" max:"4"`
+	Avatar []byte            `msg:"avatar" max:"64"`
+	Scores map[string]uint32 `msg:"scores" max:"2"`
+	Notes  []string          `msg:"notes"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -max-alloc=4096 -gen-tests
+type Team struct {
+	Lead    *Profile  `msg:"lead"`
+	Members []Profile `msg:"members"`
+}
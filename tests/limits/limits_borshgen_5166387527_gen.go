@@ -0,0 +1,904 @@
+package limits
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Profile) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Profile) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Profile", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Profile to buf.
+// The FieldPath of a returned EncodeError is relative to Profile
+func (s Profile) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Profile) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Profile in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Profile) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Profile", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Profile in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Profile) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Profile", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Profile to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Profile) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Profile", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Profile to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Profile
+func (s Profile) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Name
+					if err := checkLength("string", len(str), 16); err != nil {
+						return borsh.EncodeFieldError("Name", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	if err := checkLength("slice", len((s.Tags)), 4); err != nil {
+		return borsh.EncodeFieldError("Tags", err)
+	}
+	 appendLength(buf, len((s.Tags)))
+		for i, item := range (s.Tags) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Tags", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+		{
+		
+				// Avatar (avatar) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+	data, err := _DefaultByteArrayEncoder.MarshalBorsh((s.Avatar), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), 64)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("Avatar", err)
+		}
+		 appendBytes(buf, data)
+		}
+		{
+		
+				// Scores (scores) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Scores)), 2); err != nil {
+				return borsh.EncodeFieldError("Scores", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Scores)))
+			for mk, mv := range (s.Scores) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Scores", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Scores", err)
+			}
+		}
+		}
+		{
+		
+				// Notes (notes) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Notes: []Notes
+	if err := checkLength("slice", len((s.Notes)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Notes", err)
+	}
+	 appendLength(buf, len((s.Notes)))
+		for i, item := range (s.Notes) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Notes", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+	return nil
+}
+func (s *Profile) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Profile", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Profile like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Profile, without trailing bytes
+func (s *Profile) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Profile", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Profile in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Profile, so wrap unbuffered readers in a bufio.Reader
+func (s *Profile) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Profile", err)
+}
+
+// ReadBorsh decodes Profile nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Profile
+func (s *Profile) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Profile is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Profile
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, 16)
+	if err != nil {
+		return r.FieldError("Name", err)
+	}
+	__m := string(__s)
+	s.Name = (__m)
+		}
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	length, err := readCount(r, 4)
+	if err != nil {
+		return r.FieldError("Tags", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]string](r, length)
+		if err != nil {
+			return r.FieldError("Tags", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Tags", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+		}
+				s.Tags =  p
+		}
+		{
+		
+				// Avatar (avatar) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+			itemData, err := readByteSlice(r, 64)
+			if err != nil {
+				return r.FieldError("Avatar", err)
+			}
+			if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+				return r.FieldError("Avatar", err)
+			} else {
+				_m := (_v).([]byte)
+				s.Avatar = _m
+			}
+		}
+		{
+		
+				// Scores (scores) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, 2)
+			if err != nil {
+				return r.FieldError("Scores", err)
+			}
+			mp, err := borsh.MakeMap[map[string]uint32](r, mapLen)
+			if err != nil {
+				return r.FieldError("Scores", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv uint32
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Scores", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Scores", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError(borsh.Key("Scores", mk), err)
+	}
+	__m := uint32(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Scores = mp
+		}
+		}
+		{
+		
+				// Notes (notes) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Notes: []Notes
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Notes", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]string](r, length)
+		if err != nil {
+			return r.FieldError("Notes", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Notes", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+		}
+				s.Notes =  p
+		}
+	return err
+}
+func (s Profile) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Name
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Tags) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+		{
+		
+				// Avatar (avatar) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+
+// Var s.Avatar
+		_s, err := _DefaultByteArrayEncoder.BinarySize(s.Avatar, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder s.Avatar: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		{
+		
+				// Scores (scores) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Scores) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+				size += 4
+			}
+		}
+		}
+		{
+		
+				// Notes (notes) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Notes: []Notes
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Notes) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Profile by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Profile) CompareBorsh(other any) int {
+	o := other.(Profile)
+	_ = o
+	if c := borsh.Compare(s.Name, o.Name); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Tags, o.Tags); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Avatar, o.Avatar); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Scores, o.Scores); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Notes, o.Notes); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Profile in fields typed by an interface
+func (Profile) BorshTypeID() uint64 {
+	return 0x4632f77f1dfe4f9d
+}
+func init() {
+	Registry.Register(0x4632f77f1dfe4f9d, func() borsh.BorshEncoder { return new(Profile) })
+}
+
+// BorshSchema describes the Borsh encoding of Profile in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Profile) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Profile",
+		Definitions: map[string]borsh.Definition{
+			"(String, u32)": borsh.TupleDef("String", "u32"),
+			"BTreeMap<String, u32>": borsh.SequenceDef(4, 0, 4294967295, "(String, u32)"),
+			"Profile": borsh.StructDef(
+				borsh.Field{Name: "name", Declaration: "String"},
+				borsh.Field{Name: "tags", Declaration: "Vec<String>"},
+				borsh.Field{Name: "avatar", Declaration: "Vec<u8>"},
+				borsh.Field{Name: "scores", Declaration: "BTreeMap<String, u32>"},
+				borsh.Field{Name: "notes", Declaration: "Vec<String>"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<String>": borsh.SequenceDef(4, 0, 4294967295, "String"),
+			"Vec<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Team) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Team) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Team", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Team to buf.
+// The FieldPath of a returned EncodeError is relative to Team
+func (s Team) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Team) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Team in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Team) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Team", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Team in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Team) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Team", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Team to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Team) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Team", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Team to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Team
+func (s Team) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+			if s.Lead == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPLead
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Lead (lead) - Pointer
+					// ElementType: struct{Name string "msg:\"name\" max:\"16\""; Tags []string "msg:\"tags\" max:\"4\""; Avatar []byte "msg:\"avatar\" max:\"64\""; Scores map[string]uint32 "msg:\"scores\" max:\"2\""; Notes []string "msg:\"notes\""}
+					// Type: Profile
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Profile
+					if err := writeNested(buf, s.Lead); err != nil {
+						return borsh.EncodeFieldError("Lead", err)
+					}
+		}
+					SKIPLead:
+		{
+		
+				// Members (members) - slice
+				// ElementType: []Profile
+				// Type: []Profile
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Members: []Members
+	if err := checkLength("slice", len((s.Members)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Members", err)
+	}
+	 appendLength(buf, len((s.Members)))
+		for i, item := range (s.Members) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Name string "msg:\"name\" max:\"16\""; Tags []string "msg:\"tags\" max:\"4\""; Avatar []byte "msg:\"avatar\" max:\"64\""; Scores map[string]uint32 "msg:\"scores\" max:\"2\""; Notes []string "msg:\"notes\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Members", i), err)
+					}
+		}
+		}
+	return nil
+}
+func (s *Team) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Team", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Team like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Team, without trailing bytes
+func (s *Team) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Team", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Team in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Team, so wrap unbuffered readers in a bufio.Reader
+func (s *Team) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Team", err)
+}
+
+// ReadBorsh decodes Team nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Team
+func (s *Team) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Team is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Team
+    var err error
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Lead", err)
+				}
+				if !present {
+					s.Lead = nil
+					goto SKIPLead
+				} 
+			}
+		{
+		
+					// Lead (lead) - Pointer
+					// ElementType: struct{Name string "msg:\"name\" max:\"16\""; Tags []string "msg:\"tags\" max:\"4\""; Avatar []byte "msg:\"avatar\" max:\"64\""; Scores map[string]uint32 "msg:\"scores\" max:\"2\""; Notes []string "msg:\"notes\""}
+					// Type: Profile
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Profile
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Profile{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Lead", err)
+					}
+						s.Lead = m
+		}
+					SKIPLead:
+		{
+		
+				// Members (members) - slice
+				// ElementType: []Profile
+				// Type: []Profile
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Members: []Members
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Members", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Profile](r, length)
+		if err != nil {
+			return r.FieldError("Members", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Name string "msg:\"name\" max:\"16\""; Tags []string "msg:\"tags\" max:\"4\""; Avatar []byte "msg:\"avatar\" max:\"64\""; Scores map[string]uint32 "msg:\"scores\" max:\"2\""; Notes []string "msg:\"notes\""}
+			// Element Profile
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Profile{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Members", i), err)
+					}
+						p[i] = *m
+		}
+				s.Members =  p
+		}
+	return err
+}
+func (s Team) BinarySize() (int, error) {
+	size := 0
+		{
+			size++
+			if s.Lead == nil {
+				goto SKIPLead
+			}
+		}
+		{
+		
+					// Lead (lead) - Pointer
+					// ElementType: struct{Name string "msg:\"name\" max:\"16\""; Tags []string "msg:\"tags\" max:\"4\""; Avatar []byte "msg:\"avatar\" max:\"64\""; Scores map[string]uint32 "msg:\"scores\" max:\"2\""; Notes []string "msg:\"notes\""}
+					// Type: Profile
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Profile
+	
+		// ElementType: struct{Name string "msg:\"name\" max:\"16\""; Tags []string "msg:\"tags\" max:\"4\""; Avatar []byte "msg:\"avatar\" max:\"64\""; Scores map[string]uint32 "msg:\"scores\" max:\"2\""; Notes []string "msg:\"notes\""}
+		
+			// Element: false
+	
+		// ElementType: struct{Name string "msg:\"name\" max:\"16\""; Tags []string "msg:\"tags\" max:\"4\""; Avatar []byte "msg:\"avatar\" max:\"64\""; Scores map[string]uint32 "msg:\"scores\" max:\"2\""; Notes []string "msg:\"notes\""}
+			bs, err := borsh.SizeOf(s.Lead)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Lead", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPLead:
+		{
+		
+				// Members (members) - slice
+				// ElementType: []Profile
+				// Type: []Profile
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Members: []Members
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Members) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Name string "msg:\"name\" max:\"16\""; Tags []string "msg:\"tags\" max:\"4\""; Avatar []byte "msg:\"avatar\" max:\"64\""; Scores map[string]uint32 "msg:\"scores\" max:\"2\""; Notes []string "msg:\"notes\""}
+			// Element Profile
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Members", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Team by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Team) CompareBorsh(other any) int {
+	o := other.(Team)
+	_ = o
+	if c := borsh.Compare(s.Lead, o.Lead); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Members, o.Members); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Team in fields typed by an interface
+func (Team) BorshTypeID() uint64 {
+	return 0x56afffdf687d949c
+}
+func init() {
+	Registry.Register(0x56afffdf687d949c, func() borsh.BorshEncoder { return new(Team) })
+}
+
+// BorshSchema describes the Borsh encoding of Team in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Team) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Team",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"(String, u32)": borsh.TupleDef("String", "u32"),
+			"BTreeMap<String, u32>": borsh.SequenceDef(4, 0, 4294967295, "(String, u32)"),
+			"Option<Profile>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Profile"}),
+			"Profile": borsh.StructDef(
+				borsh.Field{Name: "name", Declaration: "String"},
+				borsh.Field{Name: "tags", Declaration: "Vec<String>"},
+				borsh.Field{Name: "avatar", Declaration: "Vec<u8>"},
+				borsh.Field{Name: "scores", Declaration: "BTreeMap<String, u32>"},
+				borsh.Field{Name: "notes", Declaration: "Vec<String>"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Team": borsh.StructDef(
+				borsh.Field{Name: "lead", Declaration: "Option<Profile>"},
+				borsh.Field{Name: "members", Declaration: "Vec<Profile>"},),
+			"Vec<Profile>": borsh.SequenceDef(4, 0, 4294967295, "Profile"),
+			"Vec<String>": borsh.SequenceDef(4, 0, 4294967295, "String"),
+			"Vec<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
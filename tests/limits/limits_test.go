@@ -0,0 +1,182 @@
+package limits
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+func profile() Profile {
+	return Profile{
+		Name:   strings.Repeat("n", 16),
+		Tags:   []string{"a", "b", "c", "d"},
+		Avatar: bytes.Repeat([]byte{1}, 64),
+		Scores: map[string]uint32{"x": 1, "y": 2},
+		Notes:  []string{},
+	}
+}
+
+// encode writes p in the layout of the generated code without checking its limits,
+// to make data that is over them
+func encode(p Profile) []byte {
+	var data []byte
+	str := func(s string) {
+		data = binary.LittleEndian.AppendUint32(data, uint32(len(s)))
+		data = append(data, s...)
+	}
+	str(p.Name)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(p.Tags)))
+	for _, tag := range p.Tags {
+		str(tag)
+	}
+	str(string(p.Avatar))
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(p.Scores)))
+	for _, k := range slices.Sorted(maps.Keys(p.Scores)) {
+		str(k)
+		data = binary.LittleEndian.AppendUint32(data, p.Scores[k])
+	}
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(p.Notes)))
+	for _, note := range p.Notes {
+		str(note)
+	}
+	return data
+}
+
+// wantLimit fails unless err is a LengthLimit DecodeError at path
+func wantLimit(t *testing.T, err error, path string) {
+	t.Helper()
+	var de *borsh.DecodeError
+	if !errors.As(err, &de) || de.Kind != borsh.LengthLimit || de.FieldPath != path {
+		t.Errorf("error = %v, want a LengthLimit error at %s", err, path)
+	}
+}
+
+// wantEncodeLimit fails unless err is a LengthLimit EncodeError at path
+func wantEncodeLimit(t *testing.T, err error, path string) {
+	t.Helper()
+	var ee *borsh.EncodeError
+	if !errors.As(err, &ee) || ee.Kind != borsh.LengthLimit || ee.FieldPath != path {
+		t.Errorf("error = %v, want a LengthLimit EncodeError at %s", err, path)
+	}
+}
+
+func TestFieldLimits(t *testing.T) {
+	v := profile()
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	var restored Profile
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() of a profile at its limits failed: %v", err)
+	}
+	if !reflect.DeepEqual(restored, v) {
+		t.Errorf("UnmarshalBorsh() = %+v, want %+v", restored, v)
+	}
+	if !bytes.Equal(encode(v), data) {
+		t.Fatalf("encode() = %x, want the MarshalBorsh() encoding %x", encode(v), data)
+	}
+
+	for path, over := range map[string]func(*Profile){
+		"Profile.Name":   func(p *Profile) { p.Name += "n" },
+		"Profile.Tags":   func(p *Profile) { p.Tags = append(p.Tags, "e") },
+		"Profile.Avatar": func(p *Profile) { p.Avatar = append(p.Avatar, 1) },
+		"Profile.Scores": func(p *Profile) { p.Scores["z"] = 3 },
+	} {
+		t.Run(path, func(t *testing.T) {
+			v := profile()
+			over(&v)
+			// Marshaling checks the limits decoding does
+			_, err := v.MarshalBorsh()
+			wantEncodeLimit(t, err, path)
+			_, err = v.WriteBorshTo(io.Discard)
+			wantEncodeLimit(t, err, path)
+
+			data := encode(v)
+			var restored Profile
+			wantLimit(t, restored.UnmarshalBorsh(data), path)
+			_, err = restored.ReadBorshFrom(bytes.NewReader(data))
+			wantLimit(t, err, path)
+		})
+	}
+}
+
+func TestSliceLimit(t *testing.T) {
+	data, err := Profile{}.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	// The Notes count is the last field of the encoding
+	binary.LittleEndian.PutUint32(data[len(data)-4:], MaxSliceLen+1)
+	var restored Profile
+	wantLimit(t, restored.UnmarshalBorsh(data), "Profile.Notes")
+
+	_, err = Profile{Notes: make([]string, MaxSliceLen+1)}.MarshalBorsh()
+	wantEncodeLimit(t, err, "Profile.Notes")
+}
+
+func TestAllocLimit(t *testing.T) {
+	if MaxDecodeAlloc != 4096 {
+		t.Fatalf("MaxDecodeAlloc = %d, want the -max-alloc=4096 of the directive", MaxDecodeAlloc)
+	}
+	note := strings.Repeat("x", 1000)
+	v := Profile{Notes: []string{note, note, note}}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	var restored Profile
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() of 3000 bytes of notes failed: %v", err)
+	}
+
+	// The fifth note takes the allocations over 4096 bytes
+	v.Notes = append(v.Notes, note, note)
+	if data, err = v.MarshalBorsh(); err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	wantLimit(t, restored.UnmarshalBorsh(data), "Profile.Notes[4]")
+}
+
+func TestAllocLimitBeforeRead(t *testing.T) {
+	note := strings.Repeat("x", 1000)
+	data, err := Profile{Notes: []string{note, note, note, note, note}}.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	// The fifth note is over the limit from its length, so its missing bytes are never read
+	data = data[:len(data)-len(note)]
+	var restored Profile
+	wantLimit(t, restored.UnmarshalBorsh(data), "Profile.Notes[4]")
+	_, err = restored.ReadBorshFrom(bytes.NewReader(data))
+	wantLimit(t, err, "Profile.Notes[4]")
+}
+
+func TestAllocLimitNested(t *testing.T) {
+	member := Profile{Notes: []string{strings.Repeat("x", 1000), strings.Repeat("y", 1000)}}
+	v := Team{Members: []Profile{member, member, member}}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	// Each member is within the limit on its own, but the limit is for the whole call
+	var restored Team
+	wantLimit(t, restored.UnmarshalBorsh(data), "Team.Members[1].Notes[1]")
+	_, err = restored.ReadBorshFrom(bytes.NewReader(data))
+	wantLimit(t, err, "Team.Members[1].Notes[1]")
+
+	if data, err = (Team{Lead: &member}).MarshalBorsh(); err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Errorf("UnmarshalBorsh() of one member failed: %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package maps
+
+import (
+	"encoding/json"
+
+	"github.com/mlayerprotocol/go-borshgen/tests/configs"
+	"github.com/mlayerprotocol/go-borshgen/tests/constants"
+)
+
+// Balances is a named map type
+type Balances map[string]uint64
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict -gen-tests -lang=ts,rust
+type Point struct {
+	X int32 `msg:"x"`
+	Y int32 `msg:"y"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict -gen-tests -lang=ts,rust
+type Ledger struct {
+	Name     string                               `msg:"name"`
+	Counts   map[uint32]uint16                    `msg:"counts"`
+	Points   map[string]Point                     `msg:"points"`
+	ByPoint  map[Point]string                     `msg:"by_point"`
+	Groups   map[string][]uint32                  `msg:"groups"`
+	Nested   map[string]map[int8]bool             `msg:"nested"`
+	Blobs    map[uint8][]byte                     `msg:"blobs"`
+	Raw      map[string]json.RawMessage           `msg:"raw"`
+	Chains   map[configs.ChainId]constants.Status `msg:"chains"`
+	Balances Balances                             `msg:"balances"`
+	Optional *map[string]int64                    `msg:"optional"`
+	History  []map[string]uint8                   `msg:"history"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict -gen-tests -lang=ts,rust
+type Tally struct {
+	Counts map[uint16]uint8 `msg:"counts"`
+}
+
+// Cell has a field that is not encoded, and so does not order the keys of Grid
+//
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict -gen-tests -lang=ts,rust
+type Cell struct {
+	Hint uint8 `msg:"-"`
+	Key  uint8 `msg:"key"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict -gen-tests -lang=ts,rust
+type Grid struct {
+	Cells map[Cell]string `msg:"cells"`
+}
@@ -0,0 +1,2842 @@
+package maps
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/configs"
+	"github.com/mlayerprotocol/go-borshgen/tests/constants"
+)
+var _ configs.ChainId
+var _ constants.Status
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Point) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Point) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Point", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Point to buf.
+// The FieldPath of a returned EncodeError is relative to Point
+func (s Point) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Point) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Point in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Point) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Point", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Point in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Point) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Point", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Point to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Point) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Point", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Point to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Point
+func (s Point) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(s.X))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(s.Y))
+		}
+	return nil
+}
+func (s *Point) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Point", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Point like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Point, without trailing bytes
+func (s *Point) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Point", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Point in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Point, so wrap unbuffered readers in a bufio.Reader
+func (s *Point) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Point", err)
+}
+
+// ReadBorsh decodes Point nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Point
+func (s *Point) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Point is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Point
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("X", err)
+	}
+	__m := int32(__v)
+	s.X = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("Y", err)
+	}
+	__m := int32(__v)
+	s.Y = (__m)
+		}
+	return err
+}
+func (s Point) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Point by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Point) CompareBorsh(other any) int {
+	o := other.(Point)
+	_ = o
+	if c := borsh.Compare(s.X, o.X); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Y, o.Y); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Point in fields typed by an interface
+func (Point) BorshTypeID() uint64 {
+	return 0x1eba58b1fdd250a2
+}
+func init() {
+	Registry.Register(0x1eba58b1fdd250a2, func() borsh.BorshEncoder { return new(Point) })
+}
+
+// BorshSchema describes the Borsh encoding of Point in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Point) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Point",
+		Definitions: map[string]borsh.Definition{
+			"Point": borsh.StructDef(
+				borsh.Field{Name: "x", Declaration: "i32"},
+				borsh.Field{Name: "y", Declaration: "i32"},),
+			"i32": borsh.PrimitiveDef(4),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Ledger) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Ledger) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Ledger", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Ledger to buf.
+// The FieldPath of a returned EncodeError is relative to Ledger
+func (s Ledger) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Ledger) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Ledger in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Ledger) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Ledger", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Ledger in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Ledger) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Ledger", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Ledger to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Ledger) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Ledger", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Ledger to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Ledger
+func (s Ledger) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Name
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Name", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+				// Counts (counts) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Counts)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Counts", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Counts)))
+			for mk, mv := range (s.Counts) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(mk))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Counts", err)
+			}
+		}
+		}
+		{
+		
+				// Points (points) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Points)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Points", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Points)))
+			for mk, mv := range (s.Points) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Points", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+					if err := writeNested(buf, mv); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Points", mk), err)
+					}
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Points", err)
+			}
+		}
+		}
+		{
+		
+				// ByPoint (by_point) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.ByPoint)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("ByPoint", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.ByPoint)))
+			for mk, mv := range (s.ByPoint) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+					if err := writeNested(buf, mk); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("ByPoint", mk), err)
+					}
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mv
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("ByPoint", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("ByPoint", err)
+			}
+		}
+		}
+		{
+		
+				// Groups (groups) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Groups)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Groups", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Groups)))
+			for mk, mv := range (s.Groups) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Groups", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+		// ElementIsSlice: true
+		if err := checkLength("slice", len((mv)), MaxSliceLen); err != nil {
+			return borsh.EncodeFieldError(borsh.Key("Groups", mk), err)
+		}
+		appendLength(buf, len((mv)))
+		for i0, inner := range (mv) {
+			_ = i0
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(inner))
+		}
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Groups", err)
+			}
+		}
+		}
+		{
+		
+				// Nested (nested) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Nested)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Nested", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Nested)))
+			for mk, mv := range (s.Nested) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Nested", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((mv)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError(borsh.Key("Nested", mk), err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((mv)))
+			for mk, mv := range (mv) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int8
+	
+					// BASICTYPE: true
+					// ELNTYPE: int8
+					buf.WriteByte(byte(mk))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType bool
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if mv {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError(borsh.Key("Nested", mk), err)
+			}
+		}
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Nested", err)
+			}
+		}
+		}
+		{
+		
+				// Blobs (blobs) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Blobs)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Blobs", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Blobs)))
+			for mk, mv := range (s.Blobs) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(mk))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+		data, err := _DefaultByteArrayEncoder.MarshalBorsh((mv), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError(borsh.Key("Blobs", mk), err)
+		}
+		 appendBytes(buf, data)
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Blobs", err)
+			}
+		}
+		}
+		{
+		
+				// Raw (raw) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Raw)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Raw", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Raw)))
+			for mk, mv := range (s.Raw) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Raw", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+		data, err := _DefaultJsonRawMessageEncoder.MarshalBorsh((mv), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError(borsh.Key("Raw", mk), err)
+		}
+		 appendBytes(buf, data)
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Raw", err)
+			}
+		}
+		}
+		{
+		
+				// Chains (chains) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Chains)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Chains", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Chains)))
+			for mk, mv := range (s.Chains) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Chains", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum_u8
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u8
+					if !(mv).Valid() {
+						return borsh.EncodeFieldError(borsh.Key("Chains", mk), fmt.Errorf("invalid enum value %d", mv))
+					}
+					buf.WriteByte(byte(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Chains", err)
+			}
+		}
+		}
+		{
+		
+				// Balances (balances) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Balances)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Balances", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Balances)))
+			for mk, mv := range (s.Balances) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Balances", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Balances", err)
+			}
+		}
+		}
+		{
+			if s.Optional == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPOptional
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+				// Optional (optional) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len(*(s.Optional)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Optional", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len(*(s.Optional)))
+			for mk, mv := range *(s.Optional) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Optional", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Optional", err)
+			}
+		}
+		}
+					SKIPOptional:
+		{
+		
+				// History (history) - slice
+				// ElementType: []map[string]uint8
+				// Type: []map[string]uint8
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	if err := checkLength("slice", len((s.History)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("History", err)
+	}
+	 appendLength(buf, len((s.History)))
+		for i, item := range (s.History) {
+			_ = i
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((item)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError(borsh.Index("History", i), err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((item)))
+			for mk, mv := range (item) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key(borsh.Index("History", i), mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError(borsh.Index("History", i), err)
+			}
+		}
+		}
+		}
+	return nil
+}
+func (s *Ledger) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Ledger", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Ledger like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Ledger, without trailing bytes
+func (s *Ledger) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Ledger", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Ledger in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Ledger, so wrap unbuffered readers in a bufio.Reader
+func (s *Ledger) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Ledger", err)
+}
+
+// ReadBorsh decodes Ledger nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Ledger
+func (s *Ledger) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Ledger is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Ledger
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Name", err)
+	}
+	__m := string(__s)
+	s.Name = (__m)
+		}
+		{
+		
+				// Counts (counts) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Counts", err)
+			}
+			mp, err := borsh.MakeMap[map[uint32]uint16](r, mapLen)
+			if err != nil {
+				return r.FieldError("Counts", err)
+			}
+			var prevKey uint32
+			for mi := 0; mi < mapLen; mi++ {
+				var mk uint32
+				var mv uint16
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError(borsh.Index("Counts", mi), err)
+	}
+	__m := uint32(__v)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Counts", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError(borsh.Key("Counts", mk), err)
+	}
+	__m := uint16(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Counts = mp
+		}
+		}
+		{
+		
+				// Points (points) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Points", err)
+			}
+			mp, err := borsh.MakeMap[map[string]Point](r, mapLen)
+			if err != nil {
+				return r.FieldError("Points", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv Point
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Points", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Points", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+			// Element Point
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Point{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Key("Points", mk), err)
+					}
+						mv = *m
+				}
+				mp[mk] = mv
+			}
+			s.Points = mp
+		}
+		}
+		{
+		
+				// ByPoint (by_point) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("ByPoint", err)
+			}
+			mp, err := borsh.MakeMap[map[Point]string](r, mapLen)
+			if err != nil {
+				return r.FieldError("ByPoint", err)
+			}
+			var prevKey Point
+			for mi := 0; mi < mapLen; mi++ {
+				var mk Point
+				var mv string
+				{
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+			// Element Point
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Point{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("ByPoint", mi), err)
+					}
+						mk = *m
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("ByPoint", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Key("ByPoint", mk), err)
+	}
+	__m := string(__s)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.ByPoint = mp
+		}
+		}
+		{
+		
+				// Groups (groups) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Groups", err)
+			}
+			mp, err := borsh.MakeMap[map[string][]uint32](r, mapLen)
+			if err != nil {
+				return r.FieldError("Groups", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv []uint32
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Groups", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Groups", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+		// ElementIsSlice: true
+			length, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError(borsh.Key("Groups", mk), err)
+			}
+			mv, err = borsh.MakeSlice[[]uint32](r, length)
+			if err != nil {
+				return r.FieldError(borsh.Key("Groups", mk), err)
+			}
+				for i0 := 0; i0 < int(length); i0++ {
+					mv = slices.Grow(mv, 1)[:i0+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError(borsh.Index(borsh.Key("Groups", mk), i0), err)
+	}
+	__m := uint32(__v)
+	mv[i0] = (__m)
+				}
+				}
+				mp[mk] = mv
+			}
+			s.Groups = mp
+		}
+		}
+		{
+		
+				// Nested (nested) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Nested", err)
+			}
+			mp, err := borsh.MakeMap[map[string]map[int8]bool](r, mapLen)
+			if err != nil {
+				return r.FieldError("Nested", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv map[int8]bool
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Nested", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Nested", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError(borsh.Key("Nested", mk), err)
+			}
+			mp, err := borsh.MakeMap[map[int8]bool](r, mapLen)
+			if err != nil {
+				return r.FieldError(borsh.Key("Nested", mk), err)
+			}
+			var prevKey int8
+			for mi := 0; mi < mapLen; mi++ {
+				var mk int8
+				var mv bool
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int8
+			// Element int8
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index(borsh.Key("Nested", mk), mi), err)
+	}
+	__m := int8(__v)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key(borsh.Key("Nested", mk), mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType bool
+			// Element bool
+	__v, err := r.ReadBool()
+	if err != nil {
+		return r.FieldError(borsh.Key(borsh.Key("Nested", mk), mk), err)
+	}
+	__m := bool(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			mv = mp
+		}
+				}
+				mp[mk] = mv
+			}
+			s.Nested = mp
+		}
+		}
+		{
+		
+				// Blobs (blobs) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Blobs", err)
+			}
+			mp, err := borsh.MakeMap[map[uint8][]byte](r, mapLen)
+			if err != nil {
+				return r.FieldError("Blobs", err)
+			}
+			var prevKey uint8
+			for mi := 0; mi < mapLen; mi++ {
+				var mk uint8
+				var mv []byte
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Blobs", mi), err)
+	}
+	__m := uint8(__v)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Blobs", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+		itemData, err := readByteSlice(r, MaxSliceLen)
+		if err != nil {
+			return r.FieldError(borsh.Key("Blobs", mk), err)
+		}
+		if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+			return r.FieldError(borsh.Key("Blobs", mk), err)
+		} else {
+			_m := (_v).([]byte)
+			mv = _m
+		}
+				}
+				mp[mk] = mv
+			}
+			s.Blobs = mp
+		}
+		}
+		{
+		
+				// Raw (raw) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Raw", err)
+			}
+			mp, err := borsh.MakeMap[map[string]json.RawMessage](r, mapLen)
+			if err != nil {
+				return r.FieldError("Raw", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv json.RawMessage
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Raw", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Raw", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+		itemData, err := readByteSlice(r, MaxSliceLen)
+		if err != nil {
+			return r.FieldError(borsh.Key("Raw", mk), err)
+		}
+		if _v, err := _DefaultJsonRawMessageEncoder.UnmarshalBorsh(itemData); err != nil {
+			return r.FieldError(borsh.Key("Raw", mk), err)
+		} else {
+			_m := (_v).(json.RawMessage)
+			mv = _m
+		}
+				}
+				mp[mk] = mv
+			}
+			s.Raw = mp
+		}
+		}
+		{
+		
+				// Chains (chains) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Chains", err)
+			}
+			mp, err := borsh.MakeMap[map[configs.ChainId]constants.Status](r, mapLen)
+			if err != nil {
+				return r.FieldError("Chains", err)
+			}
+			var prevKey configs.ChainId
+			for mi := 0; mi < mapLen; mi++ {
+				var mk configs.ChainId
+				var mv constants.Status
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element configs.ChainId
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Chains", mi), err)
+	}
+	__m := configs.ChainId(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Chains", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum_u8
+			// Element constants.Status
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Key("Chains", mk), err)
+	}
+	__m := constants.Status(__v)
+	if !__m.Valid() {
+		return r.FieldError(borsh.Key("Chains", mk), fmt.Errorf("invalid Chains value %d", __m))
+	}
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Chains = mp
+		}
+		}
+		{
+		
+				// Balances (balances) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Balances", err)
+			}
+			mp, err := borsh.MakeMap[Balances](r, mapLen)
+			if err != nil {
+				return r.FieldError("Balances", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv uint64
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Balances", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Balances", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+			// Element uint64
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError(borsh.Key("Balances", mk), err)
+	}
+	__m := uint64(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Balances = mp
+		}
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Optional", err)
+				}
+				if !present {
+					s.Optional = nil
+					goto SKIPOptional
+				} 
+			}
+		{
+		
+				// Optional (optional) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Optional", err)
+			}
+			mp, err := borsh.MakeMap[map[string]int64](r, mapLen)
+			if err != nil {
+				return r.FieldError("Optional", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv int64
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Optional", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Optional", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+			// Element int64
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError(borsh.Key("Optional", mk), err)
+	}
+	__m := int64(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Optional = &mp
+		}
+		}
+					SKIPOptional:
+		{
+		
+				// History (history) - slice
+				// ElementType: []map[string]uint8
+				// Type: []map[string]uint8
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("History", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]map[string]uint8](r, length)
+		if err != nil {
+			return r.FieldError("History", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError(borsh.Index("History", i), err)
+			}
+			mp, err := borsh.MakeMap[map[string]uint8](r, mapLen)
+			if err != nil {
+				return r.FieldError(borsh.Index("History", i), err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv uint8
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index(borsh.Index("History", i), mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key(borsh.Index("History", i), mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Key(borsh.Index("History", i), mk), err)
+	}
+	__m := uint8(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			p[i] = mp
+		}
+		}
+				s.History =  p
+		}
+	return err
+}
+func (s Ledger) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Name
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+				// Counts (counts) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Counts) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+				size += 4
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+				size += 2
+			}
+		}
+		}
+		{
+		
+				// Points (points) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Points) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+			// Element Point
+			bs, err := borsh.SizeOf(mv)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Points", err)
+			}
+					size += NestedPrefixSize + bs
+			}
+		}
+		}
+		{
+		
+				// ByPoint (by_point) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.ByPoint) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{X int32 "msg:\"x\""; Y int32 "msg:\"y\""}
+			// Element Point
+			bs, err := borsh.SizeOf(mk)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("ByPoint", err)
+			}
+					size += NestedPrefixSize + bs
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mv
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+		}
+		}
+		{
+		
+				// Groups (groups) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Groups) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+		size += LengthPrefixSize // for length prefix
+		// ElementIsSlice: true
+		for _, item := range (mv) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+				size += 4
+		}
+			}
+		}
+		}
+		{
+		
+				// Nested (nested) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Nested) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (mv) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int8
+			// Element int8
+				size += 1
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType bool
+			// Element bool
+				size += 1
+			}
+		}
+			}
+		}
+		}
+		{
+		
+				// Blobs (blobs) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Blobs) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+				size += 1
+			}
+			{
+
+// Var mv
+		_s, err := _DefaultByteArrayEncoder.BinarySize(mv, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder mv: %v", err))
+				}
+				size += LengthPrefixSize + _s
+			}
+		}
+		}
+		{
+		
+				// Raw (raw) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Raw) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+// Var mv
+		_s, err := _DefaultJsonRawMessageEncoder.BinarySize(mv, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder mv: %v", err))
+				}
+				size += LengthPrefixSize + _s
+			}
+		}
+		}
+		{
+		
+				// Chains (chains) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Chains) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element configs.ChainId
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType enum_u8
+			// Element constants.Status
+				size += 1
+			}
+		}
+		}
+		{
+		
+				// Balances (balances) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Balances) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+			// Element uint64
+				size += 8
+			}
+		}
+		}
+		{
+			size++
+			if s.Optional == nil {
+				goto SKIPOptional
+			}
+		}
+		{
+		
+				// Optional (optional) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range *(s.Optional) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+			// Element int64
+				size += 8
+			}
+		}
+		}
+			SKIPOptional:
+		{
+		
+				// History (history) - slice
+				// ElementType: []map[string]uint8
+				// Type: []map[string]uint8
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.History) {
+			_ = item
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (item) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+				size += 1
+			}
+		}
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Ledger by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Ledger) CompareBorsh(other any) int {
+	o := other.(Ledger)
+	_ = o
+	if c := borsh.Compare(s.Name, o.Name); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Counts, o.Counts); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Points, o.Points); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.ByPoint, o.ByPoint); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Groups, o.Groups); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Nested, o.Nested); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Blobs, o.Blobs); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Raw, o.Raw); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Chains, o.Chains); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Balances, o.Balances); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Optional, o.Optional); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.History, o.History); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Ledger in fields typed by an interface
+func (Ledger) BorshTypeID() uint64 {
+	return 0xd6041dbdb62f9a47
+}
+func init() {
+	Registry.Register(0xd6041dbdb62f9a47, func() borsh.BorshEncoder { return new(Ledger) })
+}
+
+// BorshSchema describes the Borsh encoding of Ledger in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Ledger) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Ledger",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"(Point, String)": borsh.TupleDef("Point", "String"),
+			"(String, BTreeMap<i8, bool>)": borsh.TupleDef("String", "BTreeMap<i8, bool>"),
+			"(String, Point)": borsh.TupleDef("String", "Point"),
+			"(String, Status)": borsh.TupleDef("String", "Status"),
+			"(String, Vec<u32>)": borsh.TupleDef("String", "Vec<u32>"),
+			"(String, Vec<u8>)": borsh.TupleDef("String", "Vec<u8>"),
+			"(String, i64)": borsh.TupleDef("String", "i64"),
+			"(String, u64)": borsh.TupleDef("String", "u64"),
+			"(String, u8)": borsh.TupleDef("String", "u8"),
+			"(i8, bool)": borsh.TupleDef("i8", "bool"),
+			"(u32, u16)": borsh.TupleDef("u32", "u16"),
+			"(u8, Vec<u8>)": borsh.TupleDef("u8", "Vec<u8>"),
+			"BTreeMap<Point, String>": borsh.SequenceDef(4, 0, 4294967295, "(Point, String)"),
+			"BTreeMap<String, BTreeMap<i8, bool>>": borsh.SequenceDef(4, 0, 4294967295, "(String, BTreeMap<i8, bool>)"),
+			"BTreeMap<String, Point>": borsh.SequenceDef(4, 0, 4294967295, "(String, Point)"),
+			"BTreeMap<String, Status>": borsh.SequenceDef(4, 0, 4294967295, "(String, Status)"),
+			"BTreeMap<String, Vec<u32>>": borsh.SequenceDef(4, 0, 4294967295, "(String, Vec<u32>)"),
+			"BTreeMap<String, Vec<u8>>": borsh.SequenceDef(4, 0, 4294967295, "(String, Vec<u8>)"),
+			"BTreeMap<String, i64>": borsh.SequenceDef(4, 0, 4294967295, "(String, i64)"),
+			"BTreeMap<String, u64>": borsh.SequenceDef(4, 0, 4294967295, "(String, u64)"),
+			"BTreeMap<String, u8>": borsh.SequenceDef(4, 0, 4294967295, "(String, u8)"),
+			"BTreeMap<i8, bool>": borsh.SequenceDef(4, 0, 4294967295, "(i8, bool)"),
+			"BTreeMap<u32, u16>": borsh.SequenceDef(4, 0, 4294967295, "(u32, u16)"),
+			"BTreeMap<u8, Vec<u8>>": borsh.SequenceDef(4, 0, 4294967295, "(u8, Vec<u8>)"),
+			"Ledger": borsh.StructDef(
+				borsh.Field{Name: "name", Declaration: "String"},
+				borsh.Field{Name: "counts", Declaration: "BTreeMap<u32, u16>"},
+				borsh.Field{Name: "points", Declaration: "BTreeMap<String, Point>"},
+				borsh.Field{Name: "by_point", Declaration: "BTreeMap<Point, String>"},
+				borsh.Field{Name: "groups", Declaration: "BTreeMap<String, Vec<u32>>"},
+				borsh.Field{Name: "nested", Declaration: "BTreeMap<String, BTreeMap<i8, bool>>"},
+				borsh.Field{Name: "blobs", Declaration: "BTreeMap<u8, Vec<u8>>"},
+				borsh.Field{Name: "raw", Declaration: "BTreeMap<String, Vec<u8>>"},
+				borsh.Field{Name: "chains", Declaration: "BTreeMap<String, Status>"},
+				borsh.Field{Name: "balances", Declaration: "BTreeMap<String, u64>"},
+				borsh.Field{Name: "optional", Declaration: "Option<BTreeMap<String, i64>>"},
+				borsh.Field{Name: "history", Declaration: "Vec<BTreeMap<String, u8>>"},),
+			"Option<BTreeMap<String, i64>>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "BTreeMap<String, i64>"}),
+			"Point": borsh.StructDef(
+				borsh.Field{Name: "x", Declaration: "i32"},
+				borsh.Field{Name: "y", Declaration: "i32"},),
+			"Status": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "StatusPending", Declaration: "StatusPending"},
+				borsh.Variant{Discriminant: 1, Name: "StatusActive", Declaration: "StatusActive"},
+				borsh.Variant{Discriminant: 2, Name: "StatusClosed", Declaration: "StatusClosed"}),
+			"StatusActive": borsh.StructDef(),
+			"StatusClosed": borsh.StructDef(),
+			"StatusPending": borsh.StructDef(),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<BTreeMap<String, u8>>": borsh.SequenceDef(4, 0, 4294967295, "BTreeMap<String, u8>"),
+			"Vec<u32>": borsh.SequenceDef(4, 0, 4294967295, "u32"),
+			"Vec<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"bool": borsh.PrimitiveDef(1),
+			"i32": borsh.PrimitiveDef(4),
+			"i64": borsh.PrimitiveDef(8),
+			"i8": borsh.PrimitiveDef(1),
+			"u16": borsh.PrimitiveDef(2),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Tally) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Tally) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Tally", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Tally to buf.
+// The FieldPath of a returned EncodeError is relative to Tally
+func (s Tally) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Tally) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Tally in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Tally) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Tally", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Tally in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Tally) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Tally", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Tally to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Tally) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Tally", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Tally to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Tally
+func (s Tally) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Counts (counts) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Counts)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Counts", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Counts)))
+			for mk, mv := range (s.Counts) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(mk))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Counts", err)
+			}
+		}
+		}
+	return nil
+}
+func (s *Tally) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Tally", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Tally like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Tally, without trailing bytes
+func (s *Tally) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Tally", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Tally in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Tally, so wrap unbuffered readers in a bufio.Reader
+func (s *Tally) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Tally", err)
+}
+
+// ReadBorsh decodes Tally nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Tally
+func (s *Tally) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Tally is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Tally
+    var err error
+		{
+		
+				// Counts (counts) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Counts", err)
+			}
+			mp, err := borsh.MakeMap[map[uint16]uint8](r, mapLen)
+			if err != nil {
+				return r.FieldError("Counts", err)
+			}
+			var prevKey uint16
+			for mi := 0; mi < mapLen; mi++ {
+				var mk uint16
+				var mv uint8
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError(borsh.Index("Counts", mi), err)
+	}
+	__m := uint16(__v)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Counts", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Key("Counts", mk), err)
+	}
+	__m := uint8(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Counts = mp
+		}
+		}
+	return err
+}
+func (s Tally) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Counts (counts) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Counts) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+				size += 2
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+				size += 1
+			}
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Tally by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Tally) CompareBorsh(other any) int {
+	o := other.(Tally)
+	_ = o
+	if c := borsh.Compare(s.Counts, o.Counts); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Tally in fields typed by an interface
+func (Tally) BorshTypeID() uint64 {
+	return 0xe52cdcdb916b1a58
+}
+func init() {
+	Registry.Register(0xe52cdcdb916b1a58, func() borsh.BorshEncoder { return new(Tally) })
+}
+
+// BorshSchema describes the Borsh encoding of Tally in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Tally) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Tally",
+		Definitions: map[string]borsh.Definition{
+			"(u16, u8)": borsh.TupleDef("u16", "u8"),
+			"BTreeMap<u16, u8>": borsh.SequenceDef(4, 0, 4294967295, "(u16, u8)"),
+			"Tally": borsh.StructDef(
+				borsh.Field{Name: "counts", Declaration: "BTreeMap<u16, u8>"},),
+			"u16": borsh.PrimitiveDef(2),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Cell) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Cell) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Cell", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Cell to buf.
+// The FieldPath of a returned EncodeError is relative to Cell
+func (s Cell) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Cell) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Cell in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Cell) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Cell", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Cell in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Cell) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Cell", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Cell to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Cell) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Cell", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Cell to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Cell
+func (s Cell) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.Key))
+		}
+	return nil
+}
+func (s *Cell) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Cell", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Cell like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Cell, without trailing bytes
+func (s *Cell) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Cell", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Cell in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Cell, so wrap unbuffered readers in a bufio.Reader
+func (s *Cell) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Cell", err)
+}
+
+// ReadBorsh decodes Cell nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Cell
+func (s *Cell) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Cell is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Cell
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Key", err)
+	}
+	__m := uint8(__v)
+	s.Key = (__m)
+		}
+	return err
+}
+func (s Cell) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Cell by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Cell) CompareBorsh(other any) int {
+	o := other.(Cell)
+	_ = o
+	if c := borsh.Compare(s.Key, o.Key); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Cell in fields typed by an interface
+func (Cell) BorshTypeID() uint64 {
+	return 0xd3fd9b8ffd538443
+}
+func init() {
+	Registry.Register(0xd3fd9b8ffd538443, func() borsh.BorshEncoder { return new(Cell) })
+}
+
+// BorshSchema describes the Borsh encoding of Cell in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Cell) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Cell",
+		Definitions: map[string]borsh.Definition{
+			"Cell": borsh.StructDef(
+				borsh.Field{Name: "key", Declaration: "u8"},),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Grid) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Grid) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Grid", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Grid to buf.
+// The FieldPath of a returned EncodeError is relative to Grid
+func (s Grid) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Grid) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Grid in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Grid) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Grid", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Grid in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Grid) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Grid", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Grid to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Grid) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Grid", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Grid to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Grid
+func (s Grid) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Cells (cells) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Cells)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Cells", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Cells)))
+			for mk, mv := range (s.Cells) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Hint uint8 "msg:\"-\""; Key uint8 "msg:\"key\""}
+					if err := writeNested(buf, mk); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Cells", mk), err)
+					}
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mv
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Cells", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Cells", err)
+			}
+		}
+		}
+	return nil
+}
+func (s *Grid) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Grid", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Grid like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Grid, without trailing bytes
+func (s *Grid) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Grid", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Grid in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Grid, so wrap unbuffered readers in a bufio.Reader
+func (s *Grid) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Grid", err)
+}
+
+// ReadBorsh decodes Grid nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Grid
+func (s *Grid) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Grid is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Grid
+    var err error
+		{
+		
+				// Cells (cells) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Cells", err)
+			}
+			mp, err := borsh.MakeMap[map[Cell]string](r, mapLen)
+			if err != nil {
+				return r.FieldError("Cells", err)
+			}
+			var prevKey Cell
+			for mi := 0; mi < mapLen; mi++ {
+				var mk Cell
+				var mv string
+				{
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Hint uint8 "msg:\"-\""; Key uint8 "msg:\"key\""}
+			// Element Cell
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Cell{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Cells", mi), err)
+					}
+						mk = *m
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Cells", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Key("Cells", mk), err)
+	}
+	__m := string(__s)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Cells = mp
+		}
+		}
+	return err
+}
+func (s Grid) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Cells (cells) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Cells) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Hint uint8 "msg:\"-\""; Key uint8 "msg:\"key\""}
+			// Element Cell
+			bs, err := borsh.SizeOf(mk)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Cells", err)
+			}
+					size += NestedPrefixSize + bs
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mv
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Grid by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Grid) CompareBorsh(other any) int {
+	o := other.(Grid)
+	_ = o
+	if c := borsh.Compare(s.Cells, o.Cells); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Grid in fields typed by an interface
+func (Grid) BorshTypeID() uint64 {
+	return 0xdd3f9b1b62aeeb1d
+}
+func init() {
+	Registry.Register(0xdd3f9b1b62aeeb1d, func() borsh.BorshEncoder { return new(Grid) })
+}
+
+// BorshSchema describes the Borsh encoding of Grid in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Grid) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Grid",
+		Definitions: map[string]borsh.Definition{
+			"(Cell, String)": borsh.TupleDef("Cell", "String"),
+			"BTreeMap<Cell, String>": borsh.SequenceDef(4, 0, 4294967295, "(Cell, String)"),
+			"Cell": borsh.StructDef(
+				borsh.Field{Name: "key", Declaration: "u8"},),
+			"Grid": borsh.StructDef(
+				borsh.Field{Name: "cells", Declaration: "BTreeMap<Cell, String>"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/tests/configs"
+	"github.com/mlayerprotocol/go-borshgen/tests/constants"
+)
+
+func TestMapLayout(t *testing.T) {
+	v := Tally{Counts: map[uint16]uint8{1: 10, 2: 20, 256: 30}}
+
+	// Keys are sorted by value, as Rust's BTreeMap orders them: 256 comes last,
+	// although its encoding 00 01 is the smallest
+	want := []byte{
+		3, 0, 0, 0, // entry count
+		1, 0, 10,
+		2, 0, 20,
+		0, 1, 30,
+	}
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(want) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(want))
+	}
+
+	var empty Tally
+	data, err = empty.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() of a nil map failed: %v", err)
+	}
+	if !bytes.Equal(data, []byte{0, 0, 0, 0}) {
+		t.Errorf("nil map = %v, want an empty map", data)
+	}
+}
+
+func TestMapRoundTrip(t *testing.T) {
+	optional := map[string]int64{"a": -1, "b": 2}
+	original := Ledger{
+		Name:    "ledger",
+		Counts:  map[uint32]uint16{7: 1, 1 << 20: 2, 3: 3},
+		Points:  map[string]Point{"origin": {}, "unit": {X: 1, Y: 1}},
+		ByPoint: map[Point]string{{X: -1, Y: 2}: "a", {X: 3, Y: -4}: "b"},
+		Groups:  map[string][]uint32{"odd": {1, 3, 5}, "even": {2, 4}, "none": {}},
+		Nested:  map[string]map[int8]bool{"x": {-1: true, 1: false}, "y": {}},
+		Blobs:   map[uint8][]byte{1: []byte("one"), 2: []byte("two")},
+		Raw:     map[string]json.RawMessage{"k": json.RawMessage(`{"v":1}`)},
+		Chains: map[configs.ChainId]constants.Status{
+			"eth": constants.StatusActive,
+			"sol": constants.StatusClosed,
+		},
+		Balances: Balances{"alice": 10, "bob": 20},
+		Optional: &optional,
+		History:  []map[string]uint8{{"a": 1}, {}, {"b": 2, "c": 3}},
+	}
+
+	data, err := original.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	size, err := original.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(data) {
+		t.Errorf("BinarySize() = %d, marshaled %d bytes", size, len(data))
+	}
+
+	var restored Ledger
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(restored, original) {
+		t.Errorf("round trip mismatch:\n got %+v\nwant %+v", restored, original)
+	}
+
+	// Go randomizes map iteration, the encoding must not depend on it
+	for i := 0; i < 20; i++ {
+		again, err := original.MarshalBorsh()
+		if err != nil {
+			t.Fatalf("MarshalBorsh() failed: %v", err)
+		}
+		if !bytes.Equal(again, data) {
+			t.Fatal("MarshalBorsh() is not deterministic")
+		}
+		encoded, err := original.Encode()
+		if err != nil {
+			t.Fatalf("Encode() failed: %v", err)
+		}
+		encodedAgain, err := original.Encode()
+		if err != nil {
+			t.Fatalf("Encode() failed: %v", err)
+		}
+		if !bytes.Equal(encoded, encodedAgain) {
+			t.Fatal("Encode() is not deterministic")
+		}
+	}
+}
+
+func TestMapStrictDecoding(t *testing.T) {
+	if !StrictDecoding {
+		t.Fatal("package must be generated with -strict")
+	}
+	cases := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"unsorted", []byte{2, 0, 0, 0, 2, 0, 20, 1, 0, 10}, "not sorted"},
+		{"sorted by encoding", []byte{2, 0, 0, 0, 0, 1, 30, 1, 0, 10}, "not sorted"},
+		{"duplicate", []byte{2, 0, 0, 0, 1, 0, 10, 1, 0, 20}, "duplicate map key"},
+		{"truncated", []byte{2, 0, 0, 0, 1, 0, 10}, "buffer too short"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var v Tally
+			err := v.UnmarshalBorsh(tc.data)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("UnmarshalBorsh() error = %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestMapIgnoredKeyField(t *testing.T) {
+	// Ordered by Hint, the keys would be written 1 then 0
+	v := Grid{Cells: map[Cell]string{{Hint: 1, Key: 0}: "a", {Hint: 0, Key: 1}: "b"}}
+	want := []byte{
+		2, 0, 0, 0, // entry count
+		0, 1, 0, 0, 0, 'a',
+		1, 1, 0, 0, 0, 'b',
+	}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+
+	var got Grid
+	if err := got.UnmarshalBorshStrict(data); err != nil {
+		t.Fatalf("UnmarshalBorshStrict() failed: %v", err)
+	}
+	wantCells := map[Cell]string{{Key: 0}: "a", {Key: 1}: "b"}
+	if !reflect.DeepEqual(got.Cells, wantCells) {
+		t.Errorf("UnmarshalBorshStrict() = %v, want %v", got.Cells, wantCells)
+	}
+}
@@ -0,0 +1,27 @@
+package numeric
+
+import (
+	"math/big"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Amounts struct {
+	Fixed    [4]byte         `msg:"fixed"`
+	Supply   borsh.Uint128   `msg:"supply"`
+	Balance  borsh.Int128    `msg:"balance"`
+	Amount   *big.Int        `msg:"amount,u128"`
+	Debt     *big.Int        `msg:"debt,i128"`
+	Total    *big.Int        `msg:"total"`
+	Payments []*big.Int      `msg:"payments,u128"`
+	Supplies []borsh.Uint128 `msg:"supplies"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Counters struct {
+	Delta int    `msg:"delta"`
+	Count uint   `msg:"count"`
+	Sizes []uint `msg:"sizes"`
+	Limit *uint  `msg:"limit"`
+}
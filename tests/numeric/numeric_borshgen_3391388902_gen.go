@@ -0,0 +1,925 @@
+package numeric
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"math/big"
+)
+var _ big.Int
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Amounts) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Amounts) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Amounts", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Amounts to buf.
+// The FieldPath of a returned EncodeError is relative to Amounts
+func (s Amounts) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Amounts) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Amounts in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Amounts) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Amounts", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Amounts in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Amounts) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Amounts", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Amounts to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Amounts) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Amounts", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Amounts to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Amounts
+func (s Amounts) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Fixed (fixed) - slice
+				// ElementType: [4]byte
+				// Type: [4]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [4]Fixed
+	for i := 0; i < 4; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(s.Fixed[i]))
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint128
+					_v128 := s.Supply
+					buf.Write(_v128[:])
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int128
+					_v128 := s.Balance
+					buf.Write(_v128[:])
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: u128
+					if err := buf.WriteU128(s.Amount); err != nil {
+						return borsh.EncodeFieldError("Amount", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: i128
+					if err := buf.WriteI128(s.Debt); err != nil {
+						return borsh.EncodeFieldError("Debt", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: u128
+					if err := buf.WriteU128(s.Total); err != nil {
+						return borsh.EncodeFieldError("Total", err)
+					}
+		}
+		{
+		
+				// Payments (payments) - slice
+				// ElementType: []*big.Int
+				// Type: []*big.Int
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Payments: []Payments
+	if err := checkLength("slice", len((s.Payments)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Payments", err)
+	}
+	 appendLength(buf, len((s.Payments)))
+		for i, item := range (s.Payments) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType u128
+	
+					// BASICTYPE: true
+					// ELNTYPE: u128
+					if err := buf.WriteU128(item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Payments", i), err)
+					}
+		}
+		}
+		{
+		
+				// Supplies (supplies) - slice
+				// ElementType: []borsh.Uint128
+				// Type: []borsh.Uint128
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Supplies: []Supplies
+	if err := checkLength("slice", len((s.Supplies)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Supplies", err)
+	}
+	 appendLength(buf, len((s.Supplies)))
+		for i, item := range (s.Supplies) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint128
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint128
+					_v128 := item
+					buf.Write(_v128[:])
+		}
+		}
+	return nil
+}
+func (s *Amounts) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Amounts", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Amounts like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Amounts, without trailing bytes
+func (s *Amounts) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Amounts", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Amounts in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Amounts, so wrap unbuffered readers in a bufio.Reader
+func (s *Amounts) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Amounts", err)
+}
+
+// ReadBorsh decodes Amounts nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Amounts
+func (s *Amounts) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Amounts is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Amounts
+    var err error
+		{
+		
+				// Fixed (fixed) - slice
+				// ElementType: [4]byte
+				// Type: [4]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [4]byte{}
+	length := 4
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Fixed", i), err)
+	}
+	__m := byte(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Fixed = (p)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError("Supply", err)
+	}
+	__m := borsh.Uint128{}
+	copy(__m[:], __b)
+	s.Supply = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError("Balance", err)
+	}
+	__m := borsh.Int128{}
+	copy(__m[:], __b)
+	s.Balance = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	s.Amount, err = r.ReadU128()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	s.Debt, err = r.ReadI128()
+	if err != nil {
+		return r.FieldError("Debt", err)
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	s.Total, err = r.ReadU128()
+	if err != nil {
+		return r.FieldError("Total", err)
+	}
+		}
+		{
+		
+				// Payments (payments) - slice
+				// ElementType: []*big.Int
+				// Type: []*big.Int
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Payments: []Payments
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Payments", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]*big.Int](r, length)
+		if err != nil {
+			return r.FieldError("Payments", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType u128
+			// Element big.Int
+	p[i], err = r.ReadU128()
+	if err != nil {
+		return r.FieldError(borsh.Index("Payments", i), err)
+	}
+		}
+				s.Payments =  p
+		}
+		{
+		
+				// Supplies (supplies) - slice
+				// ElementType: []borsh.Uint128
+				// Type: []borsh.Uint128
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Supplies: []Supplies
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Supplies", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]borsh.Uint128](r, length)
+		if err != nil {
+			return r.FieldError("Supplies", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint128
+			// Element borsh.Uint128
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError(borsh.Index("Supplies", i), err)
+	}
+	__m := borsh.Uint128{}
+	copy(__m[:], __b)
+	p[i] = (__m)
+		}
+				s.Supplies =  p
+		}
+	return err
+}
+func (s Amounts) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Fixed (fixed) - slice
+				// ElementType: [4]byte
+				// Type: [4]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [4]Fixed
+	for _, item := range  (s.Fixed) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+	}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+		
+				// Payments (payments) - slice
+				// ElementType: []*big.Int
+				// Type: []*big.Int
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Payments: []Payments
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Payments) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType u128
+			// Element big.Int
+				size += 16
+		}
+		}
+		{
+		
+				// Supplies (supplies) - slice
+				// ElementType: []borsh.Uint128
+				// Type: []borsh.Uint128
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Supplies: []Supplies
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Supplies) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint128
+			// Element borsh.Uint128
+				size += 16
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Amounts by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Amounts) CompareBorsh(other any) int {
+	o := other.(Amounts)
+	_ = o
+	if c := borsh.Compare(s.Fixed, o.Fixed); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Supply, o.Supply); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Balance, o.Balance); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Debt, o.Debt); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Total, o.Total); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Payments, o.Payments); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Supplies, o.Supplies); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Amounts in fields typed by an interface
+func (Amounts) BorshTypeID() uint64 {
+	return 0x3726eef8f37260c8
+}
+func init() {
+	Registry.Register(0x3726eef8f37260c8, func() borsh.BorshEncoder { return new(Amounts) })
+}
+
+// BorshSchema describes the Borsh encoding of Amounts in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Amounts) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Amounts",
+		Definitions: map[string]borsh.Definition{
+			"Amounts": borsh.StructDef(
+				borsh.Field{Name: "fixed", Declaration: "[u8; 4]"},
+				borsh.Field{Name: "supply", Declaration: "u128"},
+				borsh.Field{Name: "balance", Declaration: "i128"},
+				borsh.Field{Name: "amount", Declaration: "u128"},
+				borsh.Field{Name: "debt", Declaration: "i128"},
+				borsh.Field{Name: "total", Declaration: "u128"},
+				borsh.Field{Name: "payments", Declaration: "Vec<u128>"},
+				borsh.Field{Name: "supplies", Declaration: "Vec<u128>"},),
+			"Vec<u128>": borsh.SequenceDef(4, 0, 4294967295, "u128"),
+			"[u8; 4]": borsh.SequenceDef(0, 4, 4, "u8"),
+			"i128": borsh.PrimitiveDef(16),
+			"u128": borsh.PrimitiveDef(16),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Counters) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Counters) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Counters", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Counters to buf.
+// The FieldPath of a returned EncodeError is relative to Counters
+func (s Counters) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Counters) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Counters in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Counters) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Counters", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Counters in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Counters) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Counters", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Counters to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Counters) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Counters", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Counters to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Counters
+func (s Counters) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int
+					buf.WriteUint64(uint64(s.Delta))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint
+					buf.WriteUint64(uint64(s.Count))
+		}
+		{
+		
+				// Sizes (sizes) - slice
+				// ElementType: []uint
+				// Type: []uint
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sizes: []Sizes
+	if err := checkLength("slice", len((s.Sizes)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Sizes", err)
+	}
+	 appendLength(buf, len((s.Sizes)))
+		for i, item := range (s.Sizes) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint
+					buf.WriteUint64(uint64(item))
+		}
+		}
+		{
+			if s.Limit == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPLimit
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Limit (limit) - Pointer
+					// ElementType: uint
+					// Type: uint
+					// ActualType: *uint
+					// BasicType: true
+			
+					// ElementType: uint
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint
+					buf.WriteUint64(uint64(*s.Limit))
+		}
+					SKIPLimit:
+	return nil
+}
+func (s *Counters) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Counters", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Counters like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Counters, without trailing bytes
+func (s *Counters) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Counters", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Counters in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Counters, so wrap unbuffered readers in a bufio.Reader
+func (s *Counters) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Counters", err)
+}
+
+// ReadBorsh decodes Counters nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Counters
+func (s *Counters) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Counters is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Counters
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Delta", err)
+	}
+	__m := int(__v)
+	s.Delta = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Count", err)
+	}
+	__m := uint(__v)
+	s.Count = (__m)
+		}
+		{
+		
+				// Sizes (sizes) - slice
+				// ElementType: []uint
+				// Type: []uint
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sizes: []Sizes
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Sizes", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]uint](r, length)
+		if err != nil {
+			return r.FieldError("Sizes", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint
+			// Element uint
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError(borsh.Index("Sizes", i), err)
+	}
+	__m := uint(__v)
+	p[i] = (__m)
+		}
+				s.Sizes =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Limit", err)
+				}
+				if !present {
+					s.Limit = nil
+					goto SKIPLimit
+				} 
+			}
+		{
+		
+					// Limit (limit) - Pointer
+					// ElementType: uint
+					// Type: uint
+					// ActualType: *uint
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: uint
+					// IsPointer: true
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Limit", err)
+	}
+	__m := uint(__v)
+	s.Limit = &(__m)
+		}
+					SKIPLimit:
+	return err
+}
+func (s Counters) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+				// Sizes (sizes) - slice
+				// ElementType: []uint
+				// Type: []uint
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sizes: []Sizes
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Sizes) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint
+			// Element uint
+				size += 8
+		}
+		}
+		{
+			size++
+			if s.Limit == nil {
+				goto SKIPLimit
+			}
+		}
+		{
+		
+					// Limit (limit) - Pointer
+					// ElementType: uint
+					// Type: uint
+					// ActualType: *uint
+					// BasicType: true
+			
+					// ElementType: uint
+	
+		// ElementType: uint
+		
+			// Element: true
+				size += 8
+		}
+			SKIPLimit:
+	return size, nil
+}
+
+// CompareBorsh orders Counters by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Counters) CompareBorsh(other any) int {
+	o := other.(Counters)
+	_ = o
+	if c := borsh.Compare(s.Delta, o.Delta); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Count, o.Count); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Sizes, o.Sizes); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Limit, o.Limit); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Counters in fields typed by an interface
+func (Counters) BorshTypeID() uint64 {
+	return 0x3513d3b1ff4c94aa
+}
+func init() {
+	Registry.Register(0x3513d3b1ff4c94aa, func() borsh.BorshEncoder { return new(Counters) })
+}
+
+// BorshSchema describes the Borsh encoding of Counters in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Counters) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Counters",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Counters": borsh.StructDef(
+				borsh.Field{Name: "delta", Declaration: "i64"},
+				borsh.Field{Name: "count", Declaration: "u64"},
+				borsh.Field{Name: "sizes", Declaration: "Vec<u64>"},
+				borsh.Field{Name: "limit", Declaration: "Option<u64>"},),
+			"Option<u64>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "u64"}),
+			"Vec<u64>": borsh.SequenceDef(4, 0, 4294967295, "u64"),
+			"i64": borsh.PrimitiveDef(8),
+			"u64": borsh.PrimitiveDef(8),
+		},
+	}
+}
@@ -0,0 +1,182 @@
+package numeric
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+func mustBig(t *testing.T, s string) *big.Int {
+	t.Helper()
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("invalid big.Int literal %q", s)
+	}
+	return v
+}
+
+func TestInt128Layout(t *testing.T) {
+	v := Amounts{
+		Supply:   borsh.NewUint128(1, 2),
+		Balance:  borsh.Int128FromInt64(-2),
+		Amount:   big.NewInt(258),
+		Debt:     big.NewInt(-1),
+		Payments: []*big.Int{big.NewInt(5)},
+		Supplies: []borsh.Uint128{borsh.Uint128FromUint64(7)},
+	}
+
+	var want []byte
+	want = append(want, 0, 0, 0, 0)                                     // Fixed
+	want = append(want, 2, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0) // Supply: lo, hi
+	want = append(want, bytes.Repeat([]byte{0xff}, 16)...)
+	want[20] = 0xfe                                                     // Balance: -2
+	want = append(want, 2, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0) // Amount: 258
+	want = append(want, bytes.Repeat([]byte{0xff}, 16)...)              // Debt: -1
+	want = append(want, make([]byte, 16)...)                            // Total: nil is written as zero
+	want = append(want, 1, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	want = append(want, 1, 0, 0, 0, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(want) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(want))
+	}
+}
+
+func TestInt128RoundTrip(t *testing.T) {
+	maxU128 := mustBig(t, "340282366920938463463374607431768211455")
+	minI128 := mustBig(t, "-170141183460469231731687303715884105728")
+	supply, err := borsh.Uint128FromBig(maxU128)
+	if err != nil {
+		t.Fatalf("Uint128FromBig() failed: %v", err)
+	}
+	balance, err := borsh.Int128FromBig(minI128)
+	if err != nil {
+		t.Fatalf("Int128FromBig() failed: %v", err)
+	}
+
+	v := Amounts{
+		Fixed:    [4]byte{1, 2, 3, 4},
+		Supply:   supply,
+		Balance:  balance,
+		Amount:   maxU128,
+		Debt:     minI128,
+		Total:    big.NewInt(0),
+		Payments: []*big.Int{big.NewInt(1), mustBig(t, "18446744073709551616")},
+		Supplies: []borsh.Uint128{borsh.NewUint128(3, 4)},
+	}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+
+	var restored Amounts
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if restored.Amount.Cmp(maxU128) != 0 || restored.Debt.Cmp(minI128) != 0 || restored.Total.Sign() != 0 {
+		t.Errorf("big.Int mismatch: got %v %v %v", restored.Amount, restored.Debt, restored.Total)
+	}
+	if restored.Supply.String() != maxU128.String() || restored.Balance.String() != minI128.String() {
+		t.Errorf("fixed type mismatch: got %s %s", restored.Supply, restored.Balance)
+	}
+	if len(restored.Payments) != 2 || restored.Payments[1].String() != "18446744073709551616" {
+		t.Errorf("Payments mismatch: got %v", restored.Payments)
+	}
+	if !reflect.DeepEqual(restored.Supplies, v.Supplies) || restored.Fixed != v.Fixed {
+		t.Errorf("round trip mismatch: got %+v, want %+v", restored, v)
+	}
+}
+
+func TestInt128OutOfRange(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Amounts
+	}{
+		{"u128 negative", Amounts{Amount: big.NewInt(-1)}},
+		{"u128 overflow", Amounts{Amount: new(big.Int).Lsh(big.NewInt(1), 128)}},
+		{"i128 overflow", Amounts{Debt: new(big.Int).Lsh(big.NewInt(1), 127)}},
+		{"slice overflow", Amounts{Payments: []*big.Int{new(big.Int).Lsh(big.NewInt(1), 130)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.v.MarshalBorsh()
+			if err == nil || !strings.Contains(err.Error(), "out of range") {
+				t.Errorf("MarshalBorsh() error = %v, want out of range error", err)
+			}
+		})
+	}
+}
+
+func TestInt128Conversions(t *testing.T) {
+	if got := borsh.Int128FromInt64(-5).String(); got != "-5" {
+		t.Errorf("Int128FromInt64(-5) = %s", got)
+	}
+	if got := borsh.NewUint128(1, 0).String(); got != "18446744073709551616" {
+		t.Errorf("NewUint128(1, 0) = %s", got)
+	}
+	if borsh.Int128FromInt64(-5).Sign() != -1 || borsh.Int128FromInt64(0).Sign() != 0 {
+		t.Errorf("Int128.Sign() mismatch")
+	}
+	if _, err := borsh.Uint128FromBig(big.NewInt(-1)); err == nil {
+		t.Errorf("Uint128FromBig(-1) should fail")
+	}
+	if _, err := borsh.Int128FromBig(mustBig(t, "-170141183460469231731687303715884105729")); err == nil {
+		t.Errorf("Int128FromBig(min-1) should fail")
+	}
+}
+
+func TestInt128Truncated(t *testing.T) {
+	data, err := Amounts{Amount: big.NewInt(1)}.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	var restored Amounts
+	if err := restored.UnmarshalBorsh(data[:30]); err == nil {
+		t.Errorf("UnmarshalBorsh() on truncated data should fail")
+	}
+}
+
+func TestPlatformIntLayout(t *testing.T) {
+	limit := uint(9)
+	v := Counters{Delta: -2, Count: 258, Sizes: []uint{5}, Limit: &limit}
+
+	var want []byte
+	want = append(want, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff) // Delta: i64
+	want = append(want, 2, 1, 0, 0, 0, 0, 0, 0)                         // Count: u64
+	want = append(want, 1, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0)             // Sizes
+	want = append(want, 1, 9, 0, 0, 0, 0, 0, 0, 0)                      // Limit: Some
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+	if size, err := v.BinarySize(); err != nil || size != len(want) {
+		t.Errorf("BinarySize() = %d, %v, want %d", size, err, len(want))
+	}
+
+	var restored Counters
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(restored, v) {
+		t.Errorf("UnmarshalBorsh() = %+v, want %+v", restored, v)
+	}
+}
@@ -0,0 +1,43 @@
+package polymorphic
+
+// Shape is implemented by the shapes a Drawing holds. It is not a Borsh enum, so a Drawing
+// writes the type ID of each shape before it and decodes it through Registry
+type Shape interface {
+	Area() float64
+}
+
+//go:generate borshgen -tag=msg -wire=borsh -gen-tests
+type Circle struct {
+	Radius float64 `msg:"radius"`
+}
+
+func (c Circle) Area() float64 { return 3 * c.Radius * c.Radius }
+
+// Square keeps its type ID if it is renamed or moved
+//
+//borshgen:typeid 0x5157
+//go:generate borshgen -tag=msg -wire=borsh -gen-tests
+type Square struct {
+	Side float64 `msg:"side"`
+}
+
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+// Polygon implements Shape on its pointer, so it decodes as a *Polygon
+//
+//go:generate borshgen -tag=msg -wire=borsh -gen-tests
+type Polygon struct {
+	Sides []float64 `msg:"sides"`
+}
+
+func (p *Polygon) Area() float64 { return 0 }
+
+//go:generate borshgen -tag=msg -wire=borsh -gen-tests
+type Drawing struct {
+	Title  string  `msg:"title"`
+	Main   Shape   `msg:"main"`
+	Shapes []Shape `msg:"shapes"`
+	Extra  any     `msg:"extra"`
+	Items  []any   `msg:"items"`
+	Hidden *Shape  `msg:"hidden"`
+}
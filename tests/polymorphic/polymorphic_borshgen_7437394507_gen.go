@@ -0,0 +1,1077 @@
+package polymorphic
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Circle) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Circle) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Circle", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Circle to buf.
+// The FieldPath of a returned EncodeError is relative to Circle
+func (s Circle) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Circle) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Circle in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Circle) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Circle", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Circle in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Circle) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Circle", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Circle to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Circle) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Circle", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Circle to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Circle
+func (s Circle) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(s.Radius)
+		}
+	return nil
+}
+func (s *Circle) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Circle", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Circle like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Circle, without trailing bytes
+func (s *Circle) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Circle", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Circle in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Circle, so wrap unbuffered readers in a bufio.Reader
+func (s *Circle) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Circle", err)
+}
+
+// ReadBorsh decodes Circle nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Circle
+func (s *Circle) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Circle is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Circle
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadFloat64()
+	if err != nil {
+		return r.FieldError("Radius", err)
+	}
+	__m := float64(__v)
+	s.Radius = (__m)
+		}
+	return err
+}
+func (s Circle) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Circle by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Circle) CompareBorsh(other any) int {
+	o := other.(Circle)
+	_ = o
+	if c := borsh.Compare(s.Radius, o.Radius); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Circle in fields typed by an interface
+func (Circle) BorshTypeID() uint64 {
+	return 0x500f09e2e3ea5dd1
+}
+func init() {
+	Registry.Register(0x500f09e2e3ea5dd1, func() borsh.BorshEncoder { return new(Circle) })
+}
+
+// BorshSchema describes the Borsh encoding of Circle in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Circle) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Circle",
+		Definitions: map[string]borsh.Definition{
+			"Circle": borsh.StructDef(
+				borsh.Field{Name: "radius", Declaration: "f64"},),
+			"f64": borsh.PrimitiveDef(8),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Square) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Square) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Square", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Square to buf.
+// The FieldPath of a returned EncodeError is relative to Square
+func (s Square) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Square) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Square in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Square) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Square", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Square in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Square) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Square", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Square to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Square) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Square", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Square to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Square
+func (s Square) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(s.Side)
+		}
+	return nil
+}
+func (s *Square) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Square", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Square like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Square, without trailing bytes
+func (s *Square) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Square", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Square in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Square, so wrap unbuffered readers in a bufio.Reader
+func (s *Square) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Square", err)
+}
+
+// ReadBorsh decodes Square nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Square
+func (s *Square) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Square is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Square
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadFloat64()
+	if err != nil {
+		return r.FieldError("Side", err)
+	}
+	__m := float64(__v)
+	s.Side = (__m)
+		}
+	return err
+}
+func (s Square) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Square by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Square) CompareBorsh(other any) int {
+	o := other.(Square)
+	_ = o
+	if c := borsh.Compare(s.Side, o.Side); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Square in fields typed by an interface
+func (Square) BorshTypeID() uint64 {
+	return 0x5157
+}
+func init() {
+	Registry.Register(0x5157, func() borsh.BorshEncoder { return new(Square) })
+}
+
+// BorshSchema describes the Borsh encoding of Square in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Square) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Square",
+		Definitions: map[string]borsh.Definition{
+			"Square": borsh.StructDef(
+				borsh.Field{Name: "side", Declaration: "f64"},),
+			"f64": borsh.PrimitiveDef(8),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Polygon) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Polygon) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Polygon", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Polygon to buf.
+// The FieldPath of a returned EncodeError is relative to Polygon
+func (s Polygon) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Polygon) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Polygon in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Polygon) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Polygon", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Polygon in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Polygon) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Polygon", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Polygon to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Polygon) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Polygon", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Polygon to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Polygon
+func (s Polygon) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Sides (sides) - slice
+				// ElementType: []float64
+				// Type: []float64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sides: []Sides
+	if err := checkLength("slice", len((s.Sides)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Sides", err)
+	}
+	 appendLength(buf, len((s.Sides)))
+		for i, item := range (s.Sides) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType float64
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(item)
+		}
+		}
+	return nil
+}
+func (s *Polygon) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Polygon", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Polygon like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Polygon, without trailing bytes
+func (s *Polygon) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Polygon", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Polygon in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Polygon, so wrap unbuffered readers in a bufio.Reader
+func (s *Polygon) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Polygon", err)
+}
+
+// ReadBorsh decodes Polygon nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Polygon
+func (s *Polygon) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Polygon is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Polygon
+    var err error
+		{
+		
+				// Sides (sides) - slice
+				// ElementType: []float64
+				// Type: []float64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sides: []Sides
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Sides", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]float64](r, length)
+		if err != nil {
+			return r.FieldError("Sides", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType float64
+			// Element float64
+	__v, err := r.ReadFloat64()
+	if err != nil {
+		return r.FieldError(borsh.Index("Sides", i), err)
+	}
+	__m := float64(__v)
+	p[i] = (__m)
+		}
+				s.Sides =  p
+		}
+	return err
+}
+func (s Polygon) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Sides (sides) - slice
+				// ElementType: []float64
+				// Type: []float64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sides: []Sides
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Sides) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType float64
+			// Element float64
+				size += 8
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Polygon by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Polygon) CompareBorsh(other any) int {
+	o := other.(Polygon)
+	_ = o
+	if c := borsh.Compare(s.Sides, o.Sides); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Polygon in fields typed by an interface
+func (Polygon) BorshTypeID() uint64 {
+	return 0x79a72ccdfc5bcb90
+}
+func init() {
+	Registry.Register(0x79a72ccdfc5bcb90, func() borsh.BorshEncoder { return new(Polygon) })
+}
+
+// BorshSchema describes the Borsh encoding of Polygon in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Polygon) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Polygon",
+		Definitions: map[string]borsh.Definition{
+			"Polygon": borsh.StructDef(
+				borsh.Field{Name: "sides", Declaration: "Vec<f64>"},),
+			"Vec<f64>": borsh.SequenceDef(4, 0, 4294967295, "f64"),
+			"f64": borsh.PrimitiveDef(8),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Drawing) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Drawing) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Drawing", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Drawing to buf.
+// The FieldPath of a returned EncodeError is relative to Drawing
+func (s Drawing) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Drawing) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Drawing in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Drawing) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Drawing", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Drawing in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Drawing) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Drawing", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Drawing to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Drawing) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Drawing", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Drawing to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Drawing
+func (s Drawing) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Title
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Title", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: any
+					if err := appendAny(buf, s.Main); err != nil {
+						return borsh.EncodeFieldError("Main", err)
+					}
+		}
+		{
+		
+				// Shapes (shapes) - slice
+				// ElementType: []Shape
+				// Type: []Shape
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Shapes: []Shapes
+	if err := checkLength("slice", len((s.Shapes)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Shapes", err)
+	}
+	 appendLength(buf, len((s.Shapes)))
+		for i, item := range (s.Shapes) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+	
+					// BASICTYPE: true
+					// ELNTYPE: any
+					if err := appendAny(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Shapes", i), err)
+					}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: any
+					if err := appendAny(buf, s.Extra); err != nil {
+						return borsh.EncodeFieldError("Extra", err)
+					}
+		}
+		{
+		
+				// Items (items) - slice
+				// ElementType: []any
+				// Type: []any
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Items: []Items
+	if err := checkLength("slice", len((s.Items)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Items", err)
+	}
+	 appendLength(buf, len((s.Items)))
+		for i, item := range (s.Items) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+	
+					// BASICTYPE: true
+					// ELNTYPE: any
+					if err := appendAny(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Items", i), err)
+					}
+		}
+		}
+		{
+			if s.Hidden == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPHidden
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Hidden (hidden) - Pointer
+					// ElementType: any
+					// Type: Shape
+					// ActualType: *github.com/mlayerprotocol/go-borshgen/tests/Shape
+					// BasicType: true
+			
+					// ElementType: Shape
+	
+					// BASICTYPE: true
+					// ELNTYPE: any
+					if err := appendAny(buf, *s.Hidden); err != nil {
+						return borsh.EncodeFieldError("Hidden", err)
+					}
+		}
+					SKIPHidden:
+	return nil
+}
+func (s *Drawing) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Drawing", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Drawing like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Drawing, without trailing bytes
+func (s *Drawing) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Drawing", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Drawing in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Drawing, so wrap unbuffered readers in a bufio.Reader
+func (s *Drawing) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Drawing", err)
+}
+
+// ReadBorsh decodes Drawing nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Drawing
+func (s *Drawing) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Drawing is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Drawing
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Title", err)
+	}
+	__m := string(__s)
+	s.Title = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	err = readAny(r, &s.Main, depth+1)
+	if err != nil {
+		return r.FieldError("Main", err)
+	}
+		}
+		{
+		
+				// Shapes (shapes) - slice
+				// ElementType: []Shape
+				// Type: []Shape
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Shapes: []Shapes
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Shapes", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Shape](r, length)
+		if err != nil {
+			return r.FieldError("Shapes", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+			// Element Shape
+	err = readAny(r, &p[i], depth+1)
+	if err != nil {
+		return r.FieldError(borsh.Index("Shapes", i), err)
+	}
+		}
+				s.Shapes =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	err = readAny(r, &s.Extra, depth+1)
+	if err != nil {
+		return r.FieldError("Extra", err)
+	}
+		}
+		{
+		
+				// Items (items) - slice
+				// ElementType: []any
+				// Type: []any
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Items: []Items
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Items", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]any](r, length)
+		if err != nil {
+			return r.FieldError("Items", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+			// Element interface{}
+	err = readAny(r, &p[i], depth+1)
+	if err != nil {
+		return r.FieldError(borsh.Index("Items", i), err)
+	}
+		}
+				s.Items =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Hidden", err)
+				}
+				if !present {
+					s.Hidden = nil
+					goto SKIPHidden
+				} 
+			}
+		{
+		
+					// Hidden (hidden) - Pointer
+					// ElementType: any
+					// Type: Shape
+					// ActualType: *github.com/mlayerprotocol/go-borshgen/tests/Shape
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: Shape
+					// IsPointer: true
+	s.Hidden = new(Shape)
+	err = readAny(r, s.Hidden, depth+1)
+	if err != nil {
+		return r.FieldError("Hidden", err)
+	}
+		}
+					SKIPHidden:
+	return err
+}
+func (s Drawing) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Title
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				{
+					_s, err := sizeAny(s.Main)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Main", err)
+					}
+					size += _s
+				}
+		}
+		{
+		
+				// Shapes (shapes) - slice
+				// ElementType: []Shape
+				// Type: []Shape
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Shapes: []Shapes
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Shapes) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+			// Element Shape
+				{
+					_s, err := sizeAny(item)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Shapes", err)
+					}
+					size += _s
+				}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				{
+					_s, err := sizeAny(s.Extra)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Extra", err)
+					}
+					size += _s
+				}
+		}
+		{
+		
+				// Items (items) - slice
+				// ElementType: []any
+				// Type: []any
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Items: []Items
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Items) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+			// Element interface{}
+				{
+					_s, err := sizeAny(item)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Items", err)
+					}
+					size += _s
+				}
+		}
+		}
+		{
+			size++
+			if s.Hidden == nil {
+				goto SKIPHidden
+			}
+		}
+		{
+		
+					// Hidden (hidden) - Pointer
+					// ElementType: any
+					// Type: Shape
+					// ActualType: *github.com/mlayerprotocol/go-borshgen/tests/Shape
+					// BasicType: true
+			
+					// ElementType: Shape
+				{
+					_s, err := sizeAny(*s.Hidden)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("Hidden", err)
+					}
+					size += _s
+				}
+		}
+			SKIPHidden:
+	return size, nil
+}
+
+// CompareBorsh orders Drawing by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Drawing) CompareBorsh(other any) int {
+	o := other.(Drawing)
+	_ = o
+	if c := borsh.Compare(s.Title, o.Title); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Main, o.Main); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Shapes, o.Shapes); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Extra, o.Extra); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Items, o.Items); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Hidden, o.Hidden); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Drawing in fields typed by an interface
+func (Drawing) BorshTypeID() uint64 {
+	return 0x3b57d5aadbeb824b
+}
+func init() {
+	Registry.Register(0x3b57d5aadbeb824b, func() borsh.BorshEncoder { return new(Drawing) })
+}
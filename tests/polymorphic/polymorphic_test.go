@@ -0,0 +1,142 @@
+package polymorphic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/cespare/xxhash"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+func TestTypeIDs(t *testing.T) {
+	if got, want := (Circle{}).BorshTypeID(), xxhash.Sum64String("github.com/mlayerprotocol/go-borshgen/tests/polymorphic.Circle"); got != want {
+		t.Errorf("Circle.BorshTypeID() = %#x, want the xxhash of its qualified name %#x", got, want)
+	}
+	if got := (Square{}).BorshTypeID(); got != 0x5157 {
+		t.Errorf("Square.BorshTypeID() = %#x, want 0x5157 from its typeid directive", got)
+	}
+}
+
+func TestMarshalAny(t *testing.T) {
+	values := []borsh.BorshEncoder{&Circle{Radius: 2}, &Square{Side: 3}, &Polygon{Sides: []float64{1, 2, 3}}}
+	for _, v := range values {
+		data, err := Registry.MarshalAny(v)
+		if err != nil {
+			t.Fatalf("MarshalAny(%T) failed: %v", v, err)
+		}
+		if id := binary.LittleEndian.Uint64(data); id != v.(borsh.TypeIdentifier).BorshTypeID() {
+			t.Errorf("MarshalAny(%T) starts with type ID %#x", v, id)
+		}
+		got, err := Registry.UnmarshalAny(data)
+		if err != nil {
+			t.Fatalf("UnmarshalAny() of %T failed: %v", v, err)
+		}
+		if !reflect.DeepEqual(got, v) {
+			t.Errorf("UnmarshalAny() = %#v, want %#v", got, v)
+		}
+	}
+
+	if _, err := Registry.MarshalAny(nil); !errors.Is(err, borsh.InvalidValue) {
+		t.Errorf("MarshalAny(nil) = %v, want an InvalidValue error", err)
+	}
+	if _, err := Registry.MarshalAny(3); !errors.Is(err, borsh.ErrUnsupportedType) {
+		t.Errorf("MarshalAny(3) = %v, want ErrUnsupportedType", err)
+	}
+	if _, err := Registry.UnmarshalAny([]byte{1, 2, 3, 4, 5, 6, 7, 8}); !errors.Is(err, borsh.UnknownType) {
+		t.Errorf("UnmarshalAny() of an unregistered type ID = %v, want an UnknownType error", err)
+	}
+}
+
+// TestDrawing checks that interface fields decode to values of the types they were encoded from
+func TestDrawing(t *testing.T) {
+	var hidden Shape = Square{Side: 4}
+	drawing := Drawing{
+		Title:  "plan",
+		Main:   Circle{Radius: 1},
+		Shapes: []Shape{Square{Side: 2}, &Polygon{Sides: []float64{1}}},
+		Extra:  Polygon{Sides: []float64{5, 6}},
+		Items:  []any{Circle{Radius: 3}},
+		Hidden: &hidden,
+	}
+	data, err := drawing.MarshalBorsh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	main := binary.LittleEndian.AppendUint64(nil, Circle{}.BorshTypeID())
+	main = binary.LittleEndian.AppendUint64(main, 0x3ff0000000000000) // 1.0
+	if title := 4 + len("plan"); !bytes.Equal(data[title:title+16], main) {
+		t.Fatalf("Main is written as %x, want its type ID and value %x", data[title:title+16], main)
+	}
+	if size, err := drawing.BinarySize(); err != nil || size != len(data) {
+		t.Fatalf("BinarySize() = %d, %v, want %d", size, err, len(data))
+	}
+
+	var restored Drawing
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored, drawing) {
+		t.Fatalf("UnmarshalBorsh() = %+v, want %+v", restored, drawing)
+	}
+}
+
+func TestDrawingErrors(t *testing.T) {
+	if _, err := (Drawing{}).MarshalBorsh(); !errors.Is(err, borsh.InvalidValue) {
+		t.Errorf("MarshalBorsh() of a nil Shape = %v, want an InvalidValue error", err)
+	}
+
+	data, err := Drawing{Main: Circle{}, Extra: Circle{}}.MarshalBorsh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary.LittleEndian.PutUint64(data[4:], 42)
+	var d Drawing
+	err = d.UnmarshalBorsh(data)
+	var de *borsh.DecodeError
+	if !errors.As(err, &de) || de.Kind != borsh.UnknownType || de.FieldPath != "Drawing.Main" {
+		t.Errorf("UnmarshalBorsh() of an unregistered type ID = %v, want an UnknownType error in Drawing.Main", err)
+	}
+
+	// A Drawing is registered, but it is not a Shape
+	inner, err := Drawing{Main: Circle{}, Extra: Circle{}}.MarshalBorsh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = binary.LittleEndian.AppendUint64([]byte{0, 0, 0, 0}, Drawing{}.BorshTypeID())
+	if err := d.UnmarshalBorsh(append(data, inner...)); !errors.Is(err, borsh.InvalidValue) {
+		t.Errorf("UnmarshalBorsh() of a Drawing as a Shape = %v, want an InvalidValue error", err)
+	}
+}
+
+func TestRegistryInclude(t *testing.T) {
+	data, err := Registry.MarshalAny(Circle{Radius: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := borsh.NewRegistry()
+	if _, err := r.UnmarshalAny(data); !errors.Is(err, borsh.UnknownType) {
+		t.Fatalf("UnmarshalAny() from an empty Registry = %v, want an UnknownType error", err)
+	}
+	other := borsh.NewRegistry()
+	other.Include(Registry, r)
+	r.Include(other)
+	got, err := r.UnmarshalAny(data)
+	if err != nil {
+		t.Fatalf("UnmarshalAny() through an included Registry failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, &Circle{Radius: 1}) {
+		t.Fatalf("UnmarshalAny() = %#v, want &Circle{Radius: 1}", got)
+	}
+}
+
+func TestRegisterConflict(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Register() of a taken type ID did not panic")
+		}
+	}()
+	Registry.Register(0x5157, func() borsh.BorshEncoder { return new(Circle) })
+}
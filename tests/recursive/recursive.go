@@ -0,0 +1,41 @@
+package recursive
+
+import "github.com/mlayerprotocol/go-borshgen/borsh"
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -max-depth=16 -gen-tests -lang=ts,rust
+type Node struct {
+	Value    uint8           `msg:"value"`
+	Children []*Node         `msg:"children"`
+	Next     *Node           `msg:"next"`
+	Named    map[string]Node `msg:"named"`
+}
+
+// Chain contains itself without a struct in between, so it is encoded through its own methods
+type Chain []Chain
+
+func (c Chain) MarshalBorsh() ([]byte, error) {
+	return Links{Chains: c}.MarshalBorsh()
+}
+
+func (c Chain) BinarySize() (int, error) {
+	return Links{Chains: c}.BinarySize()
+}
+
+func (c *Chain) UnmarshalBorsh(data []byte) error {
+	return c.ReadBorsh(borsh.NewBytesReader(data), 0)
+}
+
+// ReadBorsh lets the generated code count Chain in the nesting depth
+func (c *Chain) ReadBorsh(r *borsh.Reader, depth int) error {
+	var l Links
+	if err := l.ReadBorsh(r, depth); err != nil {
+		return err
+	}
+	*c = l.Chains
+	return nil
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -max-depth=16 -gen-tests
+type Links struct {
+	Chains Chain `msg:"chains"`
+}
@@ -0,0 +1,734 @@
+package recursive
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Node) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Node) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Node", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Node to buf.
+// The FieldPath of a returned EncodeError is relative to Node
+func (s Node) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Node) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Node in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Node) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Node", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Node in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Node) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Node", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Node to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Node) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Node", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Node to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Node
+func (s Node) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.Value))
+		}
+		{
+		
+				// Children (children) - slice
+				// ElementType: []*Node
+				// Type: []*Node
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Children: []Children
+	if err := checkLength("slice", len((s.Children)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Children", err)
+	}
+	 appendLength(buf, len((s.Children)))
+		for i, item := range (s.Children) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Children", i), err)
+					}
+		}
+		}
+		{
+			if s.Next == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPNext
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Next (next) - Pointer
+					// ElementType: struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+					// Type: Node
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Node
+					if err := writeNested(buf, s.Next); err != nil {
+						return borsh.EncodeFieldError("Next", err)
+					}
+		}
+					SKIPNext:
+		{
+		
+				// Named (named) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Named)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Named", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Named)))
+			for mk, mv := range (s.Named) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Named", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+					if err := writeNested(buf, mv); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Named", mk), err)
+					}
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Named", err)
+			}
+		}
+		}
+	return nil
+}
+func (s *Node) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Node", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Node like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Node, without trailing bytes
+func (s *Node) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Node", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Node in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Node, so wrap unbuffered readers in a bufio.Reader
+func (s *Node) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Node", err)
+}
+
+// ReadBorsh decodes Node nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Node
+func (s *Node) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Node is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Node
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Value", err)
+	}
+	__m := uint8(__v)
+	s.Value = (__m)
+		}
+		{
+		
+				// Children (children) - slice
+				// ElementType: []*Node
+				// Type: []*Node
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Children: []Children
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Children", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]*Node](r, length)
+		if err != nil {
+			return r.FieldError("Children", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+			// Element Node
+	
+					// IsPointer: true
+					
+						// IsPointer: false
+					m := &Node{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Children", i), err)
+					}
+						p[i] = m
+		}
+				s.Children =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Next", err)
+				}
+				if !present {
+					s.Next = nil
+					goto SKIPNext
+				} 
+			}
+		{
+		
+					// Next (next) - Pointer
+					// ElementType: struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+					// Type: Node
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Node
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Node{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Next", err)
+					}
+						s.Next = m
+		}
+					SKIPNext:
+		{
+		
+				// Named (named) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Named", err)
+			}
+			mp, err := borsh.MakeMap[map[string]Node](r, mapLen)
+			if err != nil {
+				return r.FieldError("Named", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv Node
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Named", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Named", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+			// Element Node
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Node{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Key("Named", mk), err)
+					}
+						mv = *m
+				}
+				mp[mk] = mv
+			}
+			s.Named = mp
+		}
+		}
+	return err
+}
+func (s Node) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+				// Children (children) - slice
+				// ElementType: []*Node
+				// Type: []*Node
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Children: []Children
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Children) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+			// Element Node
+	
+		// ElementType: struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Children", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+		{
+			size++
+			if s.Next == nil {
+				goto SKIPNext
+			}
+		}
+		{
+		
+					// Next (next) - Pointer
+					// ElementType: struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+					// Type: Node
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Node
+	
+		// ElementType: struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+		
+			// Element: false
+	
+		// ElementType: struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+			bs, err := borsh.SizeOf(s.Next)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Next", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPNext:
+		{
+		
+				// Named (named) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Named) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Value uint8 "msg:\"value\""; Children []*github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"children\""; Next *github.com/mlayerprotocol/go-borshgen/tests/Node "msg:\"next\""; Named map[string]Node "msg:\"named\""}
+			// Element Node
+			bs, err := borsh.SizeOf(mv)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Named", err)
+			}
+					size += NestedPrefixSize + bs
+			}
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Node by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Node) CompareBorsh(other any) int {
+	o := other.(Node)
+	_ = o
+	if c := borsh.Compare(s.Value, o.Value); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Children, o.Children); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Next, o.Next); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Named, o.Named); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Node in fields typed by an interface
+func (Node) BorshTypeID() uint64 {
+	return 0x962d32527e3c01a7
+}
+func init() {
+	Registry.Register(0x962d32527e3c01a7, func() borsh.BorshEncoder { return new(Node) })
+}
+
+// BorshSchema describes the Borsh encoding of Node in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Node) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Node",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"(String, Node)": borsh.TupleDef("String", "Node"),
+			"BTreeMap<String, Node>": borsh.SequenceDef(4, 0, 4294967295, "(String, Node)"),
+			"Node": borsh.StructDef(
+				borsh.Field{Name: "value", Declaration: "u8"},
+				borsh.Field{Name: "children", Declaration: "Vec<Node>"},
+				borsh.Field{Name: "next", Declaration: "Option<Node>"},
+				borsh.Field{Name: "named", Declaration: "BTreeMap<String, Node>"},),
+			"Option<Node>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Node"}),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<Node>": borsh.SequenceDef(4, 0, 4294967295, "Node"),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Links) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Links) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Links", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Links to buf.
+// The FieldPath of a returned EncodeError is relative to Links
+func (s Links) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Links) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Links in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Links) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Links", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Links in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Links) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Links", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Links to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Links) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Links", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Links to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Links
+func (s Links) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+				// Chains (chains) - slice
+				// ElementType: Chain
+				// Type: Chain
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chains: []Chains
+	if err := checkLength("slice", len((s.Chains)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Chains", err)
+	}
+	 appendLength(buf, len((s.Chains)))
+		for i, item := range (s.Chains) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType Chain
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Chains", i), err)
+					}
+		}
+		}
+	return nil
+}
+func (s *Links) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Links", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Links like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Links, without trailing bytes
+func (s *Links) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Links", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Links in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Links, so wrap unbuffered readers in a bufio.Reader
+func (s *Links) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Links", err)
+}
+
+// ReadBorsh decodes Links nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Links
+func (s *Links) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Links is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Links
+    var err error
+		{
+		
+				// Chains (chains) - slice
+				// ElementType: []Chain
+				// Type: Chain
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chains: []Chains
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Chains", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[Chain](r, length)
+		if err != nil {
+			return r.FieldError("Chains", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType Chain
+			// Element Chain
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Chain{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Chains", i), err)
+					}
+						p[i] = *m
+		}
+				s.Chains =  p
+		}
+	return err
+}
+func (s Links) BinarySize() (int, error) {
+	size := 0
+		{
+		
+				// Chains (chains) - slice
+				// ElementType: Chain
+				// Type: Chain
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chains: []Chains
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Chains) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType Chain
+			// Element Chain
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Chains", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Links by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Links) CompareBorsh(other any) int {
+	o := other.(Links)
+	_ = o
+	if c := borsh.Compare(s.Chains, o.Chains); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Links in fields typed by an interface
+func (Links) BorshTypeID() uint64 {
+	return 0xb8421575728fe436
+}
+func init() {
+	Registry.Register(0xb8421575728fe436, func() borsh.BorshEncoder { return new(Links) })
+}
@@ -0,0 +1,101 @@
+package recursive
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// list links n nodes through Next
+func list(n int) *Node {
+	var head *Node
+	for i := n; i > 0; i-- {
+		head = &Node{Value: uint8(i), Children: []*Node{}, Next: head, Named: map[string]Node{}}
+	}
+	return head
+}
+
+func TestRecursiveRoundTrip(t *testing.T) {
+	leaf := Node{Value: 3, Children: []*Node{}, Named: map[string]Node{}}
+	v := Node{
+		Value:    1,
+		Children: []*Node{{Value: 2, Children: []*Node{&leaf}, Named: map[string]Node{}}},
+		Next:     list(3),
+		Named:    map[string]Node{"leaf": leaf},
+	}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	var got Node
+	if err := got.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("round trip mismatch:\n got %+v\nwant %+v", got, v)
+	}
+}
+
+func TestRecursiveNonStruct(t *testing.T) {
+	v := Links{Chains: Chain{Chain{}, Chain{Chain{}}}}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	want := []byte{2, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+	var got Links
+	if err := got.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("round trip mismatch:\n got %+v\nwant %+v", got, v)
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	// The root is at depth 0, so MaxDepth+1 nodes are the longest list accepted
+	for n, ok := range map[int]bool{MaxDepth + 1: true, MaxDepth + 2: false} {
+		data, err := list(n).MarshalBorsh()
+		if err != nil {
+			t.Fatalf("MarshalBorsh() failed: %v", err)
+		}
+		var got Node
+		err = got.UnmarshalBorsh(data)
+		if ok && err != nil {
+			t.Errorf("%d nodes: UnmarshalBorsh() failed: %v", n, err)
+		}
+		if !ok && (err == nil || !strings.Contains(err.Error(), "MaxDepth")) {
+			t.Errorf("%d nodes: UnmarshalBorsh() = %v, want a depth error", n, err)
+		}
+	}
+
+	var chain Links
+	for i := 0; i <= MaxDepth; i++ {
+		chain = Links{Chains: Chain{chain.Chains}}
+	}
+	data, err := chain.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if err := new(Links).UnmarshalBorsh(data); err == nil {
+		t.Error("UnmarshalBorsh() accepted a Chain nested deeper than MaxDepth")
+	}
+}
+
+func TestHostileNesting(t *testing.T) {
+	// A million nested options: value, no children, then the next node
+	const n = 1 << 20
+	var data []byte
+	data = append(data, bytes.Repeat([]byte{0, 0, 0, 0, 0, 1}, n)...)
+	data = append(data, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	data = append(data, bytes.Repeat([]byte{0, 0, 0, 0}, n)...)
+
+	var got Node
+	if err := got.UnmarshalBorsh(data); err == nil {
+		t.Error("UnmarshalBorsh() accepted input nested deeper than MaxDepth")
+	}
+}
@@ -0,0 +1,18 @@
+package sets
+
+// Role is a named set element type
+type Role string
+
+// Roles is a named set type
+type Roles map[Role]struct{}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Permissions struct {
+	Owner   string              `msg:"owner"`
+	Scopes  map[uint16]struct{} `msg:"scopes"`
+	Roles   Roles               `msg:"roles"`
+	Members []string            `msg:"members,set"`
+	Ids     []uint32            `msg:"ids,set"`
+	Extra   *[]uint8            `msg:"extra,set"`
+	Tags    []string            `msg:"tags"`
+}
@@ -0,0 +1,822 @@
+package sets
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Permissions) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Permissions) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Permissions", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Permissions to buf.
+// The FieldPath of a returned EncodeError is relative to Permissions
+func (s Permissions) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Permissions) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Permissions in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Permissions) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Permissions", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Permissions in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Permissions) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Permissions", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Permissions to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Permissions) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Permissions", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Permissions to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Permissions
+func (s Permissions) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Owner
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Owner", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+				// Scopes (scopes) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Scopes)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Scopes", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Scopes)))
+			for mk, mv := range (s.Scopes) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(mk))
+					entry.Key = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Scopes", err)
+			}
+		}
+		}
+		{
+		
+				// Roles (roles) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Roles)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Roles", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Roles)))
+			for mk, mv := range (s.Roles) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Roles", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Roles", err)
+			}
+		}
+		}
+		{
+		
+				// Members (members) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Members: []Members
+	if err := checkLength("slice", len((s.Members)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Members", err)
+	}
+	 appendLength(buf, len((s.Members)))
+		for i, item := range (s.Members) {
+			_ = i
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement((s.Members)[i-1], item, i); err != nil {
+					return borsh.EncodeFieldError(borsh.Index("Members", i), err)
+				}
+			}
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Members", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+		{
+		
+				// Ids (ids) - slice
+				// ElementType: []uint32
+				// Type: []uint32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Ids: []Ids
+	if err := checkLength("slice", len((s.Ids)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Ids", err)
+	}
+	 appendLength(buf, len((s.Ids)))
+		for i, item := range (s.Ids) {
+			_ = i
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement((s.Ids)[i-1], item, i); err != nil {
+					return borsh.EncodeFieldError(borsh.Index("Ids", i), err)
+				}
+			}
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(item))
+		}
+		}
+		{
+			if s.Extra == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPExtra
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+				// Extra (extra) - slice
+				// ElementType: []uint8
+				// Type: []uint8
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Extra: []Extra
+	if err := checkLength("slice", len(*(s.Extra)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Extra", err)
+	}
+	 appendLength(buf, len(*(s.Extra)))
+		for i, item := range *(s.Extra) {
+			_ = i
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement((*s.Extra)[i-1], item, i); err != nil {
+					return borsh.EncodeFieldError(borsh.Index("Extra", i), err)
+				}
+			}
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(item))
+		}
+		}
+					SKIPExtra:
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	if err := checkLength("slice", len((s.Tags)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Tags", err)
+	}
+	 appendLength(buf, len((s.Tags)))
+		for i, item := range (s.Tags) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Tags", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+	return nil
+}
+func (s *Permissions) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Permissions", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Permissions like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Permissions, without trailing bytes
+func (s *Permissions) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Permissions", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Permissions in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Permissions, so wrap unbuffered readers in a bufio.Reader
+func (s *Permissions) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Permissions", err)
+}
+
+// ReadBorsh decodes Permissions nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Permissions
+func (s *Permissions) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Permissions is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Permissions
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Owner", err)
+	}
+	__m := string(__s)
+	s.Owner = (__m)
+		}
+		{
+		
+				// Scopes (scopes) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Scopes", err)
+			}
+			mp, err := borsh.MakeMap[map[uint16]struct{}](r, mapLen)
+			if err != nil {
+				return r.FieldError("Scopes", err)
+			}
+			var prevKey uint16
+			for mi := 0; mi < mapLen; mi++ {
+				var mk uint16
+				var mv struct{}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError(borsh.Index("Scopes", mi), err)
+	}
+	__m := uint16(__v)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Scopes", mk), err)
+					}
+					prevKey = mk
+				}
+				mp[mk] = mv
+			}
+			s.Scopes = mp
+		}
+		}
+		{
+		
+				// Roles (roles) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Roles", err)
+			}
+			mp, err := borsh.MakeMap[Roles](r, mapLen)
+			if err != nil {
+				return r.FieldError("Roles", err)
+			}
+			var prevKey Role
+			for mi := 0; mi < mapLen; mi++ {
+				var mk Role
+				var mv struct{}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element Role
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Roles", mi), err)
+	}
+	__m := Role(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Roles", mk), err)
+					}
+					prevKey = mk
+				}
+				mp[mk] = mv
+			}
+			s.Roles = mp
+		}
+		}
+		{
+		
+				// Members (members) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Members: []Members
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Members", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]string](r, length)
+		if err != nil {
+			return r.FieldError("Members", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Members", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement(p[i-1], p[i], i); err != nil {
+					return r.FieldError(borsh.Index("Members", i), err)
+				}
+			}
+		}
+				s.Members =  p
+		}
+		{
+		
+				// Ids (ids) - slice
+				// ElementType: []uint32
+				// Type: []uint32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Ids: []Ids
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Ids", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]uint32](r, length)
+		if err != nil {
+			return r.FieldError("Ids", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError(borsh.Index("Ids", i), err)
+	}
+	__m := uint32(__v)
+	p[i] = (__m)
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement(p[i-1], p[i], i); err != nil {
+					return r.FieldError(borsh.Index("Ids", i), err)
+				}
+			}
+		}
+				s.Ids =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Extra", err)
+				}
+				if !present {
+					s.Extra = nil
+					goto SKIPExtra
+				} 
+			}
+		{
+		
+				// Extra (extra) - slice
+				// ElementType: []uint8
+				// Type: []uint8
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Extra: []Extra
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Extra", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]uint8](r, length)
+		if err != nil {
+			return r.FieldError("Extra", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Extra", i), err)
+	}
+	__m := uint8(__v)
+	p[i] = (__m)
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement(p[i-1], p[i], i); err != nil {
+					return r.FieldError(borsh.Index("Extra", i), err)
+				}
+			}
+		}
+				s.Extra =  &p
+		}
+					SKIPExtra:
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Tags", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]string](r, length)
+		if err != nil {
+			return r.FieldError("Tags", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Tags", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+		}
+				s.Tags =  p
+		}
+	return err
+}
+func (s Permissions) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Owner
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+				// Scopes (scopes) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Scopes) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+				size += 2
+			}
+		}
+		}
+		{
+		
+				// Roles (roles) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Roles) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element Role
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+		}
+		}
+		{
+		
+				// Members (members) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Members: []Members
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Members) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+		{
+		
+				// Ids (ids) - slice
+				// ElementType: []uint32
+				// Type: []uint32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Ids: []Ids
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Ids) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+				size += 4
+		}
+		}
+		{
+			size++
+			if s.Extra == nil {
+				goto SKIPExtra
+			}
+		}
+		{
+		
+				// Extra (extra) - slice
+				// ElementType: []uint8
+				// Type: []uint8
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Extra: []Extra
+	size += LengthPrefixSize // for slice length
+		for _, item := range *(s.Extra) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+				size += 1
+		}
+		}
+			SKIPExtra:
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Tags) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Permissions by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Permissions) CompareBorsh(other any) int {
+	o := other.(Permissions)
+	_ = o
+	if c := borsh.Compare(s.Owner, o.Owner); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Scopes, o.Scopes); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Roles, o.Roles); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Members, o.Members); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Ids, o.Ids); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Extra, o.Extra); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Tags, o.Tags); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Permissions in fields typed by an interface
+func (Permissions) BorshTypeID() uint64 {
+	return 0x4707e1685410e5eb
+}
+func init() {
+	Registry.Register(0x4707e1685410e5eb, func() borsh.BorshEncoder { return new(Permissions) })
+}
+
+// BorshSchema describes the Borsh encoding of Permissions in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Permissions) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Permissions",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"BTreeSet<String>": borsh.SequenceDef(4, 0, 4294967295, "String"),
+			"BTreeSet<u16>": borsh.SequenceDef(4, 0, 4294967295, "u16"),
+			"BTreeSet<u32>": borsh.SequenceDef(4, 0, 4294967295, "u32"),
+			"BTreeSet<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Option<BTreeSet<u8>>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "BTreeSet<u8>"}),
+			"Permissions": borsh.StructDef(
+				borsh.Field{Name: "owner", Declaration: "String"},
+				borsh.Field{Name: "scopes", Declaration: "BTreeSet<u16>"},
+				borsh.Field{Name: "roles", Declaration: "BTreeSet<String>"},
+				borsh.Field{Name: "members", Declaration: "BTreeSet<String>"},
+				borsh.Field{Name: "ids", Declaration: "BTreeSet<u32>"},
+				borsh.Field{Name: "extra", Declaration: "Option<BTreeSet<u8>>"},
+				borsh.Field{Name: "tags", Declaration: "Vec<String>"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<String>": borsh.SequenceDef(4, 0, 4294967295, "String"),
+			"u16": borsh.PrimitiveDef(2),
+			"u32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
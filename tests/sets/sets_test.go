@@ -0,0 +1,95 @@
+package sets
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSetLayout(t *testing.T) {
+	v := Permissions{
+		Scopes:  map[uint16]struct{}{1: {}, 256: {}},
+		Members: []string{"aa", "b"},
+	}
+
+	var want []byte
+	want = append(want, 0, 0, 0, 0)       // Owner
+	want = append(want, 2, 0, 0, 0, 1, 0) // Scopes: sorted by value, so 256 comes last
+	want = append(want, 0, 1)
+	want = append(want, 0, 0, 0, 0) // Roles
+	want = append(want, 2, 0, 0, 0, 2, 0, 0, 0, 'a', 'a', 1, 0, 0, 0, 'b') // Members: "aa" sorts before "b"
+	want = append(want, 0, 0, 0, 0) // Ids
+	want = append(want, 0)          // Extra: nil
+	want = append(want, 0, 0, 0, 0) // Tags
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(want) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(want))
+	}
+}
+
+func TestSetRoundTrip(t *testing.T) {
+	extra := []uint8{1, 2, 3}
+	original := Permissions{
+		Owner:   "root",
+		Scopes:  map[uint16]struct{}{7: {}, 300: {}, 9: {}},
+		Roles:   Roles{"admin": {}, "reader": {}},
+		Members: []string{"aa", "alice", "b"},
+		Ids:     []uint32{1, 2, 256},
+		Extra:   &extra,
+		Tags:    []string{"z", "a", "z"},
+	}
+	data, err := original.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	var restored Permissions
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(restored, original) {
+		t.Errorf("round trip mismatch:\n got %+v\nwant %+v", restored, original)
+	}
+}
+
+func TestSetValidation(t *testing.T) {
+	cases := []struct {
+		name string
+		v    Permissions
+		want string
+	}{
+		{"unsorted", Permissions{Members: []string{"b", "a"}}, "not sorted"},
+		{"duplicate", Permissions{Members: []string{"a", "a"}}, "duplicate set element"},
+		{"encoding order", Permissions{Ids: []uint32{256, 1}}, "not sorted"},
+		{"length order", Permissions{Members: []string{"b", "aa"}}, "not sorted"},
+		{"pointer", Permissions{Extra: &[]uint8{2, 2}}, "duplicate set element"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.v.MarshalBorsh(); err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("MarshalBorsh() error = %v, want %q", err, tc.want)
+			}
+		})
+	}
+
+	// Members holding "b" then "a", written by hand since MarshalBorsh refuses it
+	var data []byte
+	data = append(data, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	data = append(data, 2, 0, 0, 0, 1, 0, 0, 0, 'b', 1, 0, 0, 0, 'a')
+	data = append(data, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	var v Permissions
+	if err := v.UnmarshalBorsh(data); err == nil || !strings.Contains(err.Error(), "set element") {
+		t.Errorf("UnmarshalBorsh() error = %v, want an unsorted set error", err)
+	}
+}
@@ -0,0 +1,98 @@
+package stream
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+func TestAppendMatchesMarshal(t *testing.T) {
+	for name, v := range map[string]Message{"small": sample(1), "large": large()} {
+		t.Run(name, func(t *testing.T) {
+			want, err := v.MarshalBorsh()
+			if err != nil {
+				t.Fatalf("MarshalBorsh() failed: %v", err)
+			}
+			prefix := []byte("prefix")
+			got, err := v.AppendBorsh(bytes.Clone(prefix))
+			if err != nil {
+				t.Fatalf("AppendBorsh() failed: %v", err)
+			}
+			if !bytes.Equal(got, append(prefix, want...)) {
+				t.Fatalf("AppendBorsh() did not append the MarshalBorsh() bytes to dst")
+			}
+		})
+	}
+}
+
+func TestAppendReusesBuffer(t *testing.T) {
+	h := Header{Seq: 42, Topic: "orders"}
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		var err error
+		if buf, err = h.AppendBorsh(buf[:0]); err != nil {
+			t.Fatalf("AppendBorsh() failed: %v", err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("AppendBorsh() into a large enough buffer made %v allocations", allocs)
+	}
+
+	var decoded Header
+	if err := decoded.UnmarshalBorsh(buf); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if decoded != h {
+		t.Errorf("UnmarshalBorsh() = %+v, want %+v", decoded, h)
+	}
+}
+
+func TestAppendError(t *testing.T) {
+	v := sample(1)
+	v.Peers = []string{"alice", "bob", "bob"} // repeated set element
+	dst := []byte("prefix")
+	got, err := v.AppendBorsh(dst)
+	if err == nil {
+		t.Fatal("AppendBorsh() succeeded with a repeated set element")
+	}
+	if !bytes.Equal(got, dst) {
+		t.Errorf("AppendBorsh() = %q on error, want dst unchanged", got)
+	}
+}
+
+func TestMarshalBorshTo(t *testing.T) {
+	v := sample(1)
+	want, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+
+	dst := make([]byte, len(want)+10)
+	n, err := v.MarshalBorshTo(dst)
+	if err != nil {
+		t.Fatalf("MarshalBorshTo() failed: %v", err)
+	}
+	if n != len(want) || !bytes.Equal(dst[:n], want) {
+		t.Errorf("MarshalBorshTo() = %d, want the %d MarshalBorsh() bytes", n, len(want))
+	}
+	var decoded Message
+	if err := decoded.UnmarshalBorsh(dst[:n]); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, v) {
+		t.Errorf("UnmarshalBorsh() mismatch")
+	}
+
+	// The encoding never goes past len(dst), even with spare capacity
+	short := make([]byte, len(want)-1, len(want)+10)
+	if _, err := v.MarshalBorshTo(short); !errors.Is(err, io.ErrShortBuffer) || !errors.Is(err, borsh.ShortBuffer) {
+		t.Errorf("MarshalBorshTo(%d bytes) = %v, want io.ErrShortBuffer", len(short), err)
+	}
+	if spare := short[len(short):cap(short)]; !bytes.Equal(spare, make([]byte, len(spare))) {
+		t.Errorf("MarshalBorshTo() wrote past len(dst)")
+	}
+}
@@ -0,0 +1,161 @@
+package stream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/embedded/common"
+)
+
+func decodeError(t *testing.T, err error) *borsh.DecodeError {
+	t.Helper()
+	var de *borsh.DecodeError
+	if !errors.As(err, &de) {
+		t.Fatalf("error = %v (%T), want a *borsh.DecodeError", err, err)
+	}
+	return de
+}
+
+func TestDecodeErrorPath(t *testing.T) {
+	v := sample(1)
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	// Trail[1].Topic "y" is the last byte of the encoding
+	truncated := data[:len(data)-1]
+
+	var restored Message
+	de := decodeError(t, restored.UnmarshalBorsh(truncated))
+	if de.FieldPath != "Message.Trail[1].Topic" {
+		t.Errorf("FieldPath = %q, want Message.Trail[1].Topic", de.FieldPath)
+	}
+	if de.Kind != borsh.ShortBuffer || !errors.Is(de, borsh.ShortBuffer) {
+		t.Errorf("Kind = %v, want %v", de.Kind, borsh.ShortBuffer)
+	}
+	if errors.Is(de, borsh.LengthLimit) {
+		t.Errorf("errors.Is(%v, LengthLimit) = true", de)
+	}
+	if want := int64(len(data) - 1); de.Offset != want {
+		t.Errorf("Offset = %d, want %d", de.Offset, want)
+	}
+
+	// A stream reports the same error, and wraps io.ErrUnexpectedEOF
+	_, err = restored.ReadBorshFrom(bytes.NewReader(truncated))
+	de = decodeError(t, err)
+	if de.FieldPath != "Message.Trail[1].Topic" || de.Offset != int64(len(data)-1) {
+		t.Errorf("ReadBorshFrom() error = %v, want the error of UnmarshalBorsh", err)
+	}
+	if !errors.Is(err, io.ErrUnexpectedEOF) || de.Kind != borsh.ShortBuffer {
+		t.Errorf("ReadBorshFrom() error = %v, want a ShortBuffer error wrapping io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestDecodeErrorNested(t *testing.T) {
+	v := Routed{
+		Via:  common.Header{},
+		Hops: []*common.Header{{}, {}},
+		Msg:  sample(2),
+	}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	var restored Routed
+	de := decodeError(t, restored.UnmarshalBorsh(data[:len(data)-1]))
+	if de.FieldPath != "Routed.Msg.Trail[1].Topic" {
+		t.Errorf("FieldPath = %q, want Routed.Msg.Trail[1].Topic", de.FieldPath)
+	}
+}
+
+func TestDecodeErrorMapValue(t *testing.T) {
+	data, err := Message{Labels: map[string]uint32{"a": 1}}.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	// The u32 value of "a" is followed by the empty Peers and Trail
+	end := len(data) - 8
+	var restored Message
+	de := decodeError(t, restored.UnmarshalBorsh(data[:end-2]))
+	if de.FieldPath != "Message.Labels[a]" || de.Offset != int64(end-4) {
+		t.Errorf("error = %v, want Message.Labels[a] at offset %d", de, end-4)
+	}
+}
+
+func TestDecodeErrorLengthLimit(t *testing.T) {
+	data := binary.LittleEndian.AppendUint64(nil, 1)
+	data = binary.LittleEndian.AppendUint32(data, MaxStringLen+1)
+
+	var restored Header
+	de := decodeError(t, restored.UnmarshalBorsh(data))
+	if de.Kind != borsh.LengthLimit || de.FieldPath != "Header.Topic" || de.Offset != 12 {
+		t.Errorf("error = %v, want LengthLimit of Header.Topic at offset 12", de)
+	}
+}
+
+func TestEncodeErrorPath(t *testing.T) {
+	v := sample(1)
+	v.Peers = []string{"bob", "alice"}
+
+	_, err := v.MarshalBorsh()
+	var ee *borsh.EncodeError
+	if !errors.As(err, &ee) {
+		t.Fatalf("MarshalBorsh() error = %v (%T), want a *borsh.EncodeError", err, err)
+	}
+	if ee.FieldPath != "Message.Peers[1]" || !errors.Is(err, borsh.NotCanonical) {
+		t.Errorf("MarshalBorsh() error = %v, want NotCanonical at Message.Peers[1]", err)
+	}
+
+	r := Routed{Msg: v}
+	if _, err := r.WriteBorshTo(io.Discard); !errors.As(err, &ee) || ee.FieldPath != "Routed.Msg.Peers[1]" {
+		t.Errorf("WriteBorshTo() error = %v, want an EncodeError at Routed.Msg.Peers[1]", err)
+	}
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	if StrictDecoding {
+		t.Fatal("package must be generated without -strict")
+	}
+	data, err := sample(1).MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	data = append(data, 0)
+	// The pointer flag of Reply follows the 8 byte Seq and the length-prefixed "orders"
+	data[18] = 2
+
+	var lenient, strict Message
+	if err := lenient.UnmarshalBorsh(data); err != nil {
+		t.Errorf("UnmarshalBorsh() failed: %v", err)
+	}
+	de := decodeError(t, strict.UnmarshalBorshStrict(data))
+	if de.Kind != borsh.UnknownVariant || de.FieldPath != "Message.Reply" || de.Offset != 18 {
+		t.Errorf("UnmarshalBorshStrict() error = %v, want UnknownVariant at Message.Reply", de)
+	}
+
+	data[18] = 1
+	de = decodeError(t, strict.UnmarshalBorshStrict(data))
+	if de.Kind != borsh.TrailingBytes || de.Offset != int64(len(data)-1) {
+		t.Errorf("UnmarshalBorshStrict() error = %v, want TrailingBytes", de)
+	}
+	if err := strict.UnmarshalBorshStrict(data[:len(data)-1]); err != nil {
+		t.Errorf("UnmarshalBorshStrict() failed: %v", err)
+	}
+}
+
+func TestEncodeErrorNilElement(t *testing.T) {
+	v := Routed{Hops: []*common.Header{{}, nil}}
+
+	_, err := v.MarshalBorsh()
+	var ee *borsh.EncodeError
+	if !errors.As(err, &ee) || ee.Kind != borsh.InvalidValue || ee.FieldPath != "Routed.Hops[1]" {
+		t.Errorf("MarshalBorsh() error = %v, want InvalidValue at Routed.Hops[1]", err)
+	}
+	if _, err := v.WriteBorshTo(io.Discard); !errors.As(err, &ee) || ee.FieldPath != "Routed.Hops[1]" {
+		t.Errorf("WriteBorshTo() error = %v, want an EncodeError at Routed.Hops[1]", err)
+	}
+}
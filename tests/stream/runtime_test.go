@@ -0,0 +1,62 @@
+package stream
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/embedded/common"
+)
+
+// Generated structs of every package implement the runtime interfaces
+var (
+	_ borsh.WriterTo        = common.Header{}
+	_ borsh.EncoderTo       = common.Header{}
+	_ borsh.ReaderFrom      = (*common.Header)(nil)
+	_ borsh.BinaryMarshaler = Routed{}
+	_ borsh.ReaderFrom      = (*Routed)(nil)
+)
+
+func TestSharedInterfaces(t *testing.T) {
+	for _, pair := range [][2]reflect.Type{
+		{reflect.TypeOf((*BinaryMarshaler)(nil)).Elem(), reflect.TypeOf((*common.BinaryMarshaler)(nil)).Elem()},
+		{reflect.TypeOf((*CustomElementEncoder)(nil)).Elem(), reflect.TypeOf((*common.CustomElementEncoder)(nil)).Elem()},
+	} {
+		if pair[0] != pair[1] {
+			t.Errorf("%v and %v are different types", pair[0], pair[1])
+		}
+	}
+}
+
+func TestNestedFromOtherPackage(t *testing.T) {
+	v := Routed{
+		Via:  common.Header{Version: 1, Chain: "main"},
+		Hops: []*common.Header{{Version: 2, Chain: "a"}, {Version: 3, Chain: "b"}},
+		Msg:  sample(1),
+	}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	via, err := v.Via.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.HasPrefix(data, via) {
+		t.Errorf("MarshalBorsh() does not start with the inline common.Header")
+	}
+
+	// Structs from other packages are read in place, so they can be read from a stream
+	var decoded Routed
+	n, err := decoded.ReadBorshFrom(oneByteReader{bytes.NewReader(data)})
+	if err != nil {
+		t.Fatalf("ReadBorshFrom() failed: %v", err)
+	}
+	if n != int64(len(data)) {
+		t.Errorf("ReadBorshFrom() = %d, want %d", n, len(data))
+	}
+	if !reflect.DeepEqual(decoded, v) {
+		t.Errorf("ReadBorshFrom() mismatch:\ngot:  %+v\nwant: %+v", decoded.Via, v.Via)
+	}
+}
@@ -0,0 +1,29 @@
+package stream
+
+import "github.com/mlayerprotocol/go-borshgen/tests/embedded/common"
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests
+type Header struct {
+	Seq   uint64 `msg:"seq"`
+	Topic string `msg:"topic"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests
+type Message struct {
+	Header  Header            `msg:"header"`
+	Reply   *Header           `msg:"reply"`
+	Payload []byte            `msg:"payload"`
+	Chunks  [][]byte          `msg:"chunks"`
+	Labels  map[string]uint32 `msg:"labels"`
+	Peers   []string          `msg:"peers,set"`
+	Trail   []Header          `msg:"trail"`
+}
+
+// Routed nests structs generated in another package
+//
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests
+type Routed struct {
+	Via  common.Header    `msg:"via"`
+	Hops []*common.Header `msg:"hops"`
+	Msg  Message          `msg:"msg"`
+}
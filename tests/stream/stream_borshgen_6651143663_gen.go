@@ -0,0 +1,1305 @@
+package stream
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/embedded/common"
+)
+var _ common.Header
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Header) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Header) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Header", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Header to buf.
+// The FieldPath of a returned EncodeError is relative to Header
+func (s Header) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Header) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Header in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Header) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Header", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Header in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Header) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Header", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Header to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Header) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Header", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Header to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Header
+func (s Header) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Seq))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Topic
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Topic", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Header) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Header", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Header like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Header, without trailing bytes
+func (s *Header) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Header", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Header in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Header, so wrap unbuffered readers in a bufio.Reader
+func (s *Header) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Header", err)
+}
+
+// ReadBorsh decodes Header nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Header
+func (s *Header) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Header is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Header
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Seq", err)
+	}
+	__m := uint64(__v)
+	s.Seq = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Topic", err)
+	}
+	__m := string(__s)
+	s.Topic = (__m)
+		}
+	return err
+}
+func (s Header) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Topic
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Header by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Header) CompareBorsh(other any) int {
+	o := other.(Header)
+	_ = o
+	if c := borsh.Compare(s.Seq, o.Seq); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Topic, o.Topic); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Header in fields typed by an interface
+func (Header) BorshTypeID() uint64 {
+	return 0xf181ac432aa51973
+}
+func init() {
+	Registry.Register(0xf181ac432aa51973, func() borsh.BorshEncoder { return new(Header) })
+}
+
+// BorshSchema describes the Borsh encoding of Header in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Header) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Header",
+		Definitions: map[string]borsh.Definition{
+			"Header": borsh.StructDef(
+				borsh.Field{Name: "seq", Declaration: "u64"},
+				borsh.Field{Name: "topic", Declaration: "String"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Message) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Message) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Message", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Message to buf.
+// The FieldPath of a returned EncodeError is relative to Message
+func (s Message) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Message) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Message in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Message) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Message", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Message in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Message) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Message", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Message to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Message) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Message", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Message to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Message
+func (s Message) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Header); err != nil {
+						return borsh.EncodeFieldError("Header", err)
+					}
+		}
+		{
+			if s.Reply == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPReply
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Reply (reply) - Pointer
+					// ElementType: struct{Seq uint64 "msg:\"seq\""; Topic string "msg:\"topic\""}
+					// Type: Header
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Header
+					if err := writeNested(buf, s.Reply); err != nil {
+						return borsh.EncodeFieldError("Reply", err)
+					}
+		}
+					SKIPReply:
+		{
+		
+				// Payload (payload) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+	data, err := _DefaultByteArrayEncoder.MarshalBorsh((s.Payload), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("Payload", err)
+		}
+		 appendBytes(buf, data)
+		}
+		{
+		
+				// Chunks (chunks) - slice
+				// ElementType: [][]byte
+				// Type: [][]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chunks: []Chunks
+	if err := checkLength("slice", len((s.Chunks)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Chunks", err)
+	}
+	 appendLength(buf, len((s.Chunks)))
+		for i, item := range (s.Chunks) {
+			_ = i
+		data, err := _DefaultByteArrayEncoder.MarshalBorsh((item), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError(borsh.Index("Chunks", i), err)
+		}
+		 appendBytes(buf, data)
+		}
+		}
+		{
+		
+				// Labels (labels) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Labels)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Labels", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Labels)))
+			for mk, mv := range (s.Labels) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Labels", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(mv))
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Labels", err)
+			}
+		}
+		}
+		{
+		
+				// Peers (peers) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Peers: []Peers
+	if err := checkLength("slice", len((s.Peers)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Peers", err)
+	}
+	 appendLength(buf, len((s.Peers)))
+		for i, item := range (s.Peers) {
+			_ = i
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement((s.Peers)[i-1], item, i); err != nil {
+					return borsh.EncodeFieldError(borsh.Index("Peers", i), err)
+				}
+			}
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Peers", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+		{
+		
+				// Trail (trail) - slice
+				// ElementType: []Header
+				// Type: []Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Trail: []Trail
+	if err := checkLength("slice", len((s.Trail)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Trail", err)
+	}
+	 appendLength(buf, len((s.Trail)))
+		for i, item := range (s.Trail) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Seq uint64 "msg:\"seq\""; Topic string "msg:\"topic\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Trail", i), err)
+					}
+		}
+		}
+	return nil
+}
+func (s *Message) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Message", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Message like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Message, without trailing bytes
+func (s *Message) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Message", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Message in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Message, so wrap unbuffered readers in a bufio.Reader
+func (s *Message) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Message", err)
+}
+
+// ReadBorsh decodes Message nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Message
+func (s *Message) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Message is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Message
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Header{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Header", err)
+					}
+						s.Header = *m
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Reply", err)
+				}
+				if !present {
+					s.Reply = nil
+					goto SKIPReply
+				} 
+			}
+		{
+		
+					// Reply (reply) - Pointer
+					// ElementType: struct{Seq uint64 "msg:\"seq\""; Topic string "msg:\"topic\""}
+					// Type: Header
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Header
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Header{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Reply", err)
+					}
+						s.Reply = m
+		}
+					SKIPReply:
+		{
+		
+				// Payload (payload) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+			itemData, err := readByteSlice(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Payload", err)
+			}
+			if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+				return r.FieldError("Payload", err)
+			} else {
+				_m := (_v).([]byte)
+				s.Payload = _m
+			}
+		}
+		{
+		
+				// Chunks (chunks) - slice
+				// ElementType: [][]byte
+				// Type: [][]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chunks: []Chunks
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Chunks", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[][]byte](r, length)
+		if err != nil {
+			return r.FieldError("Chunks", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+		itemData, err := readByteSlice(r, MaxSliceLen)
+		if err != nil {
+			return r.FieldError(borsh.Index("Chunks", i), err)
+		}
+		if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+			return r.FieldError(borsh.Index("Chunks", i), err)
+		} else {
+			_m := (_v).([]byte)
+			p[i] = _m
+		}
+		}
+				s.Chunks =  p
+		}
+		{
+		
+				// Labels (labels) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Labels", err)
+			}
+			mp, err := borsh.MakeMap[map[string]uint32](r, mapLen)
+			if err != nil {
+				return r.FieldError("Labels", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv uint32
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Labels", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Labels", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError(borsh.Key("Labels", mk), err)
+	}
+	__m := uint32(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Labels = mp
+		}
+		}
+		{
+		
+				// Peers (peers) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Peers: []Peers
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Peers", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]string](r, length)
+		if err != nil {
+			return r.FieldError("Peers", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Peers", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement(p[i-1], p[i], i); err != nil {
+					return r.FieldError(borsh.Index("Peers", i), err)
+				}
+			}
+		}
+				s.Peers =  p
+		}
+		{
+		
+				// Trail (trail) - slice
+				// ElementType: []Header
+				// Type: []Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Trail: []Trail
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Trail", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Header](r, length)
+		if err != nil {
+			return r.FieldError("Trail", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Seq uint64 "msg:\"seq\""; Topic string "msg:\"topic\""}
+			// Element Header
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Header{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Trail", i), err)
+					}
+						p[i] = *m
+		}
+				s.Trail =  p
+		}
+	return err
+}
+func (s Message) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Header)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Header", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+			size++
+			if s.Reply == nil {
+				goto SKIPReply
+			}
+		}
+		{
+		
+					// Reply (reply) - Pointer
+					// ElementType: struct{Seq uint64 "msg:\"seq\""; Topic string "msg:\"topic\""}
+					// Type: Header
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Header
+	
+		// ElementType: struct{Seq uint64 "msg:\"seq\""; Topic string "msg:\"topic\""}
+		
+			// Element: false
+	
+		// ElementType: struct{Seq uint64 "msg:\"seq\""; Topic string "msg:\"topic\""}
+			bs, err := borsh.SizeOf(s.Reply)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Reply", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPReply:
+		{
+		
+				// Payload (payload) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+
+// Var s.Payload
+		_s, err := _DefaultByteArrayEncoder.BinarySize(s.Payload, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder s.Payload: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		{
+		
+				// Chunks (chunks) - slice
+				// ElementType: [][]byte
+				// Type: [][]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chunks: []Chunks
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Chunks) {
+			_ = item
+
+// Var item
+		_s, err := _DefaultByteArrayEncoder.BinarySize(item, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder item: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		}
+		{
+		
+				// Labels (labels) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Labels) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint32
+			// Element uint32
+				size += 4
+			}
+		}
+		}
+		{
+		
+				// Peers (peers) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Peers: []Peers
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Peers) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+		{
+		
+				// Trail (trail) - slice
+				// ElementType: []Header
+				// Type: []Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Trail: []Trail
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Trail) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Seq uint64 "msg:\"seq\""; Topic string "msg:\"topic\""}
+			// Element Header
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Trail", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Message by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Message) CompareBorsh(other any) int {
+	o := other.(Message)
+	_ = o
+	if c := borsh.Compare(s.Header, o.Header); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Reply, o.Reply); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Payload, o.Payload); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Chunks, o.Chunks); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Labels, o.Labels); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Peers, o.Peers); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Trail, o.Trail); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Message in fields typed by an interface
+func (Message) BorshTypeID() uint64 {
+	return 0xf4f520631f442343
+}
+func init() {
+	Registry.Register(0xf4f520631f442343, func() borsh.BorshEncoder { return new(Message) })
+}
+
+// BorshSchema describes the Borsh encoding of Message in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Message) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Message",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"(String, u32)": borsh.TupleDef("String", "u32"),
+			"BTreeMap<String, u32>": borsh.SequenceDef(4, 0, 4294967295, "(String, u32)"),
+			"BTreeSet<String>": borsh.SequenceDef(4, 0, 4294967295, "String"),
+			"Header": borsh.StructDef(
+				borsh.Field{Name: "seq", Declaration: "u64"},
+				borsh.Field{Name: "topic", Declaration: "String"},),
+			"Message": borsh.StructDef(
+				borsh.Field{Name: "header", Declaration: "Header"},
+				borsh.Field{Name: "reply", Declaration: "Option<Header>"},
+				borsh.Field{Name: "payload", Declaration: "Vec<u8>"},
+				borsh.Field{Name: "chunks", Declaration: "Vec<Vec<u8>>"},
+				borsh.Field{Name: "labels", Declaration: "BTreeMap<String, u32>"},
+				borsh.Field{Name: "peers", Declaration: "BTreeSet<String>"},
+				borsh.Field{Name: "trail", Declaration: "Vec<Header>"},),
+			"Option<Header>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Header"}),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<Header>": borsh.SequenceDef(4, 0, 4294967295, "Header"),
+			"Vec<Vec<u8>>": borsh.SequenceDef(4, 0, 4294967295, "Vec<u8>"),
+			"Vec<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Routed) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Routed) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Routed", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Routed to buf.
+// The FieldPath of a returned EncodeError is relative to Routed
+func (s Routed) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Routed) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Routed in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Routed) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Routed", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Routed in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Routed) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Routed", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Routed to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Routed) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Routed", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Routed to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Routed
+func (s Routed) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Via); err != nil {
+						return borsh.EncodeFieldError("Via", err)
+					}
+		}
+		{
+		
+				// Hops (hops) - slice
+				// ElementType: []*common.Header
+				// Type: []*common.Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Hops: []Hops
+	if err := checkLength("slice", len((s.Hops)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Hops", err)
+	}
+	 appendLength(buf, len((s.Hops)))
+		for i, item := range (s.Hops) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Version uint8 "msg:\"version\""; Chain string "msg:\"chain\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Hops", i), err)
+					}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Msg); err != nil {
+						return borsh.EncodeFieldError("Msg", err)
+					}
+		}
+	return nil
+}
+func (s *Routed) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Routed", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Routed like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Routed, without trailing bytes
+func (s *Routed) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Routed", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Routed in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Routed, so wrap unbuffered readers in a bufio.Reader
+func (s *Routed) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Routed", err)
+}
+
+// ReadBorsh decodes Routed nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Routed
+func (s *Routed) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Routed is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Routed
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &common.Header{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Via", err)
+					}
+						s.Via = *m
+		}
+		{
+		
+				// Hops (hops) - slice
+				// ElementType: []*common.Header
+				// Type: []*common.Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Hops: []Hops
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Hops", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]*common.Header](r, length)
+		if err != nil {
+			return r.FieldError("Hops", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Version uint8 "msg:\"version\""; Chain string "msg:\"chain\""}
+			// Element common.Header
+	
+					// IsPointer: true
+					
+						// IsPointer: false
+					m := &common.Header{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Hops", i), err)
+					}
+						p[i] = m
+		}
+				s.Hops =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Message{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Msg", err)
+					}
+						s.Msg = *m
+		}
+	return err
+}
+func (s Routed) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Via)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Via", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+		
+				// Hops (hops) - slice
+				// ElementType: []*common.Header
+				// Type: []*common.Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Hops: []Hops
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Hops) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Version uint8 "msg:\"version\""; Chain string "msg:\"chain\""}
+			// Element common.Header
+	
+		// ElementType: struct{Version uint8 "msg:\"version\""; Chain string "msg:\"chain\""}
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Hops", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Msg)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Msg", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Routed by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Routed) CompareBorsh(other any) int {
+	o := other.(Routed)
+	_ = o
+	if c := borsh.Compare(s.Via, o.Via); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Hops, o.Hops); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Msg, o.Msg); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Routed in fields typed by an interface
+func (Routed) BorshTypeID() uint64 {
+	return 0xd647735e66a4e6f8
+}
+func init() {
+	Registry.Register(0xd647735e66a4e6f8, func() borsh.BorshEncoder { return new(Routed) })
+}
+
+// BorshSchema describes the Borsh encoding of Routed in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Routed) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Routed",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"(String, u32)": borsh.TupleDef("String", "u32"),
+			"BTreeMap<String, u32>": borsh.SequenceDef(4, 0, 4294967295, "(String, u32)"),
+			"BTreeSet<String>": borsh.SequenceDef(4, 0, 4294967295, "String"),
+			"Header": borsh.StructDef(
+				borsh.Field{Name: "version", Declaration: "u8"},
+				borsh.Field{Name: "chain", Declaration: "String"},),
+			"Message": borsh.StructDef(
+				borsh.Field{Name: "header", Declaration: "stream::Header"},
+				borsh.Field{Name: "reply", Declaration: "Option<stream::Header>"},
+				borsh.Field{Name: "payload", Declaration: "Vec<u8>"},
+				borsh.Field{Name: "chunks", Declaration: "Vec<Vec<u8>>"},
+				borsh.Field{Name: "labels", Declaration: "BTreeMap<String, u32>"},
+				borsh.Field{Name: "peers", Declaration: "BTreeSet<String>"},
+				borsh.Field{Name: "trail", Declaration: "Vec<stream::Header>"},),
+			"Option<stream::Header>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "stream::Header"}),
+			"Routed": borsh.StructDef(
+				borsh.Field{Name: "via", Declaration: "Header"},
+				borsh.Field{Name: "hops", Declaration: "Vec<Header>"},
+				borsh.Field{Name: "msg", Declaration: "Message"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<Header>": borsh.SequenceDef(4, 0, 4294967295, "Header"),
+			"Vec<Vec<u8>>": borsh.SequenceDef(4, 0, 4294967295, "Vec<u8>"),
+			"Vec<stream::Header>": borsh.SequenceDef(4, 0, 4294967295, "stream::Header"),
+			"Vec<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"stream::Header": borsh.StructDef(
+				borsh.Field{Name: "seq", Declaration: "u64"},
+				borsh.Field{Name: "topic", Declaration: "String"},),
+			"u32": borsh.PrimitiveDef(4),
+			"u64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
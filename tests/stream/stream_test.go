@@ -0,0 +1,225 @@
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+func sample(seq uint64) Message {
+	return Message{
+		Header:  Header{Seq: seq, Topic: "orders"},
+		Reply:   &Header{Seq: seq + 1, Topic: "replies"},
+		Payload: []byte("payload"),
+		Chunks:  [][]byte{{1, 2}, {}, {3}},
+		Labels:  map[string]uint32{"a": 1, "b": 2},
+		Peers:   []string{"alice", "bob"},
+		Trail:   []Header{{Seq: 1, Topic: "x"}, {Seq: 2, Topic: "y"}},
+	}
+}
+
+// large returns a message whose encoding is several times the writer buffer
+func large() Message {
+	v := sample(7)
+	v.Payload = bytes.Repeat([]byte{0xab}, 60000)
+	for i := 0; i < 8; i++ {
+		v.Chunks = append(v.Chunks, bytes.Repeat([]byte{byte(i)}, 20000))
+	}
+	for i := 0; i < 5000; i++ {
+		v.Trail = append(v.Trail, Header{Seq: uint64(i), Topic: "trail"})
+	}
+	return v
+}
+
+// oneByteReader returns at most one byte per Read
+type oneByteReader struct{ r io.Reader }
+
+func (o oneByteReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	return o.r.Read(p[:1])
+}
+
+// chunkWriter records the size of every Write
+type chunkWriter struct {
+	bytes.Buffer
+	writes []int
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	c.writes = append(c.writes, len(p))
+	return c.Buffer.Write(p)
+}
+
+// failWriter fails after accepting limit bytes
+type failWriter struct{ limit int }
+
+func (f *failWriter) Write(p []byte) (int, error) {
+	if len(p) > f.limit {
+		n := f.limit
+		f.limit = 0
+		return n, io.ErrShortWrite
+	}
+	f.limit -= len(p)
+	return len(p), nil
+}
+
+func TestWriteMatchesMarshal(t *testing.T) {
+	for name, v := range map[string]Message{"small": sample(1), "large": large()} {
+		t.Run(name, func(t *testing.T) {
+			want, err := v.MarshalBorsh()
+			if err != nil {
+				t.Fatalf("MarshalBorsh() failed: %v", err)
+			}
+			var out chunkWriter
+			n, err := v.WriteBorshTo(&out)
+			if err != nil {
+				t.Fatalf("WriteBorshTo() failed: %v", err)
+			}
+			if n != int64(len(want)) {
+				t.Errorf("WriteBorshTo() = %d, want %d", n, len(want))
+			}
+			if !bytes.Equal(out.Bytes(), want) {
+				t.Fatalf("WriteBorshTo() wrote different bytes than MarshalBorsh()")
+			}
+			// Writes are bounded by the writer buffer, which flushes once it fills
+			for _, w := range out.writes {
+				if w >= 2*borsh.WriterBufferSize {
+					t.Errorf("WriteBorshTo() made a write of %d bytes", w)
+				}
+			}
+		})
+	}
+}
+
+func TestReadRoundTrip(t *testing.T) {
+	for name, v := range map[string]Message{"small": sample(1), "large": large()} {
+		t.Run(name, func(t *testing.T) {
+			data, err := v.MarshalBorsh()
+			if err != nil {
+				t.Fatalf("MarshalBorsh() failed: %v", err)
+			}
+			var decoded Message
+			n, err := decoded.ReadBorshFrom(oneByteReader{bytes.NewReader(data)})
+			if err != nil {
+				t.Fatalf("ReadBorshFrom() failed: %v", err)
+			}
+			if n != int64(len(data)) {
+				t.Errorf("ReadBorshFrom() = %d, want %d", n, len(data))
+			}
+			if !reflect.DeepEqual(decoded, v) {
+				t.Errorf("ReadBorshFrom() mismatch:\ngot:  %+v\nwant: %+v", decoded.Header, v.Header)
+			}
+		})
+	}
+}
+
+func TestConsecutiveMessages(t *testing.T) {
+	var stream bytes.Buffer
+	want := []Message{sample(1), large(), sample(3)}
+	for _, v := range want {
+		if _, err := v.WriteBorshTo(&stream); err != nil {
+			t.Fatalf("WriteBorshTo() failed: %v", err)
+		}
+	}
+
+	r := bufio.NewReader(&stream)
+	for i, v := range want {
+		var decoded Message
+		if _, err := decoded.ReadBorshFrom(r); err != nil {
+			t.Fatalf("message %d: ReadBorshFrom() failed: %v", i, err)
+		}
+		if !reflect.DeepEqual(decoded, v) {
+			t.Errorf("message %d: mismatch", i)
+		}
+	}
+	var extra Message
+	if _, err := extra.ReadBorshFrom(r); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadBorshFrom() at end of stream = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestTruncatedStream(t *testing.T) {
+	v := sample(1)
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	for _, n := range []int{0, 1, 8, len(data) / 2, len(data) - 1} {
+		var decoded Message
+		read, err := decoded.ReadBorshFrom(bytes.NewReader(data[:n]))
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("ReadBorshFrom(%d of %d bytes) = %v, want io.ErrUnexpectedEOF", n, len(data), err)
+		}
+		if read != int64(n) {
+			t.Errorf("ReadBorshFrom(%d of %d bytes) read %d bytes", n, len(data), read)
+		}
+	}
+}
+
+// readTruncated decodes data that ends early and returns the bytes allocated meanwhile
+func readTruncated(t *testing.T, data []byte) uint64 {
+	t.Helper()
+	var decoded Message
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := decoded.ReadBorshFrom(bytes.NewReader(data))
+	runtime.ReadMemStats(&after)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadBorshFrom() = %v, want io.ErrUnexpectedEOF", err)
+	}
+	return after.TotalAlloc - before.TotalAlloc
+}
+
+func TestHostileLength(t *testing.T) {
+	le := binary.LittleEndian
+	var prefix []byte
+	prefix = le.AppendUint64(prefix, 1) // Header.Seq
+	prefix = le.AppendUint32(prefix, 0) // Header.Topic
+	prefix = append(prefix, 0)          // Reply: nil
+
+	// A payload of MaxSliceLen bytes is read as it arrives rather than allocated up front
+	data := le.AppendUint32(bytes.Clone(prefix), MaxSliceLen)
+	data = append(data, make([]byte, 1000)...)
+	if allocated := readTruncated(t, data); allocated > 1<<15 {
+		t.Errorf("ReadBorshFrom() allocated %d bytes for a truncated payload", allocated)
+	}
+
+	// So is a chunk count of MaxSliceLen
+	chunks := le.AppendUint32(bytes.Clone(prefix), 0) // Payload
+	data = le.AppendUint32(bytes.Clone(chunks), MaxSliceLen)
+	if allocated := readTruncated(t, data); allocated > 1<<15 {
+		t.Errorf("ReadBorshFrom() allocated %d bytes for %d chunks", allocated, MaxSliceLen)
+	}
+
+	// Larger lengths are rejected before anything is read
+	for path, data := range map[string][]byte{
+		"Message.Payload": le.AppendUint32(bytes.Clone(prefix), 0xffffffff),
+		"Message.Chunks":  le.AppendUint32(bytes.Clone(chunks), 0x7fffffff),
+	} {
+		var decoded Message
+		_, err := decoded.ReadBorshFrom(bytes.NewReader(data))
+		if de := decodeError(t, err); de.Kind != borsh.LengthLimit || de.FieldPath != path {
+			t.Errorf("ReadBorshFrom() = %v, want a LengthLimit error at %s", err, path)
+		}
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	v := large()
+	n, err := v.WriteBorshTo(&failWriter{limit: 1000})
+	if !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("WriteBorshTo() = %v, want io.ErrShortWrite", err)
+	}
+	if n != 1000 {
+		t.Errorf("WriteBorshTo() = %d, want 1000", n)
+	}
+}
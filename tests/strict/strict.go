@@ -0,0 +1,11 @@
+package strict
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -strict -fuzz
+type Reading struct {
+	Valid bool     `msg:"valid"`
+	Ratio float64  `msg:"ratio"`
+	Scale float32  `msg:"scale"`
+	Note  *string  `msg:"note"`
+	Flags []bool   `msg:"flags"`
+	Prev  *Reading `msg:"prev"`
+}
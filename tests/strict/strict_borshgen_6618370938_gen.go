@@ -0,0 +1,524 @@
+package strict
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Reading) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Reading) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Reading", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Reading to buf.
+// The FieldPath of a returned EncodeError is relative to Reading
+func (s Reading) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Reading) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Reading in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Reading) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Reading", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Reading in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Reading) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Reading", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Reading to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Reading) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Reading", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Reading to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Reading
+func (s Reading) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if s.Valid {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(s.Ratio)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float32
+					buf.WriteFloat32(s.Scale)
+		}
+		{
+			if s.Note == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPNote
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Note (note) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := *s.Note
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Note", err)
+					}
+					appendString(buf, string(str))
+		}
+					SKIPNote:
+		{
+		
+				// Flags (flags) - slice
+				// ElementType: []bool
+				// Type: []bool
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Flags: []Flags
+	if err := checkLength("slice", len((s.Flags)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Flags", err)
+	}
+	 appendLength(buf, len((s.Flags)))
+		for i, item := range (s.Flags) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType bool
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if item {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+		}
+		}
+		{
+			if s.Prev == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPPrev
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Prev (prev) - Pointer
+					// ElementType: struct{Valid bool "msg:\"valid\""; Ratio float64 "msg:\"ratio\""; Scale float32 "msg:\"scale\""; Note *string "msg:\"note\""; Flags []bool "msg:\"flags\""; Prev *Reading "msg:\"prev\""}
+					// Type: Reading
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Reading
+					if err := writeNested(buf, s.Prev); err != nil {
+						return borsh.EncodeFieldError("Prev", err)
+					}
+		}
+					SKIPPrev:
+	return nil
+}
+func (s *Reading) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Reading", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Reading like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Reading, without trailing bytes
+func (s *Reading) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Reading", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Reading in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Reading, so wrap unbuffered readers in a bufio.Reader
+func (s *Reading) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Reading", err)
+}
+
+// ReadBorsh decodes Reading nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Reading
+func (s *Reading) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Reading is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Reading
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadBool()
+	if err != nil {
+		return r.FieldError("Valid", err)
+	}
+	__m := bool(__v)
+	s.Valid = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadFloat64()
+	if err != nil {
+		return r.FieldError("Ratio", err)
+	}
+	__m := float64(__v)
+	s.Ratio = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadFloat32()
+	if err != nil {
+		return r.FieldError("Scale", err)
+	}
+	__m := float32(__v)
+	s.Scale = (__m)
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Note", err)
+				}
+				if !present {
+					s.Note = nil
+					goto SKIPNote
+				} 
+			}
+		{
+		
+					// Note (note) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: string
+					// IsPointer: true
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Note", err)
+	}
+	__m := string(__s)
+	s.Note = &(__m)
+		}
+					SKIPNote:
+		{
+		
+				// Flags (flags) - slice
+				// ElementType: []bool
+				// Type: []bool
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Flags: []Flags
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Flags", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]bool](r, length)
+		if err != nil {
+			return r.FieldError("Flags", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType bool
+			// Element bool
+	__v, err := r.ReadBool()
+	if err != nil {
+		return r.FieldError(borsh.Index("Flags", i), err)
+	}
+	__m := bool(__v)
+	p[i] = (__m)
+		}
+				s.Flags =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Prev", err)
+				}
+				if !present {
+					s.Prev = nil
+					goto SKIPPrev
+				} 
+			}
+		{
+		
+					// Prev (prev) - Pointer
+					// ElementType: struct{Valid bool "msg:\"valid\""; Ratio float64 "msg:\"ratio\""; Scale float32 "msg:\"scale\""; Note *string "msg:\"note\""; Flags []bool "msg:\"flags\""; Prev *Reading "msg:\"prev\""}
+					// Type: Reading
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Reading
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Reading{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Prev", err)
+					}
+						s.Prev = m
+		}
+					SKIPPrev:
+	return err
+}
+func (s Reading) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+			size++
+			if s.Note == nil {
+				goto SKIPNote
+			}
+		}
+		{
+		
+					// Note (note) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+		// ElementType: string
+		
+			// Element: true
+				ptr := *s.Note
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+			SKIPNote:
+		{
+		
+				// Flags (flags) - slice
+				// ElementType: []bool
+				// Type: []bool
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Flags: []Flags
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Flags) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType bool
+			// Element bool
+				size += 1
+		}
+		}
+		{
+			size++
+			if s.Prev == nil {
+				goto SKIPPrev
+			}
+		}
+		{
+		
+					// Prev (prev) - Pointer
+					// ElementType: struct{Valid bool "msg:\"valid\""; Ratio float64 "msg:\"ratio\""; Scale float32 "msg:\"scale\""; Note *string "msg:\"note\""; Flags []bool "msg:\"flags\""; Prev *Reading "msg:\"prev\""}
+					// Type: Reading
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Reading
+	
+		// ElementType: struct{Valid bool "msg:\"valid\""; Ratio float64 "msg:\"ratio\""; Scale float32 "msg:\"scale\""; Note *string "msg:\"note\""; Flags []bool "msg:\"flags\""; Prev *Reading "msg:\"prev\""}
+		
+			// Element: false
+	
+		// ElementType: struct{Valid bool "msg:\"valid\""; Ratio float64 "msg:\"ratio\""; Scale float32 "msg:\"scale\""; Note *string "msg:\"note\""; Flags []bool "msg:\"flags\""; Prev *Reading "msg:\"prev\""}
+			bs, err := borsh.SizeOf(s.Prev)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Prev", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPPrev:
+	return size, nil
+}
+
+// CompareBorsh orders Reading by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Reading) CompareBorsh(other any) int {
+	o := other.(Reading)
+	_ = o
+	if c := borsh.Compare(s.Valid, o.Valid); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Ratio, o.Ratio); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Scale, o.Scale); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Note, o.Note); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Flags, o.Flags); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Prev, o.Prev); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Reading in fields typed by an interface
+func (Reading) BorshTypeID() uint64 {
+	return 0xba127022301c6a7d
+}
+func init() {
+	Registry.Register(0xba127022301c6a7d, func() borsh.BorshEncoder { return new(Reading) })
+}
+
+// BorshSchema describes the Borsh encoding of Reading in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Reading) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Reading",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Option<Reading>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Reading"}),
+			"Option<String>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "String"}),
+			"Reading": borsh.StructDef(
+				borsh.Field{Name: "valid", Declaration: "bool"},
+				borsh.Field{Name: "ratio", Declaration: "f64"},
+				borsh.Field{Name: "scale", Declaration: "f32"},
+				borsh.Field{Name: "note", Declaration: "Option<String>"},
+				borsh.Field{Name: "flags", Declaration: "Vec<bool>"},
+				borsh.Field{Name: "prev", Declaration: "Option<Reading>"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<bool>": borsh.SequenceDef(4, 0, 4294967295, "bool"),
+			"bool": borsh.PrimitiveDef(1),
+			"f32": borsh.PrimitiveDef(4),
+			"f64": borsh.PrimitiveDef(8),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
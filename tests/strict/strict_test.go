@@ -0,0 +1,104 @@
+package strict
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+// layout returns the encoding of a Reading with the given valid byte and ratio bits,
+// no note, no flags and no previous reading
+func layout(valid byte, ratio uint64) []byte {
+	data := []byte{valid}
+	data = binary.LittleEndian.AppendUint64(data, ratio)
+	data = binary.LittleEndian.AppendUint32(data, math.Float32bits(1))
+	data = append(data, 0)          // note
+	data = append(data, 0, 0, 0, 0) // flags
+	data = append(data, 0)          // prev
+	return data
+}
+
+func TestStrictRoundTrip(t *testing.T) {
+	note := "calibrated"
+	v := Reading{
+		Valid: true,
+		Ratio: 0.5,
+		Scale: 2,
+		Note:  &note,
+		Flags: []bool{true, false},
+		Prev:  &Reading{Ratio: -1, Flags: []bool{}},
+	}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	var restored Reading
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(restored, v) {
+		t.Errorf("round trip mismatch: got %+v, want %+v", restored, v)
+	}
+}
+
+func TestStrictRejects(t *testing.T) {
+	if !StrictDecoding {
+		t.Fatal("package must be generated with -strict")
+	}
+	valid := layout(1, math.Float64bits(0.5))
+	cases := []struct {
+		name   string
+		data   []byte
+		kind   borsh.ErrorKind
+		path   string
+		offset int64
+	}{
+		{"trailing bytes", append(append([]byte{}, valid...), 0), borsh.TrailingBytes, "Reading", int64(len(valid))},
+		{"bool", layout(2, math.Float64bits(0.5)), borsh.InvalidBool, "Reading.Valid", 0},
+		{"nan", layout(1, 0x7ff8000000000001), borsh.InvalidValue, "Reading.Ratio", 1},
+		{"option flag", append(valid[:len(valid)-1:len(valid)-1], 2), borsh.UnknownVariant, "Reading.Prev", int64(len(valid) - 1)},
+		{"bool element", append(layout(1, 0)[:14:14], 1, 0, 0, 0, 7, 0), borsh.InvalidBool, "Reading.Flags[0]", 18},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var v Reading
+			err := v.UnmarshalBorsh(tc.data)
+			var de *borsh.DecodeError
+			if !errors.As(err, &de) || !errors.Is(err, tc.kind) || de.FieldPath != tc.path || de.Offset != tc.offset {
+				t.Fatalf("UnmarshalBorsh() error = %v, want %v at %s, offset %d", err, tc.kind, tc.path, tc.offset)
+			}
+		})
+	}
+
+	var v Reading
+	if err := v.UnmarshalBorsh(valid); err != nil {
+		t.Errorf("UnmarshalBorsh() failed: %v", err)
+	}
+}
+
+func TestCanonicalNaN(t *testing.T) {
+	// math.NaN is not the canonical NaN, but it is written as one
+	v := Reading{Ratio: math.NaN(), Scale: float32(math.NaN())}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if want := layout(0, 0x7ff8000000000000); !bytes.Equal(data[:9], want[:9]) {
+		t.Errorf("MarshalBorsh() ratio = %x, want the canonical NaN", data[1:9])
+	}
+	if got := binary.LittleEndian.Uint32(data[9:13]); got != 0x7fc00000 {
+		t.Errorf("MarshalBorsh() scale = %#x, want the canonical NaN", got)
+	}
+	var restored Reading
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !math.IsNaN(restored.Ratio) || !math.IsNaN(float64(restored.Scale)) {
+		t.Errorf("UnmarshalBorsh() = %+v, want NaNs", restored)
+	}
+}
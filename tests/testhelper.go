@@ -77,18 +77,18 @@ func (c CustomFixedSliceEncoder) Encode(field any,  parent any) ([]byte, error)
 type ID int64
 type System string
 
-//go:generate borshgen -tag=msg -fallback=json -pool-size=LG
+//go:generate borshgen -tag=msg -fallback=json -pool-size=LG -gen-tests
 type EntityPath struct {
 	Name string
 }
 
-//go:generate borshgen -tag=msg -fallback=json
+//go:generate borshgen -tag=msg -fallback=json -gen-tests
 type EventPath struct {
 	EntityPath
 	ID        ID     `msg:"id,int64" enc:""`
 	Timestamp uint64 `msg:"ts" enc:""`
 }
-//go:generate borshgen -tag=msg -fallback=json
+//go:generate borshgen -tag=msg -fallback=json -gen-tests
 type Event struct {
 	// Basic types
 	Any any `msg:"a,_DefaultByteArrayEncoder" enc:"func"`
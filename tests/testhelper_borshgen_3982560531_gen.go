@@ -0,0 +1,3629 @@
+package tests
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/constants"
+	"github.com/mlayerprotocol/go-borshgen/tests/configs"
+)
+var _ constants.EventType
+var _ configs.ChainId
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s EntityPath) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s EntityPath) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("EntityPath", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of EntityPath to buf.
+// The FieldPath of a returned EncodeError is relative to EntityPath
+func (s EntityPath) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s EntityPath) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends EntityPath in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s EntityPath) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("EntityPath", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes EntityPath in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s EntityPath) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "EntityPath", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes EntityPath to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s EntityPath) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("EntityPath", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes EntityPath to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to EntityPath
+func (s EntityPath) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Name
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Name", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *EntityPath) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("EntityPath", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to EntityPath like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of EntityPath, without trailing bytes
+func (s *EntityPath) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("EntityPath", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads EntityPath in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of EntityPath, so wrap unbuffered readers in a bufio.Reader
+func (s *EntityPath) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("EntityPath", err)
+}
+
+// ReadBorsh decodes EntityPath nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to EntityPath
+func (s *EntityPath) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "EntityPath is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: EntityPath
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Name", err)
+	}
+	__m := string(__s)
+	s.Name = (__m)
+		}
+	return err
+}
+func (s EntityPath) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Name
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders EntityPath by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s EntityPath) CompareBorsh(other any) int {
+	o := other.(EntityPath)
+	_ = o
+	if c := borsh.Compare(s.Name, o.Name); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before EntityPath in fields typed by an interface
+func (EntityPath) BorshTypeID() uint64 {
+	return 0x391771391b3916b6
+}
+func init() {
+	Registry.Register(0x391771391b3916b6, func() borsh.BorshEncoder { return new(EntityPath) })
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s EventPath) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 2
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+			tags[i] = "id"
+			encTypes[i] = ""
+			values[i] = s.ID
+			i++
+			tags[i] = "ts"
+			encTypes[i] = ""
+			values[i] = s.Timestamp
+			i++
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s EventPath) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("EventPath", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of EventPath to buf.
+// The FieldPath of a returned EncodeError is relative to EventPath
+func (s EventPath) EncodeBorsh(buf *borsh.Writer) error {
+	
+	// Field: ID (tag: id)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: ID
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(s.ID))
+		}
+	}
+	
+	// Field: Timestamp (tag: ts)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: uint64
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Timestamp))
+		}
+	}
+	return nil
+}
+func (s EventPath) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends EventPath in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s EventPath) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("EventPath", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes EventPath in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s EventPath) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "EventPath", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes EventPath to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s EventPath) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("EventPath", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes EventPath to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to EventPath
+func (s EventPath) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.EntityPath); err != nil {
+						return borsh.EncodeFieldError("EntityPath", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(s.ID))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Timestamp))
+		}
+	return nil
+}
+func (s *EventPath) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("EventPath", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to EventPath like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of EventPath, without trailing bytes
+func (s *EventPath) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("EventPath", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads EventPath in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of EventPath, so wrap unbuffered readers in a bufio.Reader
+func (s *EventPath) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("EventPath", err)
+}
+
+// ReadBorsh decodes EventPath nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to EventPath
+func (s *EventPath) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "EventPath is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: EventPath
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &EntityPath{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("EntityPath", err)
+					}
+						s.EntityPath = *m
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("ID", err)
+	}
+	__m := ID(__v)
+	s.ID = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Timestamp", err)
+	}
+	__m := uint64(__v)
+	s.Timestamp = (__m)
+		}
+	return err
+}
+func (s EventPath) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.EntityPath)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("EntityPath", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+	return size, nil
+}
+
+// CompareBorsh orders EventPath by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s EventPath) CompareBorsh(other any) int {
+	o := other.(EventPath)
+	_ = o
+	if c := borsh.Compare(s.EntityPath, o.EntityPath); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.ID, o.ID); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Timestamp, o.Timestamp); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before EventPath in fields typed by an interface
+func (EventPath) BorshTypeID() uint64 {
+	return 0xe686284299f3b3d4
+}
+func init() {
+	Registry.Register(0xe686284299f3b3d4, func() borsh.BorshEncoder { return new(EventPath) })
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Event) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 29
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+			tags[i] = "a"
+			encTypes[i] = "func"
+			values[i] = s.Any
+			i++
+			tags[i] = "aa"
+			encTypes[i] = "f"
+			values[i] = s.ArrayAny
+			i++
+			tags[i] = "checksums"
+			encTypes[i] = ""
+			values[i] = s.Checksums
+			i++
+			tags[i] = "counter"
+			encTypes[i] = ""
+			values[i] = s.Counter
+			i++
+			tags[i] = "eid"
+			encTypes[i] = ""
+			values[i] = s.EID
+			i++
+			tags[i] = "flag"
+			encTypes[i] = ""
+			values[i] = s.Flag
+			i++
+			tags[i] = "fs"
+			encTypes[i] = ""
+			values[i] = s.FixedSlice
+			i++
+			tags[i] = "fsc"
+			encTypes[i] = "func"
+			values[i] = s.FixedSliceCustom
+			i++
+			tags[i] = "id"
+			encTypes[i] = ""
+			values[i] = s.ID
+			i++
+			tags[i] = "jps"
+			encTypes[i] = ""
+			values[i] = s.JsonPointerSliceData
+			i++
+			tags[i] = "jsd"
+			encTypes[i] = ""
+			values[i] = s.JsonData
+			i++
+			tags[i] = "jsp"
+			encTypes[i] = ""
+			values[i] = s.JsonPtrData
+			i++
+			tags[i] = "jss"
+			encTypes[i] = ""
+			values[i] = s.JsonSliceData
+			i++
+			tags[i] = "opt_counter"
+			encTypes[i] = ""
+			values[i] = s.OptionalCounter
+			i++
+			tags[i] = "opt_flag"
+			encTypes[i] = ""
+			values[i] = s.OptionalFlag
+			i++
+			tags[i] = "opt_score"
+			encTypes[i] = ""
+			values[i] = s.OptionalScore
+			i++
+			tags[i] = "par"
+			encTypes[i] = ""
+			values[i] = s.PointerArray
+			i++
+			tags[i] = "parent"
+			encTypes[i] = "f"
+			values[i] = s.Parent
+			i++
+			tags[i] = "path"
+			encTypes[i] = ""
+			values[i] = s.Path
+			i++
+			tags[i] = "paths"
+			encTypes[i] = ""
+			values[i] = s.Paths
+			i++
+			tags[i] = "rating"
+			encTypes[i] = ""
+			values[i] = s.Rating
+			i++
+			tags[i] = "score"
+			encTypes[i] = ""
+			values[i] = s.Score
+			i++
+			tags[i] = "sizes"
+			encTypes[i] = ""
+			values[i] = s.Sizes
+			i++
+			tags[i] = "sys"
+			encTypes[i] = ""
+			values[i] = s.Systems
+			i++
+			tags[i] = "tags"
+			encTypes[i] = ""
+			values[i] = s.Tags
+			i++
+			tags[i] = "ts"
+			encTypes[i] = "int"
+			values[i] = s.Timestamp
+			i++
+			tags[i] = "type"
+			encTypes[i] = "func"
+			values[i] = s.EventType
+			i++
+			tags[i] = "typep"
+			encTypes[i] = ""
+			values[i] = s.Chain
+			i++
+			tags[i] = "versions"
+			encTypes[i] = ""
+			values[i] = s.Versions
+			i++
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Event) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Event", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Event to buf.
+// The FieldPath of a returned EncodeError is relative to Event
+func (s Event) EncodeBorsh(buf *borsh.Writer) error {
+	
+	// Field: Any (tag: a)
+	// IsBasicType: true
+	// CustomeFieldEncoder: true
+	// CustomeElementncoder: any
+	{
+		{
+			data, err := _DefaultByteArrayEncoder.Encode(((s.Any)), s)
+			if err == nil {
+				err = checkLength("byte slice", len(data), MaxSliceLen)
+			}
+			if err != nil {
+				return borsh.EncodeFieldError("Any", err)
+			}
+			buf.Write(data)
+		}
+	}
+	
+	// Field: ArrayAny (tag: aa)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// ArrayAny (aa) - slice
+				// ElementType: []any
+				// Type: []any
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of ArrayAny: []ArrayAny
+	if err := checkLength("slice", len((s.ArrayAny)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("ArrayAny", err)
+	}
+		for i, item := range (s.ArrayAny) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+	
+					// BASICTYPE: true
+					// ELNTYPE: any
+					data, err := encodeAny(item)
+					if err != nil {
+						return borsh.EncodeFieldError(borsh.Index("ArrayAny", i), err)
+					}
+					buf.Write(data)
+		}
+		}
+	}
+	
+	// Field: Checksums (tag: checksums)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// Checksums (checksums) - slice
+				// ElementType: [][]byte
+				// Type: [][]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Checksums: []Checksums
+	if err := checkLength("slice", len((s.Checksums)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Checksums", err)
+	}
+		for i, item := range (s.Checksums) {
+			_ = i
+		data, err := _DefaultByteArrayEncoder.Encode((item), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError(borsh.Index("Checksums", i), err)
+		}
+		buf.Write(data)
+		}
+		}
+	}
+	
+	// Field: Counter (tag: counter)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: int32
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(s.Counter))
+		}
+	}
+	
+	// Field: EID (tag: eid)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// EID (eid) - slice
+				// ElementType: []int
+				// Type: []int
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of EID: []EID
+	if err := checkLength("slice", len((s.EID)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("EID", err)
+	}
+		for i, item := range (s.EID) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int
+	
+					// BASICTYPE: true
+					// ELNTYPE: int
+					buf.WriteUint64(uint64(item))
+		}
+		}
+	}
+	
+	// Field: Flag (tag: flag)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: bool
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if s.Flag {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+		}
+	}
+	
+	// Field: FixedSlice (tag: fs)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// FixedSlice (fs) - slice
+				// ElementType: [][32]byte
+				// Type: [][32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of FixedSlice: []FixedSlice
+	if err := checkLength("slice", len((s.FixedSlice)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("FixedSlice", err)
+	}
+		for i, item := range (s.FixedSlice) {
+			_ = i
+
+		// ElementIsSlice: true
+		if err := checkLength("slice", len((item)), MaxSliceLen); err != nil {
+			return borsh.EncodeFieldError(borsh.Index("FixedSlice", i), err)
+		}
+		for i0, inner := range (item) {
+			_ = i0
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(inner))
+		}
+		}
+		}
+	}
+	
+	// Field: FixedSliceCustom (tag: fsc)
+	// IsBasicType: false
+	// CustomeFieldEncoder: true
+	// CustomeElementncoder: 
+	{
+		{
+			data, err := _FixedSliceEncoder.Encode(((s.FixedSliceCustom)), s)
+			if err == nil {
+				err = checkLength("byte slice", len(data), MaxSliceLen)
+			}
+			if err != nil {
+				return borsh.EncodeFieldError("FixedSliceCustom", err)
+			}
+			buf.Write(data)
+		}
+	}
+	
+	// Field: ID (tag: id)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: ID
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(s.ID))
+		}
+	}
+	
+	// Field: JsonPointerSliceData (tag: jps)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+			if s.JsonPointerSliceData == nil {
+				goto SKIPJsonPointerSliceData
+			}
+		{
+		
+				// JsonPointerSliceData (jps) - slice
+				// ElementType: []json.RawMessage
+				// Type: []json.RawMessage
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of JsonPointerSliceData: []JsonPointerSliceData
+	if err := checkLength("slice", len(*(s.JsonPointerSliceData)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("JsonPointerSliceData", err)
+	}
+		for i, item := range *(s.JsonPointerSliceData) {
+			_ = i
+		data, err := _DefaultJsonRawMessageEncoder.Encode((item), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError(borsh.Index("JsonPointerSliceData", i), err)
+		}
+		buf.Write(data)
+		}
+		}
+			 SKIPJsonPointerSliceData:
+	}
+	
+	// Field: JsonData (tag: jsd)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: []byte
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: true
+		data, err := _DefaultJsonRawMessageEncoder.Encode((s.JsonData), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("JsonData", err)
+		}
+		buf.Write(data)
+		}
+	}
+	
+	// Field: JsonPtrData (tag: jsp)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+			if s.JsonPtrData == nil {
+				goto SKIPJsonPtrData
+			}
+		{
+		
+					// JsonPtrData (jsp) - Pointer
+					// ElementType: []byte
+					// Type: json.RawMessage
+					// ActualType: []byte
+					// BasicType: false
+			
+					// ElementType: json.RawMessage
+	
+			// s.JsonPtrData - custom type
+			
+				// Element: []byte
+		data, err := _DefaultJsonRawMessageEncoder.Encode((*s.JsonPtrData), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("JsonPtrData", err)
+		}
+		buf.Write(data)
+		}
+			 SKIPJsonPtrData:
+	}
+	
+	// Field: JsonSliceData (tag: jss)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// JsonSliceData (jss) - slice
+				// ElementType: []json.RawMessage
+				// Type: []json.RawMessage
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of JsonSliceData: []JsonSliceData
+	if err := checkLength("slice", len((s.JsonSliceData)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("JsonSliceData", err)
+	}
+		for i, item := range (s.JsonSliceData) {
+			_ = i
+		data, err := _DefaultJsonRawMessageEncoder.Encode((item), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError(borsh.Index("JsonSliceData", i), err)
+		}
+		buf.Write(data)
+		}
+		}
+	}
+	
+	// Field: OptionalCounter (tag: opt_counter)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+			if s.OptionalCounter == nil {
+				goto SKIPOptionalCounter
+			}
+		{
+		
+					// OptionalCounter (opt_counter) - Pointer
+					// ElementType: int32
+					// Type: int32
+					// ActualType: *int32
+					// BasicType: true
+			
+					// ElementType: int32
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(*s.OptionalCounter))
+		}
+			 SKIPOptionalCounter:
+	}
+	
+	// Field: OptionalFlag (tag: opt_flag)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+			if s.OptionalFlag == nil {
+				goto SKIPOptionalFlag
+			}
+		{
+		
+					// OptionalFlag (opt_flag) - Pointer
+					// ElementType: bool
+					// Type: bool
+					// ActualType: *bool
+					// BasicType: true
+			
+					// ElementType: bool
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if *s.OptionalFlag {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+		}
+			 SKIPOptionalFlag:
+	}
+	
+	// Field: OptionalScore (tag: opt_score)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+			if s.OptionalScore == nil {
+				goto SKIPOptionalScore
+			}
+		{
+		
+					// OptionalScore (opt_score) - Pointer
+					// ElementType: float64
+					// Type: float64
+					// ActualType: *float64
+					// BasicType: true
+			
+					// ElementType: float64
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(*s.OptionalScore)
+		}
+			 SKIPOptionalScore:
+	}
+	
+	// Field: PointerArray (tag: par)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// PointerArray (par) - slice
+				// ElementType: []*EventPath
+				// Type: []*EventPath
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of PointerArray: []PointerArray
+	if err := checkLength("slice", len((s.PointerArray)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("PointerArray", err)
+	}
+		for i, item := range (s.PointerArray) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+	
+			// item - custom type
+			
+				// Element: No Element
+					if err := borsh.WriteEncoded(buf, *item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("PointerArray", i), err)
+					}
+		}
+		}
+	}
+	
+	// Field: Parent (tag: parent)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+			if s.Parent == nil {
+				goto SKIPParent
+			}
+		{
+		
+				// Parent (parent) - slice
+				// ElementType: []ID
+				// Type: []ID
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Parent: []Parent
+	if err := checkLength("slice", len(*(s.Parent)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Parent", err)
+	}
+		for i, item := range *(s.Parent) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(item))
+		}
+		}
+			 SKIPParent:
+	}
+	
+	// Field: Path (tag: path)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+			if s.Path == nil {
+				goto SKIPPath
+			}
+		{
+		
+					// Path (path) - Pointer
+					// ElementType: ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+					// Type: EventPath
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: EventPath
+	
+			// s.Path - custom type
+			
+				// Element: ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+	
+			// s.Path - custom type
+			
+				// Element: No Element
+					if err := borsh.WriteEncoded(buf, *s.Path); err != nil {
+						return borsh.EncodeFieldError("Path", err)
+					}
+		}
+			 SKIPPath:
+	}
+	
+	// Field: Paths (tag: paths)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// Paths (paths) - slice
+				// ElementType: []EventPath
+				// Type: []EventPath
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Paths: []Paths
+	if err := checkLength("slice", len((s.Paths)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Paths", err)
+	}
+		for i, item := range (s.Paths) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+	
+			// item - custom type
+			
+				// Element: No Element
+					if err := borsh.WriteEncoded(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Paths", i), err)
+					}
+		}
+		}
+	}
+	
+	// Field: Rating (tag: rating)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: float32
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float32
+					buf.WriteFloat32(s.Rating)
+		}
+	}
+	
+	// Field: Score (tag: score)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: float64
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(s.Score)
+		}
+	}
+	
+	// Field: Sizes (tag: sizes)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// Sizes (sizes) - slice
+				// ElementType: []uint64
+				// Type: []uint64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sizes: []Sizes
+	if err := checkLength("slice", len((s.Sizes)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Sizes", err)
+	}
+		for i, item := range (s.Sizes) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(item))
+		}
+		}
+	}
+	
+	// Field: Systems (tag: sys)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// Systems (sys) - slice
+				// ElementType: []System
+				// Type: []System
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Systems: []Systems
+	if err := checkLength("slice", len((s.Systems)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Systems", err)
+	}
+		for i, item := range (s.Systems) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Systems", i), err)
+					}
+					buf.Write([]byte(str))
+		}
+		}
+	}
+	
+	// Field: Tags (tag: tags)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	if err := checkLength("slice", len((s.Tags)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Tags", err)
+	}
+		for i, item := range (s.Tags) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Tags", i), err)
+					}
+					buf.Write([]byte(str))
+		}
+		}
+	}
+	
+	// Field: Timestamp (tag: ts)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: uint64
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Timestamp))
+		}
+	}
+	
+	// Field: EventType (tag: type)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: uint16
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.EventType))
+		}
+	}
+	
+	// Field: Chain (tag: typep)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// Chain (typep) - slice
+				// ElementType: [][][]configs.ChainId
+				// Type: [][][]configs.ChainId
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chain: []Chain
+	if err := checkLength("slice", len((s.Chain)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Chain", err)
+	}
+		for i, item := range (s.Chain) {
+			_ = i
+
+		// ElementIsSlice: true
+		if err := checkLength("slice", len((item)), MaxSliceLen); err != nil {
+			return borsh.EncodeFieldError(borsh.Index("Chain", i), err)
+		}
+		for i0, inner := range (item) {
+			_ = i0
+
+		// ElementIsSlice: true
+		if err := checkLength("slice", len((inner)), MaxSliceLen); err != nil {
+			return borsh.EncodeFieldError(borsh.Index(borsh.Index("Chain", i), i0), err)
+		}
+		for i1, inner := range (inner) {
+			_ = i1
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := inner
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index(borsh.Index(borsh.Index("Chain", i), i0), i1), err)
+					}
+					buf.Write([]byte(str))
+		}
+		}
+		}
+		}
+	}
+	
+	// Field: Versions (tag: versions)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// Versions (versions) - slice
+				// ElementType: []int32
+				// Type: []int32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Versions: []Versions
+	if err := checkLength("slice", len((s.Versions)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Versions", err)
+	}
+		for i, item := range (s.Versions) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int32
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(item))
+		}
+		}
+	}
+	return nil
+}
+func (s Event) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Event in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Event) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Event", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Event in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Event) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Event", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Event to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Event) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Event", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Event to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Event
+func (s Event) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+			data, err := _DefaultByteArrayEncoder.MarshalBorsh(((s.Any)), s)
+			if err == nil {
+				err = checkLength("byte slice", len(data), MaxSliceLen)
+			}
+			if err != nil {
+				return borsh.EncodeFieldError("Any", err)
+			}
+			appendBytes(buf, data)
+		}
+		{
+		
+				// ArrayAny (aa) - slice
+				// ElementType: []any
+				// Type: []any
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of ArrayAny: []ArrayAny
+	if err := checkLength("slice", len((s.ArrayAny)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("ArrayAny", err)
+	}
+	 appendLength(buf, len((s.ArrayAny)))
+		for i, item := range (s.ArrayAny) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+	
+					// BASICTYPE: true
+					// ELNTYPE: any
+					if err := appendAny(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("ArrayAny", i), err)
+					}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(s.ID))
+		}
+		{
+		
+				// PointerArray (par) - slice
+				// ElementType: []*EventPath
+				// Type: []*EventPath
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of PointerArray: []PointerArray
+	if err := checkLength("slice", len((s.PointerArray)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("PointerArray", err)
+	}
+	 appendLength(buf, len((s.PointerArray)))
+		for i, item := range (s.PointerArray) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+	
+			// item - custom type
+			
+				// Element: No Element
+					data, err := borsh.MarshalValue(*item)
+					if err != nil {
+						return borsh.EncodeFieldError(borsh.Index("PointerArray", i), err)
+					}
+					if err := appendNested(buf, data); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("PointerArray", i), err)
+					}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+			// s.EventType - custom type
+			
+				// Element: uint16
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.EventType))
+		}
+		{
+			data, err := _FixedSliceEncoder.MarshalBorsh(((s.FixedSliceCustom)), s)
+			if err == nil {
+				err = checkLength("byte slice", len(data), MaxSliceLen)
+			}
+			if err != nil {
+				return borsh.EncodeFieldError("FixedSliceCustom", err)
+			}
+			appendBytes(buf, data)
+		}
+		{
+		
+				// FixedSlice (fs) - slice
+				// ElementType: [][32]byte
+				// Type: [][32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of FixedSlice: []FixedSlice
+	if err := checkLength("slice", len((s.FixedSlice)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("FixedSlice", err)
+	}
+	 appendLength(buf, len((s.FixedSlice)))
+		for i, item := range (s.FixedSlice) {
+			_ = i
+	for j0 := 0; j0 < 32; j0++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+	
+					// BASICTYPE: true
+					// ELNTYPE: byte
+					buf.WriteByte(byte(item[j0]))
+	}
+		}
+		}
+		{
+		
+				// Chain (typep) - slice
+				// ElementType: [][][]configs.ChainId
+				// Type: [][][]configs.ChainId
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chain: []Chain
+	if err := checkLength("slice", len((s.Chain)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Chain", err)
+	}
+	 appendLength(buf, len((s.Chain)))
+		for i, item := range (s.Chain) {
+			_ = i
+
+		// ElementIsSlice: true
+		if err := checkLength("slice", len((item)), MaxSliceLen); err != nil {
+			return borsh.EncodeFieldError(borsh.Index("Chain", i), err)
+		}
+		appendLength(buf, len((item)))
+		for i0, inner := range (item) {
+			_ = i0
+
+		// ElementIsSlice: true
+		if err := checkLength("slice", len((inner)), MaxSliceLen); err != nil {
+			return borsh.EncodeFieldError(borsh.Index(borsh.Index("Chain", i), i0), err)
+		}
+		appendLength(buf, len((inner)))
+		for i1, inner := range (inner) {
+			_ = i1
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := inner
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index(borsh.Index(borsh.Index("Chain", i), i0), i1), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+		}
+		}
+		{
+			if s.Parent == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPParent
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+				// Parent (parent) - slice
+				// ElementType: []ID
+				// Type: []ID
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Parent: []Parent
+	if err := checkLength("slice", len(*(s.Parent)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Parent", err)
+	}
+	 appendLength(buf, len(*(s.Parent)))
+		for i, item := range *(s.Parent) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(item))
+		}
+		}
+					SKIPParent:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(s.Timestamp))
+		}
+		{
+		
+				// Data (data) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+	data, err := _DefaultByteArrayEncoder.MarshalBorsh((s.Data), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("Data", err)
+		}
+		 appendBytes(buf, data)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(s.Counter))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if s.Flag {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(s.Score)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: float32
+					buf.WriteFloat32(s.Rating)
+		}
+		{
+		
+				// Systems (sys) - slice
+				// ElementType: []System
+				// Type: []System
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Systems: []Systems
+	if err := checkLength("slice", len((s.Systems)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Systems", err)
+	}
+	 appendLength(buf, len((s.Systems)))
+		for i, item := range (s.Systems) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Systems", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	if err := checkLength("slice", len((s.Tags)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Tags", err)
+	}
+	 appendLength(buf, len((s.Tags)))
+		for i, item := range (s.Tags) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Tags", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+		{
+		
+				// Checksums (checksums) - slice
+				// ElementType: [][]byte
+				// Type: [][]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Checksums: []Checksums
+	if err := checkLength("slice", len((s.Checksums)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Checksums", err)
+	}
+	 appendLength(buf, len((s.Checksums)))
+		for i, item := range (s.Checksums) {
+			_ = i
+		data, err := _DefaultByteArrayEncoder.MarshalBorsh((item), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError(borsh.Index("Checksums", i), err)
+		}
+		 appendBytes(buf, data)
+		}
+		}
+		{
+			if s.Path == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPPath
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Path (path) - Pointer
+					// ElementType: ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+					// Type: EventPath
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: EventPath
+	
+			// s.Path - custom type
+			
+				// Element: No Element
+					data, err := borsh.MarshalValue(*s.Path)
+					if err != nil {
+						return borsh.EncodeFieldError("Path", err)
+					}
+					if err := appendNested(buf, data); err != nil {
+						return borsh.EncodeFieldError("Path", err)
+					}
+		}
+					SKIPPath:
+		{
+		
+				// Paths (paths) - slice
+				// ElementType: []EventPath
+				// Type: []EventPath
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Paths: []Paths
+	if err := checkLength("slice", len((s.Paths)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Paths", err)
+	}
+	 appendLength(buf, len((s.Paths)))
+		for i, item := range (s.Paths) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+	
+			// item - custom type
+			
+				// Element: No Element
+					data, err := borsh.MarshalValue(item)
+					if err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Paths", i), err)
+					}
+					if err := appendNested(buf, data); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Paths", i), err)
+					}
+		}
+		}
+		{
+		
+				// EID (eid) - slice
+				// ElementType: []int
+				// Type: []int
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of EID: []EID
+	if err := checkLength("slice", len((s.EID)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("EID", err)
+	}
+	 appendLength(buf, len((s.EID)))
+		for i, item := range (s.EID) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int
+	
+					// BASICTYPE: true
+					// ELNTYPE: int
+					buf.WriteUint64(uint64(item))
+		}
+		}
+		{
+		
+				// Versions (versions) - slice
+				// ElementType: []int32
+				// Type: []int32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Versions: []Versions
+	if err := checkLength("slice", len((s.Versions)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Versions", err)
+	}
+	 appendLength(buf, len((s.Versions)))
+		for i, item := range (s.Versions) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int32
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(item))
+		}
+		}
+		{
+		
+				// Sizes (sizes) - slice
+				// ElementType: []uint64
+				// Type: []uint64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sizes: []Sizes
+	if err := checkLength("slice", len((s.Sizes)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Sizes", err)
+	}
+	 appendLength(buf, len((s.Sizes)))
+		for i, item := range (s.Sizes) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint64
+					buf.WriteUint64(uint64(item))
+		}
+		}
+		{
+			if s.OptionalCounter == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPOptionalCounter
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// OptionalCounter (opt_counter) - Pointer
+					// ElementType: int32
+					// Type: int32
+					// ActualType: *int32
+					// BasicType: true
+			
+					// ElementType: int32
+	
+					// BASICTYPE: true
+					// ELNTYPE: int32
+					buf.WriteUint32(uint32(*s.OptionalCounter))
+		}
+					SKIPOptionalCounter:
+		{
+			if s.OptionalFlag == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPOptionalFlag
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// OptionalFlag (opt_flag) - Pointer
+					// ElementType: bool
+					// Type: bool
+					// ActualType: *bool
+					// BasicType: true
+			
+					// ElementType: bool
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if *s.OptionalFlag {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+		}
+					SKIPOptionalFlag:
+		{
+			if s.OptionalScore == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPOptionalScore
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// OptionalScore (opt_score) - Pointer
+					// ElementType: float64
+					// Type: float64
+					// ActualType: *float64
+					// BasicType: true
+			
+					// ElementType: float64
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(*s.OptionalScore)
+		}
+					SKIPOptionalScore:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: true
+	
+			// s.JsonData - custom type
+			
+				// Element: []byte
+		data, err := _DefaultJsonRawMessageEncoder.MarshalBorsh((s.JsonData), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("JsonData", err)
+		}
+		appendBytes(buf, data)
+		}
+		{
+			if s.JsonPtrData == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPJsonPtrData
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// JsonPtrData (jsp) - Pointer
+					// ElementType: []byte
+					// Type: json.RawMessage
+					// ActualType: []byte
+					// BasicType: false
+			
+					// ElementType: json.RawMessage
+		data, err := _DefaultJsonRawMessageEncoder.MarshalBorsh((*s.JsonPtrData), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("JsonPtrData", err)
+		}
+		appendBytes(buf, data)
+		}
+					SKIPJsonPtrData:
+		{
+		
+				// JsonSliceData (jss) - slice
+				// ElementType: []json.RawMessage
+				// Type: []json.RawMessage
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of JsonSliceData: []JsonSliceData
+	if err := checkLength("slice", len((s.JsonSliceData)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("JsonSliceData", err)
+	}
+	 appendLength(buf, len((s.JsonSliceData)))
+		for i, item := range (s.JsonSliceData) {
+			_ = i
+		data, err := _DefaultJsonRawMessageEncoder.MarshalBorsh((item), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError(borsh.Index("JsonSliceData", i), err)
+		}
+		 appendBytes(buf, data)
+		}
+		}
+		{
+			if s.JsonPointerSliceData == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPJsonPointerSliceData
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+				// JsonPointerSliceData (jps) - slice
+				// ElementType: []json.RawMessage
+				// Type: []json.RawMessage
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of JsonPointerSliceData: []JsonPointerSliceData
+	if err := checkLength("slice", len(*(s.JsonPointerSliceData)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("JsonPointerSliceData", err)
+	}
+	 appendLength(buf, len(*(s.JsonPointerSliceData)))
+		for i, item := range *(s.JsonPointerSliceData) {
+			_ = i
+		data, err := _DefaultJsonRawMessageEncoder.MarshalBorsh((item), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError(borsh.Index("JsonPointerSliceData", i), err)
+		}
+		 appendBytes(buf, data)
+		}
+		}
+					SKIPJsonPointerSliceData:
+	return nil
+}
+func (s *Event) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Event", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Event like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Event, without trailing bytes
+func (s *Event) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Event", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Event in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Event, so wrap unbuffered readers in a bufio.Reader
+func (s *Event) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Event", err)
+}
+
+// ReadBorsh decodes Event nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Event
+func (s *Event) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Event is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Event
+    var err error
+		{
+				itemData, err := readByteSlice(r, MaxSliceLen)
+				if err != nil {
+					return r.FieldError("Any", err)
+				}
+				if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+					return r.FieldError("Any", err)
+				} else {
+				 		_m := (_v).(interface{})
+					s.Any = _m
+				}
+		}
+		{
+		
+				// ArrayAny (aa) - slice
+				// ElementType: []any
+				// Type: []any
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of ArrayAny: []ArrayAny
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("ArrayAny", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]any](r, length)
+		if err != nil {
+			return r.FieldError("ArrayAny", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+			// Element interface{}
+	err = readAny(r, &p[i], depth+1)
+	if err != nil {
+		return r.FieldError(borsh.Index("ArrayAny", i), err)
+	}
+		}
+				s.ArrayAny =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("ID", err)
+	}
+	__m := ID(__v)
+	s.ID = (__m)
+		}
+		{
+		
+				// PointerArray (par) - slice
+				// ElementType: []*EventPath
+				// Type: []*EventPath
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of PointerArray: []PointerArray
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("PointerArray", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]*EventPath](r, length)
+		if err != nil {
+			return r.FieldError("PointerArray", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+			// Element EventPath
+	
+			// p[i] - custom type
+			
+				// Element: No Element
+				// IsPointer: true
+					 	_m := EventPath{}
+						err = readNested(r, &_m, depth+1)
+							p[i] = &_m
+						if err != nil {
+							return r.FieldError(borsh.Index("PointerArray", i), err)
+						}
+		}
+				s.PointerArray =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError("EventType", err)
+	}
+	__m := constants.EventType(__v)
+	s.EventType = (__m)
+		}
+		{
+				itemData, err := readByteSlice(r, MaxSliceLen)
+				if err != nil {
+					return r.FieldError("FixedSliceCustom", err)
+				}
+				if _v, err := _FixedSliceEncoder.UnmarshalBorsh(itemData); err != nil {
+					return r.FieldError("FixedSliceCustom", err)
+				} else {
+				 		_m := (_v).([][32]byte)
+					s.FixedSliceCustom = _m
+				}
+		}
+		{
+		
+				// FixedSlice (fs) - slice
+				// ElementType: [][32]byte
+				// Type: [][32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of FixedSlice: []FixedSlice
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("FixedSlice", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[][32]byte](r, length)
+		if err != nil {
+			return r.FieldError("FixedSlice", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+			length := 32
+			p[i] = [32]byte{}
+				for i0 := 0; i0 < int(length); i0++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index(borsh.Index("FixedSlice", i), i0), err)
+	}
+	__m := byte(__v)
+	p[i][i0] = (__m)
+				}
+		}
+				s.FixedSlice =  p
+		}
+		{
+		
+				// Chain (typep) - slice
+				// ElementType: [][][]configs.ChainId
+				// Type: [][][]configs.ChainId
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chain: []Chain
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Chain", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[][][]configs.ChainId](r, length)
+		if err != nil {
+			return r.FieldError("Chain", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+		// ElementIsSlice: true
+			length, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError(borsh.Index("Chain", i), err)
+			}
+			p[i], err = borsh.MakeSlice[[][]configs.ChainId](r, length)
+			if err != nil {
+				return r.FieldError(borsh.Index("Chain", i), err)
+			}
+				for i0 := 0; i0 < int(length); i0++ {
+					p[i] = slices.Grow(p[i], 1)[:i0+1]
+
+		// ElementIsSlice: true
+			length, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError(borsh.Index(borsh.Index("Chain", i), i0), err)
+			}
+			p[i][i0], err = borsh.MakeSlice[[]configs.ChainId](r, length)
+			if err != nil {
+				return r.FieldError(borsh.Index(borsh.Index("Chain", i), i0), err)
+			}
+				for i1 := 0; i1 < int(length); i1++ {
+					p[i][i0] = slices.Grow(p[i][i0], 1)[:i1+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element configs.ChainId
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index(borsh.Index(borsh.Index("Chain", i), i0), i1), err)
+	}
+	__m := configs.ChainId(__s)
+	p[i][i0][i1] = (__m)
+				}
+				}
+		}
+				s.Chain =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Parent", err)
+				}
+				if !present {
+					s.Parent = nil
+					goto SKIPParent
+				} 
+			}
+		{
+		
+				// Parent (parent) - slice
+				// ElementType: []ID
+				// Type: []ID
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Parent: []Parent
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Parent", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]ID](r, length)
+		if err != nil {
+			return r.FieldError("Parent", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+			// Element ID
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError(borsh.Index("Parent", i), err)
+	}
+	__m := ID(__v)
+	p[i] = (__m)
+		}
+				s.Parent =  &p
+		}
+					SKIPParent:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Timestamp", err)
+	}
+	__m := uint64(__v)
+	s.Timestamp = (__m)
+		}
+		{
+		
+				// Data (data) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+			itemData, err := readByteSlice(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Data", err)
+			}
+			if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+				return r.FieldError("Data", err)
+			} else {
+				_m := (_v).([]byte)
+				s.Data = _m
+			}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("Counter", err)
+	}
+	__m := int32(__v)
+	s.Counter = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadBool()
+	if err != nil {
+		return r.FieldError("Flag", err)
+	}
+	__m := bool(__v)
+	s.Flag = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadFloat64()
+	if err != nil {
+		return r.FieldError("Score", err)
+	}
+	__m := float64(__v)
+	s.Score = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadFloat32()
+	if err != nil {
+		return r.FieldError("Rating", err)
+	}
+	__m := float32(__v)
+	s.Rating = (__m)
+		}
+		{
+		
+				// Systems (sys) - slice
+				// ElementType: []System
+				// Type: []System
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Systems: []Systems
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Systems", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]System](r, length)
+		if err != nil {
+			return r.FieldError("Systems", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element System
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Systems", i), err)
+	}
+	__m := System(__s)
+	p[i] = (__m)
+		}
+				s.Systems =  p
+		}
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Tags", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]string](r, length)
+		if err != nil {
+			return r.FieldError("Tags", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Tags", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+		}
+				s.Tags =  p
+		}
+		{
+		
+				// Checksums (checksums) - slice
+				// ElementType: [][]byte
+				// Type: [][]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Checksums: []Checksums
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Checksums", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[][]byte](r, length)
+		if err != nil {
+			return r.FieldError("Checksums", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+		itemData, err := readByteSlice(r, MaxSliceLen)
+		if err != nil {
+			return r.FieldError(borsh.Index("Checksums", i), err)
+		}
+		if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+			return r.FieldError(borsh.Index("Checksums", i), err)
+		} else {
+			_m := (_v).([]byte)
+			p[i] = _m
+		}
+		}
+				s.Checksums =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Path", err)
+				}
+				if !present {
+					s.Path = nil
+					goto SKIPPath
+				} 
+			}
+		{
+		
+					// Path (path) - Pointer
+					// ElementType: ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+					// Type: EventPath
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: false
+					// ElementType: EventPath
+					// IsPointer: true
+	
+			// s.Path - custom type
+			
+				// Element: No Element
+				// IsPointer: true
+					 	_m := EventPath{}
+						err = readNested(r, &_m, depth+1)
+							s.Path = &_m
+						if err != nil {
+							return r.FieldError("Path", err)
+						}
+		}
+					SKIPPath:
+		{
+		
+				// Paths (paths) - slice
+				// ElementType: []EventPath
+				// Type: []EventPath
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Paths: []Paths
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Paths", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]EventPath](r, length)
+		if err != nil {
+			return r.FieldError("Paths", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+			// Element EventPath
+	
+			// p[i] - custom type
+			
+				// Element: No Element
+				// IsPointer: false
+					 	_m := EventPath{}
+						err = readNested(r, &_m, depth+1)
+							p[i] = _m
+						if err != nil {
+							return r.FieldError(borsh.Index("Paths", i), err)
+						}
+		}
+				s.Paths =  p
+		}
+		{
+		
+				// EID (eid) - slice
+				// ElementType: []int
+				// Type: []int
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of EID: []EID
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("EID", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]int](r, length)
+		if err != nil {
+			return r.FieldError("EID", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int
+			// Element int
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError(borsh.Index("EID", i), err)
+	}
+	__m := int(__v)
+	p[i] = (__m)
+		}
+				s.EID =  p
+		}
+		{
+		
+				// Versions (versions) - slice
+				// ElementType: []int32
+				// Type: []int32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Versions: []Versions
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Versions", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]int32](r, length)
+		if err != nil {
+			return r.FieldError("Versions", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int32
+			// Element int32
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError(borsh.Index("Versions", i), err)
+	}
+	__m := int32(__v)
+	p[i] = (__m)
+		}
+				s.Versions =  p
+		}
+		{
+		
+				// Sizes (sizes) - slice
+				// ElementType: []uint64
+				// Type: []uint64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sizes: []Sizes
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Sizes", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]uint64](r, length)
+		if err != nil {
+			return r.FieldError("Sizes", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+			// Element uint64
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError(borsh.Index("Sizes", i), err)
+	}
+	__m := uint64(__v)
+	p[i] = (__m)
+		}
+				s.Sizes =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("OptionalCounter", err)
+				}
+				if !present {
+					s.OptionalCounter = nil
+					goto SKIPOptionalCounter
+				} 
+			}
+		{
+		
+					// OptionalCounter (opt_counter) - Pointer
+					// ElementType: int32
+					// Type: int32
+					// ActualType: *int32
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: int32
+					// IsPointer: true
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("OptionalCounter", err)
+	}
+	__m := int32(__v)
+	s.OptionalCounter = &(__m)
+		}
+					SKIPOptionalCounter:
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("OptionalFlag", err)
+				}
+				if !present {
+					s.OptionalFlag = nil
+					goto SKIPOptionalFlag
+				} 
+			}
+		{
+		
+					// OptionalFlag (opt_flag) - Pointer
+					// ElementType: bool
+					// Type: bool
+					// ActualType: *bool
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: bool
+					// IsPointer: true
+	__v, err := r.ReadBool()
+	if err != nil {
+		return r.FieldError("OptionalFlag", err)
+	}
+	__m := bool(__v)
+	s.OptionalFlag = &(__m)
+		}
+					SKIPOptionalFlag:
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("OptionalScore", err)
+				}
+				if !present {
+					s.OptionalScore = nil
+					goto SKIPOptionalScore
+				} 
+			}
+		{
+		
+					// OptionalScore (opt_score) - Pointer
+					// ElementType: float64
+					// Type: float64
+					// ActualType: *float64
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: float64
+					// IsPointer: true
+	__v, err := r.ReadFloat64()
+	if err != nil {
+		return r.FieldError("OptionalScore", err)
+	}
+	__m := float64(__v)
+	s.OptionalScore = &(__m)
+		}
+					SKIPOptionalScore:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: true
+		itemData, err := readByteSlice(r, MaxSliceLen)
+		if err != nil {
+			return r.FieldError("JsonData", err)
+		}
+		if _v, err := _DefaultJsonRawMessageEncoder.UnmarshalBorsh(itemData); err != nil {
+			return r.FieldError("JsonData", err)
+		} else {
+			_m := (_v).(json.RawMessage)
+			s.JsonData = _m
+		}
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("JsonPtrData", err)
+				}
+				if !present {
+					s.JsonPtrData = nil
+					goto SKIPJsonPtrData
+				} 
+			}
+		{
+		
+					// JsonPtrData (jsp) - Pointer
+					// ElementType: []byte
+					// Type: json.RawMessage
+					// ActualType: []byte
+					// BasicType: false
+					// IsStruct: false
+					// ElementType: json.RawMessage
+					// IsPointer: true
+		itemData, err := readByteSlice(r, MaxSliceLen)
+		if err != nil {
+			return r.FieldError("JsonPtrData", err)
+		}
+		if _v, err := _DefaultJsonRawMessageEncoder.UnmarshalBorsh(itemData); err != nil {
+			return r.FieldError("JsonPtrData", err)
+		} else {
+			_m := (_v).(json.RawMessage)
+			s.JsonPtrData = &_m
+		}
+		}
+					SKIPJsonPtrData:
+		{
+		
+				// JsonSliceData (jss) - slice
+				// ElementType: []json.RawMessage
+				// Type: []json.RawMessage
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of JsonSliceData: []JsonSliceData
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("JsonSliceData", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]json.RawMessage](r, length)
+		if err != nil {
+			return r.FieldError("JsonSliceData", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+		itemData, err := readByteSlice(r, MaxSliceLen)
+		if err != nil {
+			return r.FieldError(borsh.Index("JsonSliceData", i), err)
+		}
+		if _v, err := _DefaultJsonRawMessageEncoder.UnmarshalBorsh(itemData); err != nil {
+			return r.FieldError(borsh.Index("JsonSliceData", i), err)
+		} else {
+			_m := (_v).(json.RawMessage)
+			p[i] = _m
+		}
+		}
+				s.JsonSliceData =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("JsonPointerSliceData", err)
+				}
+				if !present {
+					s.JsonPointerSliceData = nil
+					goto SKIPJsonPointerSliceData
+				} 
+			}
+		{
+		
+				// JsonPointerSliceData (jps) - slice
+				// ElementType: []json.RawMessage
+				// Type: []json.RawMessage
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of JsonPointerSliceData: []JsonPointerSliceData
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("JsonPointerSliceData", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]json.RawMessage](r, length)
+		if err != nil {
+			return r.FieldError("JsonPointerSliceData", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+		itemData, err := readByteSlice(r, MaxSliceLen)
+		if err != nil {
+			return r.FieldError(borsh.Index("JsonPointerSliceData", i), err)
+		}
+		if _v, err := _DefaultJsonRawMessageEncoder.UnmarshalBorsh(itemData); err != nil {
+			return r.FieldError(borsh.Index("JsonPointerSliceData", i), err)
+		} else {
+			_m := (_v).(json.RawMessage)
+			p[i] = _m
+		}
+		}
+				s.JsonPointerSliceData =  &p
+		}
+					SKIPJsonPointerSliceData:
+	return err
+}
+func (s Event) BinarySize() (int, error) {
+	size := 0
+		{
+			_size, err := _DefaultByteArrayEncoder.BinarySize(s.Any, s)
+				if err != nil {
+						return 0, borsh.EncodeFieldError("Any", err)
+				}
+				size += LengthPrefixSize + _size
+		}
+		{
+		
+				// ArrayAny (aa) - slice
+				// ElementType: []any
+				// Type: []any
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of ArrayAny: []ArrayAny
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.ArrayAny) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType any
+			// Element interface{}
+				{
+					_s, err := sizeAny(item)
+					if err != nil {
+						return 0, borsh.EncodeFieldError("ArrayAny", err)
+					}
+					size += _s
+				}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+				// PointerArray (par) - slice
+				// ElementType: []*EventPath
+				// Type: []*EventPath
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of PointerArray: []PointerArray
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.PointerArray) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+			// Element EventPath
+	
+		// ElementType: ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+	
+			// item - custom type
+			// VarVar item
+			
+				// Element: No Element
+					_s, err := borsh.SizeOf(item)
+					if err != nil {
+						panic(err)
+					}
+						size += NestedPrefixSize + _s
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 2
+		}
+		{
+			_size, err := _FixedSliceEncoder.BinarySize(s.FixedSliceCustom, s)
+				if err != nil {
+						return 0, borsh.EncodeFieldError("FixedSliceCustom", err)
+				}
+				size += LengthPrefixSize + _size
+		}
+		{
+		
+				// FixedSlice (fs) - slice
+				// ElementType: [][32]byte
+				// Type: [][32]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of FixedSlice: []FixedSlice
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.FixedSlice) {
+			_ = item
+			for i0 := 0; i0  < 32; i0 ++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType byte
+			// Element byte
+				size += 1
+			}
+		}
+		}
+		{
+		
+				// Chain (typep) - slice
+				// ElementType: [][][]configs.ChainId
+				// Type: [][][]configs.ChainId
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Chain: []Chain
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Chain) {
+			_ = item
+		size += LengthPrefixSize // for length prefix
+		// ElementIsSlice: true
+		for _, item := range (item) {
+			_ = item
+		size += LengthPrefixSize // for length prefix
+		// ElementIsSlice: true
+		for _, item := range (item) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element configs.ChainId
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+		}
+		}
+		{
+			size++
+			if s.Parent == nil {
+				goto SKIPParent
+			}
+		}
+		{
+		
+				// Parent (parent) - slice
+				// ElementType: []ID
+				// Type: []ID
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Parent: []Parent
+	size += LengthPrefixSize // for slice length
+		for _, item := range *(s.Parent) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+			// Element ID
+				size += 8
+		}
+		}
+			SKIPParent:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+				// Data (data) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+
+// Var s.Data
+		_s, err := _DefaultByteArrayEncoder.BinarySize(s.Data, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder s.Data: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+				// Systems (sys) - slice
+				// ElementType: []System
+				// Type: []System
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Systems: []Systems
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Systems) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element System
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+		{
+		
+				// Tags (tags) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Tags: []Tags
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Tags) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+		{
+		
+				// Checksums (checksums) - slice
+				// ElementType: [][]byte
+				// Type: [][]byte
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Checksums: []Checksums
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Checksums) {
+			_ = item
+
+// Var item
+		_s, err := _DefaultByteArrayEncoder.BinarySize(item, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder item: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		}
+		{
+			size++
+			if s.Path == nil {
+				goto SKIPPath
+			}
+		}
+		{
+		
+					// Path (path) - Pointer
+					// ElementType: ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+					// Type: EventPath
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: EventPath
+	
+		// ElementType: ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+		
+			// Element: false
+	
+		// ElementType: ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+	
+			// s.Path - custom type
+			// VarVar s.Path
+			
+				// Element: No Element
+					_s, err := borsh.SizeOf(s.Path)
+					if err != nil {
+						panic(err)
+					}
+						size += NestedPrefixSize + _s
+		}
+			SKIPPath:
+		{
+		
+				// Paths (paths) - slice
+				// ElementType: []EventPath
+				// Type: []EventPath
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Paths: []Paths
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Paths) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType ID "msg:\"id,int64\" enc:\"\""; Timestamp uint64 "msg:\"ts\" enc:\"\""}
+			// Element EventPath
+	
+			// item - custom type
+			// VarVar item
+			
+				// Element: No Element
+					_s, err := borsh.SizeOf(item)
+					if err != nil {
+						panic(err)
+					}
+						size += NestedPrefixSize + _s
+		}
+		}
+		{
+		
+				// EID (eid) - slice
+				// ElementType: []int
+				// Type: []int
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of EID: []EID
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.EID) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int
+			// Element int
+				size += 8
+		}
+		}
+		{
+		
+				// Versions (versions) - slice
+				// ElementType: []int32
+				// Type: []int32
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Versions: []Versions
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Versions) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int32
+			// Element int32
+				size += 4
+		}
+		}
+		{
+		
+				// Sizes (sizes) - slice
+				// ElementType: []uint64
+				// Type: []uint64
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Sizes: []Sizes
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Sizes) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint64
+			// Element uint64
+				size += 8
+		}
+		}
+		{
+			size++
+			if s.OptionalCounter == nil {
+				goto SKIPOptionalCounter
+			}
+		}
+		{
+		
+					// OptionalCounter (opt_counter) - Pointer
+					// ElementType: int32
+					// Type: int32
+					// ActualType: *int32
+					// BasicType: true
+			
+					// ElementType: int32
+	
+		// ElementType: int32
+		
+			// Element: true
+				size += 4
+		}
+			SKIPOptionalCounter:
+		{
+			size++
+			if s.OptionalFlag == nil {
+				goto SKIPOptionalFlag
+			}
+		}
+		{
+		
+					// OptionalFlag (opt_flag) - Pointer
+					// ElementType: bool
+					// Type: bool
+					// ActualType: *bool
+					// BasicType: true
+			
+					// ElementType: bool
+	
+		// ElementType: bool
+		
+			// Element: true
+				size += 1
+		}
+			SKIPOptionalFlag:
+		{
+			size++
+			if s.OptionalScore == nil {
+				goto SKIPOptionalScore
+			}
+		}
+		{
+		
+					// OptionalScore (opt_score) - Pointer
+					// ElementType: float64
+					// Type: float64
+					// ActualType: *float64
+					// BasicType: true
+			
+					// ElementType: float64
+	
+		// ElementType: float64
+		
+			// Element: true
+				size += 8
+		}
+			SKIPOptionalScore:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: true
+	
+	// VarVar s.JsonData
+
+// Var s.JsonData
+		_s, err := _DefaultJsonRawMessageEncoder.BinarySize(s.JsonData, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder s.JsonData: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		{
+			size++
+			if s.JsonPtrData == nil {
+				goto SKIPJsonPtrData
+			}
+		}
+		{
+		
+					// JsonPtrData (jsp) - Pointer
+					// ElementType: []byte
+					// Type: json.RawMessage
+					// ActualType: []byte
+					// BasicType: false
+			
+					// ElementType: json.RawMessage
+	
+		// ElementType: []byte
+		
+			// Element: false
+	
+	// VarVar s.JsonPtrData
+
+// Var s.JsonPtrData
+		_s, err := _DefaultJsonRawMessageEncoder.BinarySize(*s.JsonPtrData, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder s.JsonPtrData: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+			SKIPJsonPtrData:
+		{
+		
+				// JsonSliceData (jss) - slice
+				// ElementType: []json.RawMessage
+				// Type: []json.RawMessage
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of JsonSliceData: []JsonSliceData
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.JsonSliceData) {
+			_ = item
+
+// Var item
+		_s, err := _DefaultJsonRawMessageEncoder.BinarySize(item, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder item: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		}
+		{
+			size++
+			if s.JsonPointerSliceData == nil {
+				goto SKIPJsonPointerSliceData
+			}
+		}
+		{
+		
+				// JsonPointerSliceData (jps) - slice
+				// ElementType: []json.RawMessage
+				// Type: []json.RawMessage
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of JsonPointerSliceData: []JsonPointerSliceData
+	size += LengthPrefixSize // for slice length
+		for _, item := range *(s.JsonPointerSliceData) {
+			_ = item
+
+// Var item
+		_s, err := _DefaultJsonRawMessageEncoder.BinarySize(item, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder item: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		}
+			SKIPJsonPointerSliceData:
+	return size, nil
+}
+
+// CompareBorsh orders Event by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Event) CompareBorsh(other any) int {
+	o := other.(Event)
+	_ = o
+	if c := borsh.Compare(s.Any, o.Any); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.ArrayAny, o.ArrayAny); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.ID, o.ID); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.PointerArray, o.PointerArray); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.EventType, o.EventType); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.FixedSliceCustom, o.FixedSliceCustom); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.FixedSlice, o.FixedSlice); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Chain, o.Chain); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Parent, o.Parent); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Timestamp, o.Timestamp); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Data, o.Data); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Counter, o.Counter); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Flag, o.Flag); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Score, o.Score); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Rating, o.Rating); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Systems, o.Systems); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Tags, o.Tags); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Checksums, o.Checksums); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Path, o.Path); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Paths, o.Paths); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.EID, o.EID); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Versions, o.Versions); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Sizes, o.Sizes); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.OptionalCounter, o.OptionalCounter); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.OptionalFlag, o.OptionalFlag); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.OptionalScore, o.OptionalScore); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.JsonData, o.JsonData); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.JsonPtrData, o.JsonPtrData); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.JsonSliceData, o.JsonSliceData); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.JsonPointerSliceData, o.JsonPointerSliceData); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Event in fields typed by an interface
+func (Event) BorshTypeID() uint64 {
+	return 0x8a1857f9b743613
+}
+func init() {
+	Registry.Register(0x8a1857f9b743613, func() borsh.BorshEncoder { return new(Event) })
+}
@@ -0,0 +1,31 @@
+package times
+
+import (
+	"time"
+
+	"github.com/mlayerprotocol/go-borshgen/tests/times/uuid"
+)
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Event struct {
+	ID       uuid.UUID       `msg:"id"`
+	Parent   *uuid.UUID      `msg:"parent"`
+	At       time.Time       `msg:"at"`
+	Seconds  time.Time       `msg:"seconds,unix"`
+	Millis   time.Time       `msg:"millis,unixmilli"`
+	Nanos    time.Time       `msg:"nanos,unixnano"`
+	Expires  *time.Time      `msg:"expires"`
+	History  []time.Time     `msg:"history"`
+	Timeout  time.Duration   `msg:"timeout"`
+	Backoff  []time.Duration `msg:"backoff"`
+	Deadline *time.Duration  `msg:"deadline"`
+}
+
+// UUID is a UUID of a package not named uuid
+type UUID [16]byte
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Session struct {
+	ID     UUID       `msg:"id"`
+	Parent *uuid.UUID `msg:"parent"`
+}
@@ -0,0 +1,1023 @@
+package times
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/times/uuid"
+	"time"
+)
+var _ uuid.UUID
+var _ time.Time
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Event) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Event) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Event", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Event to buf.
+// The FieldPath of a returned EncodeError is relative to Event
+func (s Event) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Event) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Event in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Event) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Event", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Event in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Event) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Event", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Event to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Event) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Event", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Event to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Event
+func (s Event) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uuid
+					_v128 := s.ID
+					buf.Write(_v128[:])
+		}
+		{
+			if s.Parent == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPParent
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Parent (parent) - Pointer
+					// ElementType: uuid
+					// Type: uuid.UUID
+					// ActualType: [16]byte
+					// BasicType: true
+			
+					// ElementType: uuid.UUID
+	
+					// BASICTYPE: true
+					// ELNTYPE: uuid
+					_v128 := *s.Parent
+					buf.Write(_v128[:])
+		}
+					SKIPParent:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: time
+					if err := _CustomTimeTimeEncoder.Write(buf, s.At); err != nil {
+						return borsh.EncodeFieldError("At", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: time_unix
+					if err := _CustomTimeUnixEncoder.Write(buf, s.Seconds); err != nil {
+						return borsh.EncodeFieldError("Seconds", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: time_unixmilli
+					if err := _CustomTimeUnixMilliEncoder.Write(buf, s.Millis); err != nil {
+						return borsh.EncodeFieldError("Millis", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: time_unixnano
+					if err := _CustomTimeUnixNanoEncoder.Write(buf, s.Nanos); err != nil {
+						return borsh.EncodeFieldError("Nanos", err)
+					}
+		}
+		{
+			if s.Expires == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPExpires
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Expires (expires) - Pointer
+					// ElementType: time
+					// Type: time.Time
+					// ActualType: struct
+					// BasicType: true
+			
+					// ElementType: time.Time
+	
+					// BASICTYPE: true
+					// ELNTYPE: time
+					if err := _CustomTimeTimeEncoder.Write(buf, *s.Expires); err != nil {
+						return borsh.EncodeFieldError("Expires", err)
+					}
+		}
+					SKIPExpires:
+		{
+		
+				// History (history) - slice
+				// ElementType: []time.Time
+				// Type: []time.Time
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	if err := checkLength("slice", len((s.History)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("History", err)
+	}
+	 appendLength(buf, len((s.History)))
+		for i, item := range (s.History) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType time
+	
+					// BASICTYPE: true
+					// ELNTYPE: time
+					if err := _CustomTimeTimeEncoder.Write(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("History", i), err)
+					}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+			// s.Timeout - custom type
+			
+				// Element: int64
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(s.Timeout))
+		}
+		{
+		
+				// Backoff (backoff) - slice
+				// ElementType: []time.Duration
+				// Type: []time.Duration
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Backoff: []Backoff
+	if err := checkLength("slice", len((s.Backoff)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Backoff", err)
+	}
+	 appendLength(buf, len((s.Backoff)))
+		for i, item := range (s.Backoff) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(item))
+		}
+		}
+		{
+			if s.Deadline == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPDeadline
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Deadline (deadline) - Pointer
+					// ElementType: int64
+					// Type: time.Duration
+					// ActualType: *time.Duration
+					// BasicType: true
+			
+					// ElementType: time.Duration
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(*s.Deadline))
+		}
+					SKIPDeadline:
+	return nil
+}
+func (s *Event) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Event", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Event like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Event, without trailing bytes
+func (s *Event) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Event", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Event in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Event, so wrap unbuffered readers in a bufio.Reader
+func (s *Event) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Event", err)
+}
+
+// ReadBorsh decodes Event nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Event
+func (s *Event) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Event is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Event
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError("ID", err)
+	}
+	__m := uuid.UUID{}
+	copy(__m[:], __b)
+	s.ID = (__m)
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Parent", err)
+				}
+				if !present {
+					s.Parent = nil
+					goto SKIPParent
+				} 
+			}
+		{
+		
+					// Parent (parent) - Pointer
+					// ElementType: uuid
+					// Type: uuid.UUID
+					// ActualType: [16]byte
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: uuid.UUID
+					// IsPointer: true
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError("Parent", err)
+	}
+	__m := uuid.UUID{}
+	copy(__m[:], __b)
+	s.Parent = &(__m)
+		}
+					SKIPParent:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := _CustomTimeTimeEncoder.Read(r)
+	if err != nil {
+		return r.FieldError("At", err)
+	}
+	s.At = __v
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := _CustomTimeUnixEncoder.Read(r)
+	if err != nil {
+		return r.FieldError("Seconds", err)
+	}
+	s.Seconds = __v
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := _CustomTimeUnixMilliEncoder.Read(r)
+	if err != nil {
+		return r.FieldError("Millis", err)
+	}
+	s.Millis = __v
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := _CustomTimeUnixNanoEncoder.Read(r)
+	if err != nil {
+		return r.FieldError("Nanos", err)
+	}
+	s.Nanos = __v
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Expires", err)
+				}
+				if !present {
+					s.Expires = nil
+					goto SKIPExpires
+				} 
+			}
+		{
+		
+					// Expires (expires) - Pointer
+					// ElementType: time
+					// Type: time.Time
+					// ActualType: struct
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: time.Time
+					// IsPointer: true
+	__v, err := _CustomTimeTimeEncoder.Read(r)
+	if err != nil {
+		return r.FieldError("Expires", err)
+	}
+	s.Expires = &__v
+		}
+					SKIPExpires:
+		{
+		
+				// History (history) - slice
+				// ElementType: []time.Time
+				// Type: []time.Time
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("History", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]time.Time](r, length)
+		if err != nil {
+			return r.FieldError("History", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType time
+			// Element time.Time
+	__v, err := _CustomTimeTimeEncoder.Read(r)
+	if err != nil {
+		return r.FieldError(borsh.Index("History", i), err)
+	}
+	p[i] = __v
+		}
+				s.History =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Timeout", err)
+	}
+	__m := time.Duration(__v)
+	s.Timeout = (__m)
+		}
+		{
+		
+				// Backoff (backoff) - slice
+				// ElementType: []time.Duration
+				// Type: []time.Duration
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Backoff: []Backoff
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Backoff", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]time.Duration](r, length)
+		if err != nil {
+			return r.FieldError("Backoff", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+			// Element time.Duration
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError(borsh.Index("Backoff", i), err)
+	}
+	__m := time.Duration(__v)
+	p[i] = (__m)
+		}
+				s.Backoff =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Deadline", err)
+				}
+				if !present {
+					s.Deadline = nil
+					goto SKIPDeadline
+				} 
+			}
+		{
+		
+					// Deadline (deadline) - Pointer
+					// ElementType: int64
+					// Type: time.Duration
+					// ActualType: *time.Duration
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: time.Duration
+					// IsPointer: true
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Deadline", err)
+	}
+	__m := time.Duration(__v)
+	s.Deadline = &(__m)
+		}
+					SKIPDeadline:
+	return err
+}
+func (s Event) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+			size++
+			if s.Parent == nil {
+				goto SKIPParent
+			}
+		}
+		{
+		
+					// Parent (parent) - Pointer
+					// ElementType: uuid
+					// Type: uuid.UUID
+					// ActualType: [16]byte
+					// BasicType: true
+			
+					// ElementType: uuid.UUID
+				size += 16
+		}
+			SKIPParent:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 12
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+			size++
+			if s.Expires == nil {
+				goto SKIPExpires
+			}
+		}
+		{
+		
+					// Expires (expires) - Pointer
+					// ElementType: time
+					// Type: time.Time
+					// ActualType: struct
+					// BasicType: true
+			
+					// ElementType: time.Time
+				size += 12
+		}
+			SKIPExpires:
+		{
+		
+				// History (history) - slice
+				// ElementType: []time.Time
+				// Type: []time.Time
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of History: []History
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.History) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType time
+			// Element time.Time
+				size += 12
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+				// Backoff (backoff) - slice
+				// ElementType: []time.Duration
+				// Type: []time.Duration
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Backoff: []Backoff
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Backoff) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType int64
+			// Element time.Duration
+				size += 8
+		}
+		}
+		{
+			size++
+			if s.Deadline == nil {
+				goto SKIPDeadline
+			}
+		}
+		{
+		
+					// Deadline (deadline) - Pointer
+					// ElementType: int64
+					// Type: time.Duration
+					// ActualType: *time.Duration
+					// BasicType: true
+			
+					// ElementType: time.Duration
+	
+		// ElementType: int64
+		
+			// Element: true
+				size += 8
+		}
+			SKIPDeadline:
+	return size, nil
+}
+
+// CompareBorsh orders Event by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Event) CompareBorsh(other any) int {
+	o := other.(Event)
+	_ = o
+	if c := borsh.Compare(s.ID, o.ID); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Parent, o.Parent); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.At, o.At); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Seconds, o.Seconds); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Millis, o.Millis); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Nanos, o.Nanos); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Expires, o.Expires); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.History, o.History); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Timeout, o.Timeout); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Backoff, o.Backoff); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Deadline, o.Deadline); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Event in fields typed by an interface
+func (Event) BorshTypeID() uint64 {
+	return 0x7c2fd4227e9c8ba9
+}
+func init() {
+	Registry.Register(0x7c2fd4227e9c8ba9, func() borsh.BorshEncoder { return new(Event) })
+}
+
+// BorshSchema describes the Borsh encoding of Event in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Event) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Event",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Event": borsh.StructDef(
+				borsh.Field{Name: "id", Declaration: "Uuid"},
+				borsh.Field{Name: "parent", Declaration: "Option<Uuid>"},
+				borsh.Field{Name: "at", Declaration: "Time"},
+				borsh.Field{Name: "seconds", Declaration: "TimeUnix"},
+				borsh.Field{Name: "millis", Declaration: "TimeUnixMilli"},
+				borsh.Field{Name: "nanos", Declaration: "TimeUnixNano"},
+				borsh.Field{Name: "expires", Declaration: "Option<Time>"},
+				borsh.Field{Name: "history", Declaration: "Vec<Time>"},
+				borsh.Field{Name: "timeout", Declaration: "i64"},
+				borsh.Field{Name: "backoff", Declaration: "Vec<i64>"},
+				borsh.Field{Name: "deadline", Declaration: "Option<i64>"},),
+			"Option<Time>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Time"}),
+			"Option<Uuid>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Uuid"}),
+			"Option<i64>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "i64"}),
+			"Time": borsh.StructDef(
+				borsh.Field{Name: "seconds", Declaration: "i64"},
+				borsh.Field{Name: "nanos", Declaration: "u32"},),
+			"TimeUnix": borsh.StructDef(
+				borsh.Field{Name: "seconds", Declaration: "i64"},),
+			"TimeUnixMilli": borsh.StructDef(
+				borsh.Field{Name: "millis", Declaration: "i64"},),
+			"TimeUnixNano": borsh.StructDef(
+				borsh.Field{Name: "nanos", Declaration: "i64"},),
+			"Uuid": borsh.SequenceDef(0, 16, 16, "u8"),
+			"Vec<Time>": borsh.SequenceDef(4, 0, 4294967295, "Time"),
+			"Vec<i64>": borsh.SequenceDef(4, 0, 4294967295, "i64"),
+			"i64": borsh.PrimitiveDef(8),
+			"u32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Session) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Session) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Session", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Session to buf.
+// The FieldPath of a returned EncodeError is relative to Session
+func (s Session) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Session) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Session in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Session) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Session", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Session in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Session) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Session", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Session to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Session) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Session", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Session to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Session
+func (s Session) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uuid
+					_v128 := s.ID
+					buf.Write(_v128[:])
+		}
+		{
+			if s.Parent == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPParent
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Parent (parent) - Pointer
+					// ElementType: uuid
+					// Type: uuid.UUID
+					// ActualType: [16]byte
+					// BasicType: true
+			
+					// ElementType: uuid.UUID
+	
+					// BASICTYPE: true
+					// ELNTYPE: uuid
+					_v128 := *s.Parent
+					buf.Write(_v128[:])
+		}
+					SKIPParent:
+	return nil
+}
+func (s *Session) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Session", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Session like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Session, without trailing bytes
+func (s *Session) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Session", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Session in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Session, so wrap unbuffered readers in a bufio.Reader
+func (s *Session) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Session", err)
+}
+
+// ReadBorsh decodes Session nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Session
+func (s *Session) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Session is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Session
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError("ID", err)
+	}
+	__m := UUID{}
+	copy(__m[:], __b)
+	s.ID = (__m)
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Parent", err)
+				}
+				if !present {
+					s.Parent = nil
+					goto SKIPParent
+				} 
+			}
+		{
+		
+					// Parent (parent) - Pointer
+					// ElementType: uuid
+					// Type: uuid.UUID
+					// ActualType: [16]byte
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: uuid.UUID
+					// IsPointer: true
+	__b, err := r.Next(16)
+	if err != nil {
+		return r.FieldError("Parent", err)
+	}
+	__m := uuid.UUID{}
+	copy(__m[:], __b)
+	s.Parent = &(__m)
+		}
+					SKIPParent:
+	return err
+}
+func (s Session) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+			size++
+			if s.Parent == nil {
+				goto SKIPParent
+			}
+		}
+		{
+		
+					// Parent (parent) - Pointer
+					// ElementType: uuid
+					// Type: uuid.UUID
+					// ActualType: [16]byte
+					// BasicType: true
+			
+					// ElementType: uuid.UUID
+				size += 16
+		}
+			SKIPParent:
+	return size, nil
+}
+
+// CompareBorsh orders Session by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Session) CompareBorsh(other any) int {
+	o := other.(Session)
+	_ = o
+	if c := borsh.Compare(s.ID, o.ID); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Parent, o.Parent); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Session in fields typed by an interface
+func (Session) BorshTypeID() uint64 {
+	return 0x909324da467e773c
+}
+func init() {
+	Registry.Register(0x909324da467e773c, func() borsh.BorshEncoder { return new(Session) })
+}
+
+// BorshSchema describes the Borsh encoding of Session in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Session) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Session",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Option<Uuid>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Uuid"}),
+			"Session": borsh.StructDef(
+				borsh.Field{Name: "id", Declaration: "Uuid"},
+				borsh.Field{Name: "parent", Declaration: "Option<Uuid>"},),
+			"Uuid": borsh.SequenceDef(0, 16, 16, "u8"),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
@@ -0,0 +1,165 @@
+package times
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mlayerprotocol/go-borshgen/tests/times/uuid"
+)
+
+func roundTrip(t *testing.T, v Event) Event {
+	t.Helper()
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(data) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(data))
+	}
+	var decoded Event
+	if err := decoded.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	return decoded
+}
+
+func TestTimeLayout(t *testing.T) {
+	at := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+	v := Event{
+		ID:      uuid.UUID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		At:      at,
+		Seconds: at,
+		Millis:  at,
+		Nanos:   at,
+		Timeout: 1500 * time.Millisecond,
+	}
+
+	le := binary.LittleEndian
+	var want []byte
+	want = append(want, v.ID[:]...)                 // ID
+	want = append(want, 0)                          // Parent: nil
+	want = le.AppendUint64(want, uint64(at.Unix())) // At: seconds and nanoseconds
+	want = le.AppendUint32(want, 123456789)
+	want = le.AppendUint64(want, uint64(at.Unix()))      // Seconds
+	want = le.AppendUint64(want, uint64(at.UnixMilli())) // Millis
+	want = le.AppendUint64(want, uint64(at.UnixNano()))  // Nanos
+	want = append(want, 0)                               // Expires: nil
+	want = le.AppendUint32(want, 0)                      // History
+	want = le.AppendUint64(want, uint64(1500*time.Millisecond))
+	want = le.AppendUint32(want, 0) // Backoff
+	want = append(want, 0)          // Deadline: nil
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+}
+
+func TestTimeRoundTrip(t *testing.T) {
+	at := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.FixedZone("UTC+2", 2*60*60))
+	expires := time.Date(1900, 1, 1, 0, 0, 0, 1, time.UTC)
+	deadline := -3 * time.Second
+	parent := uuid.UUID{0xff, 0xee}
+	v := Event{
+		ID:       uuid.UUID{1, 2, 3},
+		Parent:   &parent,
+		At:       at,
+		Seconds:  at,
+		Millis:   at,
+		Nanos:    at,
+		Expires:  &expires,
+		History:  []time.Time{at, {}, expires},
+		Timeout:  time.Hour,
+		Backoff:  []time.Duration{time.Millisecond, time.Minute},
+		Deadline: &deadline,
+	}
+	decoded := roundTrip(t, v)
+
+	want := v
+	want.At = at.UTC()
+	want.Seconds = at.Truncate(time.Second).UTC()
+	want.Millis = at.Truncate(time.Millisecond).UTC()
+	want.Nanos = at.UTC()
+	want.History = []time.Time{at.UTC(), {}, expires}
+	if !reflect.DeepEqual(decoded, want) {
+		t.Errorf("round trip = %+v, want %+v", decoded, want)
+	}
+	if loc := decoded.At.Location(); loc != time.UTC {
+		t.Errorf("decoded location = %v, want UTC", loc)
+	}
+}
+
+func TestZeroTime(t *testing.T) {
+	zero := time.Time{}
+	v := Event{Expires: &zero, History: []time.Time{{}}, Backoff: []time.Duration{}}
+	decoded := roundTrip(t, v)
+	if !reflect.DeepEqual(decoded, v) {
+		t.Errorf("round trip = %+v, want %+v", decoded, v)
+	}
+	for name, at := range map[string]time.Time{
+		"At": decoded.At, "Seconds": decoded.Seconds, "Millis": decoded.Millis, "Nanos": decoded.Nanos, "Expires": *decoded.Expires,
+	} {
+		if !at.IsZero() {
+			t.Errorf("%s = %v, want the zero time", name, at)
+		}
+	}
+}
+
+func TestUnixNanoOutOfRange(t *testing.T) {
+	v := Event{Nanos: time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if _, err := v.MarshalBorsh(); err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Fatalf("MarshalBorsh() error = %v, want out of range", err)
+	}
+}
+
+func TestInvalidNanoseconds(t *testing.T) {
+	data, err := Event{}.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	// At starts after the ID and the nil Parent, its nanoseconds after the seconds
+	binary.LittleEndian.PutUint32(data[16+1+8:], uint32(time.Second))
+	var decoded Event
+	if err := decoded.UnmarshalBorsh(data); err == nil || !strings.Contains(err.Error(), "invalid nanoseconds") {
+		t.Fatalf("UnmarshalBorsh() error = %v, want invalid nanoseconds", err)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	v := Event{At: time.Unix(1700000000, 5).UTC(), ID: uuid.UUID{9}}
+	if _, err := v.Encode(); err != nil {
+		t.Fatalf("Encode() failed: %v", err)
+	}
+}
+
+// TestUUIDs checks that a UUID is told by its name and layout rather than by the name of its package
+func TestUUIDs(t *testing.T) {
+	parent := uuid.UUID{0xff}
+	v := Session{ID: UUID{1, 2, 3}, Parent: &parent}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	want := append(append(append([]byte{}, v.ID[:]...), 1), parent[:]...)
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+
+	fields := Session{}.BorshSchema().Definitions["Session"].Struct.Fields
+	for i, want := range []string{"Uuid", "Option<Uuid>"} {
+		if got := fields[i].Declaration; got != want {
+			t.Errorf("field %s is declared %s, want %s", fields[i].Name, got, want)
+		}
+	}
+}
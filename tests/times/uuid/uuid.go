@@ -0,0 +1,10 @@
+// Package uuid stands in for github.com/google/uuid, which the module does not depend on
+package uuid
+
+// UUID has the same layout and MarshalBinary as github.com/google/uuid.UUID
+type UUID [16]byte
+
+// MarshalBinary returns the 16 bytes of the UUID
+func (u UUID) MarshalBinary() ([]byte, error) {
+	return u[:], nil
+}
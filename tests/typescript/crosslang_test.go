@@ -0,0 +1,49 @@
+//go:build crosslang
+
+package typescript
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh/borshtest"
+)
+
+// TestTypeScriptInvalidUTF8 checks that the TypeScript classes treat a string that is not valid
+// UTF-8 as Go does: lenient decoding accepts it, with U+FFFD for the invalid byte, and strict
+// decoding rejects it
+func TestTypeScriptInvalidUTF8(t *testing.T) {
+	data, err := invalidUTF8.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	module, err := filepath.Abs("header_borshgen.ts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := fmt.Sprintf(`import { Header } from %q;
+
+const data = Uint8Array.from(Buffer.from(%q, "hex"));
+const h = Header.deserialize(data, false);
+if (h.sender !== "a\ufffdb") {
+  throw new Error("lenient deserialize: sender = " + JSON.stringify(h.sender));
+}
+let rejected = false;
+try {
+  Header.deserialize(data, true);
+} catch (e) {
+  rejected = String(e).includes("not valid UTF-8");
+}
+if (!rejected) {
+  throw new Error("strict deserialize accepted invalid UTF-8");
+}
+`, "file://"+filepath.ToSlash(module), hex.EncodeToString(data))
+	file := filepath.Join(t.TempDir(), "utf8.test.ts")
+	if err := os.WriteFile(file, []byte(script), 0644); err != nil {
+		t.Fatal(err)
+	}
+	borshtest.RunTypeScript(t, file)
+}
@@ -0,0 +1,7 @@
+package typescript
+
+//go:generate borshgen -tag=msg -fallback=json -gen-tests -lang=ts,rust
+type Header struct {
+	Version uint8  `msg:"version" enc:""`
+	Sender  string `msg:"sender" enc:"from"`
+}
@@ -0,0 +1,262 @@
+package typescript
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Header) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 2
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+			tags[i] = "sender"
+			encTypes[i] = "from"
+			values[i] = s.Sender
+			i++
+			tags[i] = "version"
+			encTypes[i] = ""
+			values[i] = s.Version
+			i++
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Header) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Header", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Header to buf.
+// The FieldPath of a returned EncodeError is relative to Header
+func (s Header) EncodeBorsh(buf *borsh.Writer) error {
+	
+	// Field: Sender (tag: sender)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: string
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Sender
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Sender", err)
+					}
+					buf.Write([]byte(str))
+		}
+	}
+	
+	// Field: Version (tag: version)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: uint8
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.Version))
+		}
+	}
+	return nil
+}
+func (s Header) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Header in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Header) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Header", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Header in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Header) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Header", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Header to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Header) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Header", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Header to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Header
+func (s Header) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(s.Version))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Sender
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Sender", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Header) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Header", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Header like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Header, without trailing bytes
+func (s *Header) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Header", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Header in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Header, so wrap unbuffered readers in a bufio.Reader
+func (s *Header) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Header", err)
+}
+
+// ReadBorsh decodes Header nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Header
+func (s *Header) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Header is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Header
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Version", err)
+	}
+	__m := uint8(__v)
+	s.Version = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Sender", err)
+	}
+	__m := string(__s)
+	s.Sender = (__m)
+		}
+	return err
+}
+func (s Header) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Sender
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Header by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Header) CompareBorsh(other any) int {
+	o := other.(Header)
+	_ = o
+	if c := borsh.Compare(s.Version, o.Version); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Sender, o.Sender); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Header in fields typed by an interface
+func (Header) BorshTypeID() uint64 {
+	return 0x66dd5565cf5458a0
+}
+func init() {
+	Registry.Register(0x66dd5565cf5458a0, func() borsh.BorshEncoder { return new(Header) })
+}
@@ -0,0 +1,36 @@
+package typescript
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/mlayerprotocol/go-borshgen/tests/constants"
+	"github.com/mlayerprotocol/go-borshgen/tests/embedded/common"
+)
+
+type Nonce int64
+
+// Message uses the legacy layout, with u16 length prefixes and length-prefixed nested structs.
+// Header is imported from header_borshgen.ts, and common.Header is declared as CommonHeader
+//
+//go:generate borshgen -tag=msg -fallback=json -gen-tests -lang=ts,rust
+type Message struct {
+	Header   Header             `msg:"header" enc:""`
+	Origin   common.Header      `msg:"origin"`
+	Nonce    Nonce              `msg:"nonce,int64" enc:""`
+	Body     string             `msg:"body" max:"64" enc:""`
+	Payload  []byte             `msg:"payload,_DefaultByteArrayEncoder" enc:"data"`
+	Meta     json.RawMessage    `msg:"meta"`
+	Sent     time.Time          `msg:"sent,unixmilli" enc:"at"`
+	Expires  *time.Time         `msg:"expires"`
+	Status   constants.Status   `msg:"status" enc:""`
+	Amount   *big.Int           `msg:"amount,u128"`
+	Note     *string            `msg:"note"`
+	Replies  []Header           `msg:"replies" enc:""`
+	Quorum   [3]uint16          `msg:"quorum"`
+	Weights  map[string]float64 `msg:"weights" enc:""`
+	Flags    map[uint8]struct{} `msg:"flags"`
+	Signers  []string           `msg:"signers,set" enc:""`
+	Previous *Message           `msg:"previous"`
+}
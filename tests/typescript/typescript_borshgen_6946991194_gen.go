@@ -0,0 +1,1461 @@
+package typescript
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+	"github.com/mlayerprotocol/go-borshgen/tests/embedded/common"
+	"time"
+	"github.com/mlayerprotocol/go-borshgen/tests/constants"
+	"math/big"
+)
+var _ common.Header
+var _ time.Time
+var _ constants.Status
+var _ big.Int
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Message) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 9
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+			tags[i] = "body"
+			encTypes[i] = ""
+			values[i] = s.Body
+			i++
+			tags[i] = "header"
+			encTypes[i] = ""
+			values[i] = s.Header
+			i++
+			tags[i] = "nonce"
+			encTypes[i] = ""
+			values[i] = s.Nonce
+			i++
+			tags[i] = "payload"
+			encTypes[i] = "data"
+			values[i] = s.Payload
+			i++
+			tags[i] = "replies"
+			encTypes[i] = ""
+			values[i] = s.Replies
+			i++
+			tags[i] = "sent"
+			encTypes[i] = "at"
+			values[i] = s.Sent
+			i++
+			tags[i] = "signers"
+			encTypes[i] = ""
+			values[i] = s.Signers
+			i++
+			tags[i] = "status"
+			encTypes[i] = ""
+			values[i] = s.Status
+			i++
+			tags[i] = "weights"
+			encTypes[i] = ""
+			values[i] = s.Weights
+			i++
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Message) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Message", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Message to buf.
+// The FieldPath of a returned EncodeError is relative to Message
+func (s Message) EncodeBorsh(buf *borsh.Writer) error {
+	
+	// Field: Body (tag: body)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: string
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Body
+					if err := checkLength("string", len(str), 64); err != nil {
+						return borsh.EncodeFieldError("Body", err)
+					}
+					buf.Write([]byte(str))
+		}
+	}
+	
+	// Field: Header (tag: header)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: Header
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := borsh.WriteEncoded(buf, s.Header); err != nil {
+						return borsh.EncodeFieldError("Header", err)
+					}
+		}
+	}
+	
+	// Field: Nonce (tag: nonce)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: Nonce
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(s.Nonce))
+		}
+	}
+	
+	// Field: Payload (tag: payload)
+	// IsBasicType: false
+	// CustomeFieldEncoder: true
+	// CustomeElementncoder: 
+	{
+		{
+			data, err := _DefaultByteArrayEncoder.Encode(((s.Payload)), s)
+			if err == nil {
+				err = checkLength("byte slice", len(data), MaxSliceLen)
+			}
+			if err != nil {
+				return borsh.EncodeFieldError("Payload", err)
+			}
+			buf.Write(data)
+		}
+	}
+	
+	// Field: Replies (tag: replies)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// Replies (replies) - slice
+				// ElementType: []Header
+				// Type: []Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Replies: []Replies
+	if err := checkLength("slice", len((s.Replies)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Replies", err)
+	}
+		for i, item := range (s.Replies) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Version uint8 "msg:\"version\" enc:\"\""; Sender string "msg:\"sender\" enc:\"from\""}
+					if err := borsh.WriteEncoded(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Replies", i), err)
+					}
+		}
+		}
+	}
+	
+	// Field: Sent (tag: sent)
+	// IsBasicType: true
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: struct
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: time_unixmilli
+					if err := _CustomTimeUnixMilliEncoder.Write(buf, s.Sent); err != nil {
+						return borsh.EncodeFieldError("Sent", err)
+					}
+		}
+	}
+	
+	// Field: Signers (tag: signers)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: 
+	{
+		{
+		
+				// Signers (signers) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Signers: []Signers
+	if err := checkLength("slice", len((s.Signers)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Signers", err)
+	}
+		for i, item := range (s.Signers) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Signers", i), err)
+					}
+					buf.Write([]byte(str))
+		}
+		}
+	}
+	
+	// Field: Status (tag: status)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: uint8
+	{
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u8
+					if !(s.Status).Valid() {
+						return borsh.EncodeFieldError("Status", fmt.Errorf("invalid enum value %d", s.Status))
+					}
+					buf.WriteByte(byte(s.Status))
+		}
+	}
+	
+	// Field: Weights (tag: weights)
+	// IsBasicType: false
+	// CustomeFieldEncoder: false
+	// CustomeElementncoder: map[string]float64
+	{
+		{
+		
+				// Weights (weights) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Weights)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Weights", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Weights)))
+			for mk, mv := range (s.Weights) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Weights", mk), err)
+					}
+					buf.Write([]byte(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType float64
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(mv)
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := borsh.SortMapEntries(entries); err != nil {
+				return borsh.EncodeFieldError("Weights", err)
+			}
+			for _, entry := range entries {
+				buf.Write(entry.Key)
+				buf.Write(entry.Value)
+			}
+		}
+		}
+	}
+	return nil
+}
+func (s Message) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Message in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Message) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Message", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Message in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Message) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Message", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Message to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Message) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Message", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Message to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Message
+func (s Message) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Header); err != nil {
+						return borsh.EncodeFieldError("Header", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Origin); err != nil {
+						return borsh.EncodeFieldError("Origin", err)
+					}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: int64
+					buf.WriteUint64(uint64(s.Nonce))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Body
+					if err := checkLength("string", len(str), 64); err != nil {
+						return borsh.EncodeFieldError("Body", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+			data, err := _DefaultByteArrayEncoder.MarshalBorsh(((s.Payload)), s)
+			if err == nil {
+				err = checkLength("byte slice", len(data), MaxSliceLen)
+			}
+			if err != nil {
+				return borsh.EncodeFieldError("Payload", err)
+			}
+			appendBytes(buf, data)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: true
+	
+			// s.Meta - custom type
+			
+				// Element: []byte
+		data, err := _DefaultJsonRawMessageEncoder.MarshalBorsh((s.Meta), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("Meta", err)
+		}
+		appendBytes(buf, data)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: time_unixmilli
+					if err := _CustomTimeUnixMilliEncoder.Write(buf, s.Sent); err != nil {
+						return borsh.EncodeFieldError("Sent", err)
+					}
+		}
+		{
+			if s.Expires == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPExpires
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Expires (expires) - Pointer
+					// ElementType: time
+					// Type: time.Time
+					// ActualType: struct
+					// BasicType: true
+			
+					// ElementType: time.Time
+	
+					// BASICTYPE: true
+					// ELNTYPE: time
+					if err := _CustomTimeTimeEncoder.Write(buf, *s.Expires); err != nil {
+						return borsh.EncodeFieldError("Expires", err)
+					}
+		}
+					SKIPExpires:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+			// s.Status - custom type
+			
+				// Element: enum_u8
+	
+					// BASICTYPE: true
+					// ELNTYPE: enum_u8
+					if !(s.Status).Valid() {
+						return borsh.EncodeFieldError("Status", fmt.Errorf("invalid enum value %d", s.Status))
+					}
+					buf.WriteByte(byte(s.Status))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: u128
+					if err := buf.WriteU128(s.Amount); err != nil {
+						return borsh.EncodeFieldError("Amount", err)
+					}
+		}
+		{
+			if s.Note == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPNote
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Note (note) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := *s.Note
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Note", err)
+					}
+					appendString(buf, string(str))
+		}
+					SKIPNote:
+		{
+		
+				// Replies (replies) - slice
+				// ElementType: []Header
+				// Type: []Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Replies: []Replies
+	if err := checkLength("slice", len((s.Replies)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Replies", err)
+	}
+	 appendLength(buf, len((s.Replies)))
+		for i, item := range (s.Replies) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Version uint8 "msg:\"version\" enc:\"\""; Sender string "msg:\"sender\" enc:\"from\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Replies", i), err)
+					}
+		}
+		}
+		{
+		
+				// Quorum (quorum) - slice
+				// ElementType: [3]uint16
+				// Type: [3]uint16
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Fixed array of length true: [3]Quorum
+	for i := 0; i < 3; i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(s.Quorum[i]))
+	}
+		}
+		{
+		
+				// Weights (weights) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Weights)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Weights", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Weights)))
+			for mk, mv := range (s.Weights) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := mk
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Key("Weights", mk), err)
+					}
+					appendString(buf, string(str))
+					entry.Key = buf.Bytes()
+				}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType float64
+	
+					// BASICTYPE: true
+					// ELNTYPE: float64
+					buf.WriteFloat64(mv)
+					entry.Value = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Weights", err)
+			}
+		}
+		}
+		{
+		
+				// Flags (flags) - map
+
+		// Map: entries sorted by key. Sets have no values
+		{
+			if err := checkLength("map", len((s.Flags)), MaxSliceLen); err != nil {
+				return borsh.EncodeFieldError("Flags", err)
+			}
+			entries := make([]borsh.MapEntry, 0, len((s.Flags)))
+			for mk, mv := range (s.Flags) {
+				_, _ = mk, mv
+				entry := borsh.MapEntry{Order: mk}
+				{
+					buf := borsh.NewBufferWriter(nil)
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint8
+					buf.WriteByte(byte(mk))
+					entry.Key = buf.Bytes()
+				}
+				entries = append(entries, entry)
+			}
+			if err := appendMap(buf, entries); err != nil {
+				return borsh.EncodeFieldError("Flags", err)
+			}
+		}
+		}
+		{
+		
+				// Signers (signers) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Signers: []Signers
+	if err := checkLength("slice", len((s.Signers)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Signers", err)
+	}
+	 appendLength(buf, len((s.Signers)))
+		for i, item := range (s.Signers) {
+			_ = i
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement((s.Signers)[i-1], item, i); err != nil {
+					return borsh.EncodeFieldError(borsh.Index("Signers", i), err)
+				}
+			}
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := item
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Signers", i), err)
+					}
+					appendString(buf, string(str))
+		}
+		}
+		{
+			if s.Previous == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPPrevious
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Previous (previous) - Pointer
+					// ElementType: struct{Header github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"header\" enc:\"\""; Origin github.com/mlayerprotocol/go-borshgen/tests/embedded/common.Header "msg:\"origin\""; Nonce github.com/mlayerprotocol/go-borshgen/tests/Nonce "msg:\"nonce,int64\" enc:\"\""; Body string "msg:\"body\" max:\"64\" enc:\"\""; Payload []byte "msg:\"payload,_DefaultByteArrayEncoder\" enc:\"data\""; Meta encoding/json.RawMessage "msg:\"meta\""; Sent time.Time "msg:\"sent,unixmilli\" enc:\"at\""; Expires *time.Time "msg:\"expires\""; Status github.com/mlayerprotocol/go-borshgen/tests/constants.Status "msg:\"status\" enc:\"\""; Amount *math/big.Int "msg:\"amount,u128\""; Note *string "msg:\"note\""; Replies []github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"replies\" enc:\"\""; Quorum [3]uint16 "msg:\"quorum\""; Weights map[string]float64 "msg:\"weights\" enc:\"\""; Flags map[uint8]struct{} "msg:\"flags\""; Signers []string "msg:\"signers,set\" enc:\"\""; Previous *Message "msg:\"previous\""}
+					// Type: Message
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Message
+					if err := writeNested(buf, s.Previous); err != nil {
+						return borsh.EncodeFieldError("Previous", err)
+					}
+		}
+					SKIPPrevious:
+	return nil
+}
+func (s *Message) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Message", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Message like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Message, without trailing bytes
+func (s *Message) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Message", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Message in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Message, so wrap unbuffered readers in a bufio.Reader
+func (s *Message) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Message", err)
+}
+
+// ReadBorsh decodes Message nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Message
+func (s *Message) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Message is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Message
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Header{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Header", err)
+					}
+						s.Header = *m
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &common.Header{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Origin", err)
+					}
+						s.Origin = *m
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint64()
+	if err != nil {
+		return r.FieldError("Nonce", err)
+	}
+	__m := Nonce(__v)
+	s.Nonce = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, 64)
+	if err != nil {
+		return r.FieldError("Body", err)
+	}
+	__m := string(__s)
+	s.Body = (__m)
+		}
+		{
+				itemData, err := readByteSlice(r, MaxSliceLen)
+				if err != nil {
+					return r.FieldError("Payload", err)
+				}
+				if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+					return r.FieldError("Payload", err)
+				} else {
+				 		_m := (_v).([]byte)
+					s.Payload = _m
+				}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: true
+		itemData, err := readByteSlice(r, MaxSliceLen)
+		if err != nil {
+			return r.FieldError("Meta", err)
+		}
+		if _v, err := _DefaultJsonRawMessageEncoder.UnmarshalBorsh(itemData); err != nil {
+			return r.FieldError("Meta", err)
+		} else {
+			_m := (_v).(json.RawMessage)
+			s.Meta = _m
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := _CustomTimeUnixMilliEncoder.Read(r)
+	if err != nil {
+		return r.FieldError("Sent", err)
+	}
+	s.Sent = __v
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Expires", err)
+				}
+				if !present {
+					s.Expires = nil
+					goto SKIPExpires
+				} 
+			}
+		{
+		
+					// Expires (expires) - Pointer
+					// ElementType: time
+					// Type: time.Time
+					// ActualType: struct
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: time.Time
+					// IsPointer: true
+	__v, err := _CustomTimeTimeEncoder.Read(r)
+	if err != nil {
+		return r.FieldError("Expires", err)
+	}
+	s.Expires = &__v
+		}
+					SKIPExpires:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError("Status", err)
+	}
+	__m := constants.Status(__v)
+	if !__m.Valid() {
+		return r.FieldError("Status", fmt.Errorf("invalid Status value %d", __m))
+	}
+	s.Status = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	s.Amount, err = r.ReadU128()
+	if err != nil {
+		return r.FieldError("Amount", err)
+	}
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Note", err)
+				}
+				if !present {
+					s.Note = nil
+					goto SKIPNote
+				} 
+			}
+		{
+		
+					// Note (note) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+					// IsStruct: false
+					// ElementType: string
+					// IsPointer: true
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Note", err)
+	}
+	__m := string(__s)
+	s.Note = &(__m)
+		}
+					SKIPNote:
+		{
+		
+				// Replies (replies) - slice
+				// ElementType: []Header
+				// Type: []Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Replies: []Replies
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Replies", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Header](r, length)
+		if err != nil {
+			return r.FieldError("Replies", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Version uint8 "msg:\"version\" enc:\"\""; Sender string "msg:\"sender\" enc:\"from\""}
+			// Element Header
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Header{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Replies", i), err)
+					}
+						p[i] = *m
+		}
+				s.Replies =  p
+		}
+		{
+		
+				// Quorum (quorum) - slice
+				// ElementType: [3]uint16
+				// Type: [3]uint16
+				// CustomType: 
+				// IsCustomEncoder: false
+	var p = [3]uint16{}
+	length := 3
+	for i := 0; i < int(length); i++ {
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError(borsh.Index("Quorum", i), err)
+	}
+	__m := uint16(__v)
+	p[i] = (__m)
+	}
+		//_m := (p)
+		s.Quorum = (p)
+		}
+		{
+		
+				// Weights (weights) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Weights", err)
+			}
+			mp, err := borsh.MakeMap[map[string]float64](r, mapLen)
+			if err != nil {
+				return r.FieldError("Weights", err)
+			}
+			var prevKey string
+			for mi := 0; mi < mapLen; mi++ {
+				var mk string
+				var mv float64
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Weights", mi), err)
+	}
+	__m := string(__s)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Weights", mk), err)
+					}
+					prevKey = mk
+				}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType float64
+			// Element float64
+	__v, err := r.ReadFloat64()
+	if err != nil {
+		return r.FieldError(borsh.Key("Weights", mk), err)
+	}
+	__m := float64(__v)
+	mv = (__m)
+				}
+				mp[mk] = mv
+			}
+			s.Weights = mp
+		}
+		}
+		{
+		
+				// Flags (flags) - map
+
+		// Map: entry count then key/value pairs. Sets have no values
+		{
+			mapLen, err := readCount(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Flags", err)
+			}
+			mp, err := borsh.MakeMap[map[uint8]struct{}](r, mapLen)
+			if err != nil {
+				return r.FieldError("Flags", err)
+			}
+			var prevKey uint8
+			for mi := 0; mi < mapLen; mi++ {
+				var mk uint8
+				var mv struct{}
+				{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+	__v, err := r.ReadByte()
+	if err != nil {
+		return r.FieldError(borsh.Index("Flags", mi), err)
+	}
+	__m := uint8(__v)
+	mk = (__m)
+				}
+				if r.Strict() {
+					if err := checkMapKey(prevKey, mk, mi); err != nil {
+						return r.FieldError(borsh.Key("Flags", mk), err)
+					}
+					prevKey = mk
+				}
+				mp[mk] = mv
+			}
+			s.Flags = mp
+		}
+		}
+		{
+		
+				// Signers (signers) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Signers: []Signers
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Signers", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]string](r, length)
+		if err != nil {
+			return r.FieldError("Signers", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError(borsh.Index("Signers", i), err)
+	}
+	__m := string(__s)
+	p[i] = (__m)
+			
+			// Set: elements must be strictly ascending
+			if i > 0 {
+				if err := checkSetElement(p[i-1], p[i], i); err != nil {
+					return r.FieldError(borsh.Index("Signers", i), err)
+				}
+			}
+		}
+				s.Signers =  p
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Previous", err)
+				}
+				if !present {
+					s.Previous = nil
+					goto SKIPPrevious
+				} 
+			}
+		{
+		
+					// Previous (previous) - Pointer
+					// ElementType: struct{Header github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"header\" enc:\"\""; Origin github.com/mlayerprotocol/go-borshgen/tests/embedded/common.Header "msg:\"origin\""; Nonce github.com/mlayerprotocol/go-borshgen/tests/Nonce "msg:\"nonce,int64\" enc:\"\""; Body string "msg:\"body\" max:\"64\" enc:\"\""; Payload []byte "msg:\"payload,_DefaultByteArrayEncoder\" enc:\"data\""; Meta encoding/json.RawMessage "msg:\"meta\""; Sent time.Time "msg:\"sent,unixmilli\" enc:\"at\""; Expires *time.Time "msg:\"expires\""; Status github.com/mlayerprotocol/go-borshgen/tests/constants.Status "msg:\"status\" enc:\"\""; Amount *math/big.Int "msg:\"amount,u128\""; Note *string "msg:\"note\""; Replies []github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"replies\" enc:\"\""; Quorum [3]uint16 "msg:\"quorum\""; Weights map[string]float64 "msg:\"weights\" enc:\"\""; Flags map[uint8]struct{} "msg:\"flags\""; Signers []string "msg:\"signers,set\" enc:\"\""; Previous *Message "msg:\"previous\""}
+					// Type: Message
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Message
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Message{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Previous", err)
+					}
+						s.Previous = m
+		}
+					SKIPPrevious:
+	return err
+}
+func (s Message) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Header)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Header", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Origin)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Origin", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Body
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+			_size, err := _DefaultByteArrayEncoder.BinarySize(s.Payload, s)
+				if err != nil {
+						return 0, borsh.EncodeFieldError("Payload", err)
+				}
+				size += LengthPrefixSize + _size
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: true
+	
+	// VarVar s.Meta
+
+// Var s.Meta
+		_s, err := _DefaultJsonRawMessageEncoder.BinarySize(s.Meta, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder s.Meta: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 8
+		}
+		{
+			size++
+			if s.Expires == nil {
+				goto SKIPExpires
+			}
+		}
+		{
+		
+					// Expires (expires) - Pointer
+					// ElementType: time
+					// Type: time.Time
+					// ActualType: struct
+					// BasicType: true
+			
+					// ElementType: time.Time
+				size += 12
+		}
+			SKIPExpires:
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 16
+		}
+		{
+			size++
+			if s.Note == nil {
+				goto SKIPNote
+			}
+		}
+		{
+		
+					// Note (note) - Pointer
+					// ElementType: string
+					// Type: string
+					// ActualType: *string
+					// BasicType: true
+			
+					// ElementType: string
+	
+		// ElementType: string
+		
+			// Element: true
+				ptr := *s.Note
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+			SKIPNote:
+		{
+		
+				// Replies (replies) - slice
+				// ElementType: []Header
+				// Type: []Header
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Replies: []Replies
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Replies) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{Version uint8 "msg:\"version\" enc:\"\""; Sender string "msg:\"sender\" enc:\"from\""}
+			// Element Header
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Replies", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+		{
+		
+				// Quorum (quorum) - slice
+				// ElementType: [3]uint16
+				// Type: [3]uint16
+				// CustomType: 
+				// IsCustomEncoder: false
+
+// Fixed array of length true: [3]Quorum
+	for _, item := range  (s.Quorum) {
+		_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+				size += 2
+	}
+		}
+		{
+		
+				// Weights (weights) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Weights) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := mk
+				size += LengthPrefixSize + len([]byte(ptr))
+			}
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType float64
+			// Element float64
+				size += 8
+			}
+		}
+		}
+		{
+		
+				// Flags (flags) - map
+		size += LengthPrefixSize // for entry count
+		for mk, mv := range (s.Flags) {
+			_, _ = mk, mv
+			{
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint8
+			// Element uint8
+				size += 1
+			}
+		}
+		}
+		{
+		
+				// Signers (signers) - slice
+				// ElementType: []string
+				// Type: []string
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Signers: []Signers
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Signers) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType string
+			// Element string
+				ptr := item
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		}
+		{
+			size++
+			if s.Previous == nil {
+				goto SKIPPrevious
+			}
+		}
+		{
+		
+					// Previous (previous) - Pointer
+					// ElementType: struct{Header github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"header\" enc:\"\""; Origin github.com/mlayerprotocol/go-borshgen/tests/embedded/common.Header "msg:\"origin\""; Nonce github.com/mlayerprotocol/go-borshgen/tests/Nonce "msg:\"nonce,int64\" enc:\"\""; Body string "msg:\"body\" max:\"64\" enc:\"\""; Payload []byte "msg:\"payload,_DefaultByteArrayEncoder\" enc:\"data\""; Meta encoding/json.RawMessage "msg:\"meta\""; Sent time.Time "msg:\"sent,unixmilli\" enc:\"at\""; Expires *time.Time "msg:\"expires\""; Status github.com/mlayerprotocol/go-borshgen/tests/constants.Status "msg:\"status\" enc:\"\""; Amount *math/big.Int "msg:\"amount,u128\""; Note *string "msg:\"note\""; Replies []github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"replies\" enc:\"\""; Quorum [3]uint16 "msg:\"quorum\""; Weights map[string]float64 "msg:\"weights\" enc:\"\""; Flags map[uint8]struct{} "msg:\"flags\""; Signers []string "msg:\"signers,set\" enc:\"\""; Previous *Message "msg:\"previous\""}
+					// Type: Message
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Message
+	
+		// ElementType: struct{Header github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"header\" enc:\"\""; Origin github.com/mlayerprotocol/go-borshgen/tests/embedded/common.Header "msg:\"origin\""; Nonce github.com/mlayerprotocol/go-borshgen/tests/Nonce "msg:\"nonce,int64\" enc:\"\""; Body string "msg:\"body\" max:\"64\" enc:\"\""; Payload []byte "msg:\"payload,_DefaultByteArrayEncoder\" enc:\"data\""; Meta encoding/json.RawMessage "msg:\"meta\""; Sent time.Time "msg:\"sent,unixmilli\" enc:\"at\""; Expires *time.Time "msg:\"expires\""; Status github.com/mlayerprotocol/go-borshgen/tests/constants.Status "msg:\"status\" enc:\"\""; Amount *math/big.Int "msg:\"amount,u128\""; Note *string "msg:\"note\""; Replies []github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"replies\" enc:\"\""; Quorum [3]uint16 "msg:\"quorum\""; Weights map[string]float64 "msg:\"weights\" enc:\"\""; Flags map[uint8]struct{} "msg:\"flags\""; Signers []string "msg:\"signers,set\" enc:\"\""; Previous *Message "msg:\"previous\""}
+		
+			// Element: false
+	
+		// ElementType: struct{Header github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"header\" enc:\"\""; Origin github.com/mlayerprotocol/go-borshgen/tests/embedded/common.Header "msg:\"origin\""; Nonce github.com/mlayerprotocol/go-borshgen/tests/Nonce "msg:\"nonce,int64\" enc:\"\""; Body string "msg:\"body\" max:\"64\" enc:\"\""; Payload []byte "msg:\"payload,_DefaultByteArrayEncoder\" enc:\"data\""; Meta encoding/json.RawMessage "msg:\"meta\""; Sent time.Time "msg:\"sent,unixmilli\" enc:\"at\""; Expires *time.Time "msg:\"expires\""; Status github.com/mlayerprotocol/go-borshgen/tests/constants.Status "msg:\"status\" enc:\"\""; Amount *math/big.Int "msg:\"amount,u128\""; Note *string "msg:\"note\""; Replies []github.com/mlayerprotocol/go-borshgen/tests/Header "msg:\"replies\" enc:\"\""; Quorum [3]uint16 "msg:\"quorum\""; Weights map[string]float64 "msg:\"weights\" enc:\"\""; Flags map[uint8]struct{} "msg:\"flags\""; Signers []string "msg:\"signers,set\" enc:\"\""; Previous *Message "msg:\"previous\""}
+			bs, err := borsh.SizeOf(s.Previous)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Previous", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPPrevious:
+	return size, nil
+}
+
+// CompareBorsh orders Message by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Message) CompareBorsh(other any) int {
+	o := other.(Message)
+	_ = o
+	if c := borsh.Compare(s.Header, o.Header); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Origin, o.Origin); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Nonce, o.Nonce); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Body, o.Body); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Payload, o.Payload); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Meta, o.Meta); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Sent, o.Sent); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Expires, o.Expires); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Status, o.Status); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Amount, o.Amount); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Note, o.Note); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Replies, o.Replies); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Quorum, o.Quorum); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Weights, o.Weights); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Flags, o.Flags); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Signers, o.Signers); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Previous, o.Previous); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Message in fields typed by an interface
+func (Message) BorshTypeID() uint64 {
+	return 0xa3b55579239bf2f0
+}
+func init() {
+	Registry.Register(0xa3b55579239bf2f0, func() borsh.BorshEncoder { return new(Message) })
+}
@@ -0,0 +1,31 @@
+package typescript
+
+import (
+	"testing"
+
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+
+// invalidUTF8 is a Header whose sender is not valid UTF-8
+var invalidUTF8 = Header{Version: 1, Sender: "a\xffb"}
+
+func TestInvalidUTF8(t *testing.T) {
+	data, err := invalidUTF8.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+
+	// Lenient decoding keeps the bytes of the string
+	var got Header
+	if err := got.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if got != invalidUTF8 {
+		t.Errorf("UnmarshalBorsh() = %+v, want %+v", got, invalidUTF8)
+	}
+
+	err = new(Header).UnmarshalBorshStrict(data)
+	if borsh.KindOf(err) != borsh.InvalidValue {
+		t.Fatalf("UnmarshalBorshStrict() = %v, want an InvalidValue error", err)
+	}
+}
@@ -0,0 +1,18 @@
+package wire
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Inner struct {
+	A uint32 `msg:"a"`
+	S string `msg:"s"`
+}
+
+//go:generate borshgen -tag=msg -fallback=json -wire=borsh -gen-tests -lang=ts,rust
+type Outer struct {
+	Name   string   `msg:"name"`
+	Items  []uint16 `msg:"items"`
+	Inner  Inner    `msg:"inner"`
+	Opt    *Inner   `msg:"opt"`
+	Data   []byte   `msg:"data"`
+	Inners []Inner  `msg:"inners"`
+	Flag   bool     `msg:"flag"`
+}
@@ -0,0 +1,803 @@
+package wire
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"github.com/mlayerprotocol/go-borshgen/borsh"
+)
+var _ bytes.Buffer
+var _  sync.Pool
+var _ = fmt.Print
+var _ = errors.New("")
+var _ = binary.MaxVarintLen16
+var _  json.RawMessage
+var _  =  math.Pi
+var _ = fmt.Print
+var _ io.Writer
+var _ = slices.Grow[[]byte]
+var _ borsh.Writer
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Inner) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Inner) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Inner", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Inner to buf.
+// The FieldPath of a returned EncodeError is relative to Inner
+func (s Inner) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Inner) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Inner in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Inner) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Inner", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Inner in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Inner) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Inner", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Inner to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Inner) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Inner", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Inner to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Inner
+func (s Inner) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint32
+					buf.WriteUint32(uint32(s.A))
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.S
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("S", err)
+					}
+					appendString(buf, string(str))
+		}
+	return nil
+}
+func (s *Inner) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Inner", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Inner like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Inner, without trailing bytes
+func (s *Inner) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Inner", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Inner in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Inner, so wrap unbuffered readers in a bufio.Reader
+func (s *Inner) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Inner", err)
+}
+
+// ReadBorsh decodes Inner nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Inner
+func (s *Inner) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Inner is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Inner
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadUint32()
+	if err != nil {
+		return r.FieldError("A", err)
+	}
+	__m := uint32(__v)
+	s.A = (__m)
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("S", err)
+	}
+	__m := string(__s)
+	s.S = (__m)
+		}
+	return err
+}
+func (s Inner) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 4
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.S
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Inner by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Inner) CompareBorsh(other any) int {
+	o := other.(Inner)
+	_ = o
+	if c := borsh.Compare(s.A, o.A); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.S, o.S); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Inner in fields typed by an interface
+func (Inner) BorshTypeID() uint64 {
+	return 0x91bc870af212b9ac
+}
+func init() {
+	Registry.Register(0x91bc870af212b9ac, func() borsh.BorshEncoder { return new(Inner) })
+}
+
+// BorshSchema describes the Borsh encoding of Inner in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Inner) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Inner",
+		Definitions: map[string]borsh.Definition{
+			"Inner": borsh.StructDef(
+				borsh.Field{Name: "a", Declaration: "u32"},
+				borsh.Field{Name: "s", Declaration: "String"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"u32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Outer) EncodeFields() (tags []string, encTypes []string, values []any) {
+	len := 0
+	if len > 0 {
+		tags = make([]string, len)
+		encTypes = make([]string, len)
+		values = make([]any, len)
+		i := 0
+		_ = i
+	}
+	return tags, encTypes, values
+}
+
+	// Encode creates a deterministic encoding of fields with "enc" tag
+func (s Outer) Encode() ([]byte, error) {
+	buf := borsh.NewBufferWriter(nil)
+	if err := s.EncodeBorsh(buf); err != nil {
+		return nil, borsh.WithType("Outer", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBorsh writes the Encode form of Outer to buf.
+// The FieldPath of a returned EncodeError is relative to Outer
+func (s Outer) EncodeBorsh(buf *borsh.Writer) error {
+	return nil
+}
+func (s Outer) MarshalBorsh() ([]byte, error) {
+	// One pass into a growing slice, since sizing it first would walk the struct twice
+	return s.AppendBorsh(nil)
+}
+
+// AppendBorsh appends Outer in binary format to dst and returns the extended slice.
+// It only allocates when dst is short of capacity, so one buffer can be reused across messages.
+// On error dst is returned unchanged
+func (s Outer) AppendBorsh(dst []byte) ([]byte, error) {
+	w := borsh.GetWriter(dst)
+	defer borsh.PutWriter(w)
+	err := s.WriteBorsh(w)
+	out := w.Bytes()
+	if err != nil {
+		return dst, borsh.WithType("Outer", err)
+	}
+	return out, nil
+}
+
+// MarshalBorshTo writes Outer in binary format to the start of dst and returns the number of bytes written.
+// It fails with io.ErrShortBuffer when the encoding does not fit in len(dst)
+func (s Outer) MarshalBorshTo(dst []byte) (int, error) {
+	out, err := s.AppendBorsh(dst[:0:len(dst)])
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > len(dst) {
+		return 0, &borsh.EncodeError{Kind: borsh.ShortBuffer, FieldPath: "Outer", Err: fmt.Errorf("needs %d bytes, have %d: %w", len(out), len(dst), io.ErrShortBuffer)}
+	}
+	return len(out), nil
+}
+
+// WriteBorshTo writes Outer to out in binary format and returns the number of bytes written.
+// The encoding is flushed to out in chunks rather than built in memory first
+func (s Outer) WriteBorshTo(out io.Writer) (int64, error) {
+	w := borsh.NewWriter(out)
+	if err := s.WriteBorsh(w); err != nil {
+		return w.Written(), borsh.WithType("Outer", err)
+	}
+	err := w.Flush()
+	return w.Written(), err
+}
+
+// WriteBorsh writes Outer to buf, whichever package the struct holding it was generated in.
+// The FieldPath of a returned EncodeError is relative to Outer
+func (s Outer) WriteBorsh(buf *borsh.Writer) error {
+	var err error
+	_ = err
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: string
+					str := s.Name
+					if err := checkLength("string", len(str), MaxStringLen); err != nil {
+						return borsh.EncodeFieldError("Name", err)
+					}
+					appendString(buf, string(str))
+		}
+		{
+		
+				// Items (items) - slice
+				// ElementType: []uint16
+				// Type: []uint16
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Items: []Items
+	if err := checkLength("slice", len((s.Items)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Items", err)
+	}
+	 appendLength(buf, len((s.Items)))
+		for i, item := range (s.Items) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+	
+					// BASICTYPE: true
+					// ELNTYPE: uint16
+					buf.WriteUint16(uint16(item))
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+					if err := writeNested(buf, s.Inner); err != nil {
+						return borsh.EncodeFieldError("Inner", err)
+					}
+		}
+		{
+			if s.Opt == nil {
+				buf.WriteByte(0) // // nil marker
+				goto SKIPOpt
+			} else {
+				buf.WriteByte(1) // non-nil marker
+			}
+		}
+		{
+		
+					// Opt (opt) - Pointer
+					// ElementType: struct{A uint32 "msg:\"a\""; S string "msg:\"s\""}
+					// Type: Inner
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Inner
+					if err := writeNested(buf, s.Opt); err != nil {
+						return borsh.EncodeFieldError("Opt", err)
+					}
+		}
+					SKIPOpt:
+		{
+		
+				// Data (data) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+	data, err := _DefaultByteArrayEncoder.MarshalBorsh((s.Data), s)
+		if err == nil {
+			err = checkLength("byte slice", len(data), MaxSliceLen)
+		}
+		if err != nil {
+			return borsh.EncodeFieldError("Data", err)
+		}
+		 appendBytes(buf, data)
+		}
+		{
+		
+				// Inners (inners) - slice
+				// ElementType: []Inner
+				// Type: []Inner
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Inners: []Inners
+	if err := checkLength("slice", len((s.Inners)), MaxSliceLen); err != nil {
+		return borsh.EncodeFieldError("Inners", err)
+	}
+	 appendLength(buf, len((s.Inners)))
+		for i, item := range (s.Inners) {
+			_ = i
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{A uint32 "msg:\"a\""; S string "msg:\"s\""}
+					if err := writeNested(buf, item); err != nil {
+						return borsh.EncodeFieldError(borsh.Index("Inners", i), err)
+					}
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// BASICTYPE: true
+					// ELNTYPE: bool
+					if s.Flag {
+						buf.WriteByte(1)
+					} else {
+						buf.WriteByte(0)
+					}
+		}
+	return nil
+}
+func (s *Outer) UnmarshalBorsh(data []byte) (error) {
+	return borsh.WithType("Outer", newReader(data, StrictDecoding).Decode(s))
+}
+
+// UnmarshalBorshStrict unmarshals binary data to Outer like UnmarshalBorsh does with StrictDecoding:
+// data must be the canonical encoding of Outer, without trailing bytes
+func (s *Outer) UnmarshalBorshStrict(data []byte) (error) {
+	return borsh.WithType("Outer", newReader(data, true).Decode(s))
+}
+
+// ReadBorshFrom reads Outer in binary format from src and returns the number of bytes read.
+// It reads exactly the bytes of Outer, so wrap unbuffered readers in a bufio.Reader
+func (s *Outer) ReadBorshFrom(src io.Reader) (int64, error) {
+	r := borsh.NewReader(src)
+	r.SetStrict(StrictDecoding)
+	r.SetAllocLimit(MaxDecodeAlloc)
+	err := s.ReadBorsh(r, 0)
+	return r.BytesRead(), borsh.WithType("Outer", err)
+}
+
+// ReadBorsh decodes Outer nested in depth other structs from r.
+// The FieldPath of a returned DecodeError is relative to Outer
+func (s *Outer) ReadBorsh(r *borsh.Reader, depth int) (error) {
+	if depth > MaxDepth {
+		return r.Errorf(borsh.MaxDepth, "Outer is nested deeper than MaxDepth (%d)", MaxDepth)
+	}
+	
+	// FIELDS: Outer
+    var err error
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			  
+		// Basictype Unmarshalling
+	__s, err := readString(r, MaxStringLen)
+	if err != nil {
+		return r.FieldError("Name", err)
+	}
+	__m := string(__s)
+	s.Name = (__m)
+		}
+		{
+		
+				// Items (items) - slice
+				// ElementType: []uint16
+				// Type: []uint16
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Items: []Items
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Items", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]uint16](r, length)
+		if err != nil {
+			return r.FieldError("Items", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+	__v, err := r.ReadUint16()
+	if err != nil {
+		return r.FieldError(borsh.Index("Items", i), err)
+	}
+	__m := uint16(__v)
+	p[i] = (__m)
+		}
+				s.Items =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Inner{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Inner", err)
+					}
+						s.Inner = *m
+		}
+			{	present, err := r.ReadOption()
+				if err != nil {
+					return r.FieldError("Opt", err)
+				}
+				if !present {
+					s.Opt = nil
+					goto SKIPOpt
+				} 
+			}
+		{
+		
+					// Opt (opt) - Pointer
+					// ElementType: struct{A uint32 "msg:\"a\""; S string "msg:\"s\""}
+					// Type: Inner
+					// ActualType: struct
+					// BasicType: false
+					// IsStruct: true
+					// ElementType: Inner
+					// IsPointer: true
+	
+					// IsPointer: true
+					
+						// IsPointer: true
+					m := &Inner{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError("Opt", err)
+					}
+						s.Opt = m
+		}
+					SKIPOpt:
+		{
+		
+				// Data (data) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+			itemData, err := readByteSlice(r, MaxSliceLen)
+			if err != nil {
+				return r.FieldError("Data", err)
+			}
+			if _v, err := _DefaultByteArrayEncoder.UnmarshalBorsh(itemData); err != nil {
+				return r.FieldError("Data", err)
+			} else {
+				_m := (_v).([]byte)
+				s.Data = _m
+			}
+		}
+		{
+		
+				// Inners (inners) - slice
+				// ElementType: []Inner
+				// Type: []Inner
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Inners: []Inners
+	length, err := readCount(r, MaxSliceLen)
+	if err != nil {
+		return r.FieldError("Inners", err)
+	}
+		// The length is not trusted for the allocation, the slice grows as elements are read
+		p, err := borsh.MakeSlice[[]Inner](r, length)
+		if err != nil {
+			return r.FieldError("Inners", err)
+		}
+		for i := 0; i < int(length); i++ {
+			p = slices.Grow(p, 1)[:i+1]
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{A uint32 "msg:\"a\""; S string "msg:\"s\""}
+			// Element Inner
+	
+					// IsPointer: false
+					
+						// IsPointer: false
+					m := &Inner{}
+					if err := readNested(r, m, depth+1); err != nil {
+						return r.FieldError(borsh.Index("Inners", i), err)
+					}
+						p[i] = *m
+		}
+				s.Inners =  p
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+	__v, err := r.ReadBool()
+	if err != nil {
+		return r.FieldError("Flag", err)
+	}
+	__m := bool(__v)
+	s.Flag = (__m)
+		}
+	return err
+}
+func (s Outer) BinarySize() (int, error) {
+	size := 0
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				ptr := s.Name
+				size += LengthPrefixSize + len([]byte(ptr))
+		}
+		{
+		
+				// Items (items) - slice
+				// ElementType: []uint16
+				// Type: []uint16
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Items: []Items
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Items) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice true
+			// ElementType uint16
+			// Element uint16
+				size += 2
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+			bs, err := borsh.SizeOf(s.Inner)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Inner", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		{
+			size++
+			if s.Opt == nil {
+				goto SKIPOpt
+			}
+		}
+		{
+		
+					// Opt (opt) - Pointer
+					// ElementType: struct{A uint32 "msg:\"a\""; S string "msg:\"s\""}
+					// Type: Inner
+					// ActualType: struct
+					// BasicType: false
+			
+					// ElementType: Inner
+	
+		// ElementType: struct{A uint32 "msg:\"a\""; S string "msg:\"s\""}
+		
+			// Element: false
+	
+		// ElementType: struct{A uint32 "msg:\"a\""; S string "msg:\"s\""}
+			bs, err := borsh.SizeOf(s.Opt)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Opt", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+			SKIPOpt:
+		{
+		
+				// Data (data) - slice
+				// ElementType: []byte
+				// Type: []byte
+				// CustomType: []byte
+				// IsCustomEncoder: false
+
+// Var s.Data
+		_s, err := _DefaultByteArrayEncoder.BinarySize(s.Data, s)
+				if err != nil {
+					panic(fmt.Sprintf("failed to calculate binary size for custom encoder s.Data: %v", err))
+				}
+				size += LengthPrefixSize + _s
+		}
+		{
+		
+				// Inners (inners) - slice
+				// ElementType: []Inner
+				// Type: []Inner
+				// CustomType: 
+				// IsCustomEncoder: false
+
+	// Slice of Inners: []Inners
+	size += LengthPrefixSize // for slice length
+		for _, item := range (s.Inners) {
+			_ = item
+
+			// NONSLICE:
+			// IsBasice false
+			// ElementType struct{A uint32 "msg:\"a\""; S string "msg:\"s\""}
+			// Element Inner
+			bs, err := borsh.SizeOf(item)
+			if err != nil {
+				return 0, borsh.EncodeFieldError("Inners", err)
+			}
+					size += NestedPrefixSize + bs
+		}
+		}
+		{
+		
+		// IsCustomElementEncoder: false
+		// IsCustomElementEncoder: false
+				size += 1
+		}
+	return size, nil
+}
+
+// CompareBorsh orders Outer by its encoded fields in wire order, as Rust's derived Ord does.
+// It orders the keys of Borsh maps and the elements of Borsh sets
+func (s Outer) CompareBorsh(other any) int {
+	o := other.(Outer)
+	_ = o
+	if c := borsh.Compare(s.Name, o.Name); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Items, o.Items); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Inner, o.Inner); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Opt, o.Opt); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Data, o.Data); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Inners, o.Inners); c != 0 {
+		return c
+	}
+	if c := borsh.Compare(s.Flag, o.Flag); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// BorshTypeID returns the type ID written before Outer in fields typed by an interface
+func (Outer) BorshTypeID() uint64 {
+	return 0x7a4f1cf902157281
+}
+func init() {
+	Registry.Register(0x7a4f1cf902157281, func() borsh.BorshEncoder { return new(Outer) })
+}
+
+// BorshSchema describes the Borsh encoding of Outer in the layout of Rust's borsh::schema::BorshSchemaContainer
+func (Outer) BorshSchema() *borsh.Schema {
+	return &borsh.Schema{
+		Declaration: "Outer",
+		Definitions: map[string]borsh.Definition{
+			"()": borsh.PrimitiveDef(0),
+			"Inner": borsh.StructDef(
+				borsh.Field{Name: "a", Declaration: "u32"},
+				borsh.Field{Name: "s", Declaration: "String"},),
+			"Option<Inner>": borsh.EnumDef(1,
+				borsh.Variant{Discriminant: 0, Name: "None", Declaration: "()"},
+				borsh.Variant{Discriminant: 1, Name: "Some", Declaration: "Inner"}),
+			"Outer": borsh.StructDef(
+				borsh.Field{Name: "name", Declaration: "String"},
+				borsh.Field{Name: "items", Declaration: "Vec<u16>"},
+				borsh.Field{Name: "inner", Declaration: "Inner"},
+				borsh.Field{Name: "opt", Declaration: "Option<Inner>"},
+				borsh.Field{Name: "data", Declaration: "Vec<u8>"},
+				borsh.Field{Name: "inners", Declaration: "Vec<Inner>"},
+				borsh.Field{Name: "flag", Declaration: "bool"},),
+			"String": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"Vec<Inner>": borsh.SequenceDef(4, 0, 4294967295, "Inner"),
+			"Vec<u16>": borsh.SequenceDef(4, 0, 4294967295, "u16"),
+			"Vec<u8>": borsh.SequenceDef(4, 0, 4294967295, "u8"),
+			"bool": borsh.PrimitiveDef(1),
+			"u16": borsh.PrimitiveDef(2),
+			"u32": borsh.PrimitiveDef(4),
+			"u8": borsh.PrimitiveDef(1),
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBorshWireLayout(t *testing.T) {
+	v := Outer{
+		Name:   "ab",
+		Items:  []uint16{1, 2},
+		Inner:  Inner{A: 7, S: "x"},
+		Data:   []byte{9},
+		Inners: []Inner{{A: 1, S: ""}},
+		Flag:   true,
+	}
+	want := []byte{
+		2, 0, 0, 0, 'a', 'b', // Name: u32 length + bytes
+		2, 0, 0, 0, 1, 0, 2, 0, // Items: u32 count + u16 elements
+		7, 0, 0, 0, 1, 0, 0, 0, 'x', // Inner: inline, no length prefix
+		0,             // Opt: None
+		1, 0, 0, 0, 9, // Data: u32 length + bytes
+		1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, // Inners: u32 count + inline structs
+		1, // Flag
+	}
+
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBorsh() = %v, want %v", data, want)
+	}
+
+	size, err := v.BinarySize()
+	if err != nil {
+		t.Fatalf("BinarySize() failed: %v", err)
+	}
+	if size != len(want) {
+		t.Errorf("BinarySize() = %d, want %d", size, len(want))
+	}
+
+	var restored Outer
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if !reflect.DeepEqual(restored, v) {
+		t.Errorf("round trip mismatch: got %+v, want %+v", restored, v)
+	}
+}
+
+func TestBorshWireOption(t *testing.T) {
+	v := Outer{Opt: &Inner{A: 3, S: "yz"}}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	var restored Outer
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if restored.Opt == nil || *restored.Opt != *v.Opt {
+		t.Errorf("Opt mismatch: got %+v, want %+v", restored.Opt, v.Opt)
+	}
+}
+
+func TestBorshWireLongString(t *testing.T) {
+	// Longer than a uint16 prefix can describe
+	v := Inner{A: 1, S: strings.Repeat("a", 70000)}
+	data, err := v.MarshalBorsh()
+	if err != nil {
+		t.Fatalf("MarshalBorsh() failed: %v", err)
+	}
+	var restored Inner
+	if err := restored.UnmarshalBorsh(data); err != nil {
+		t.Fatalf("UnmarshalBorsh() failed: %v", err)
+	}
+	if restored.S != v.S {
+		t.Errorf("long string mismatch: got %d bytes, want %d", len(restored.S), len(v.S))
+	}
+}
+
+func TestBorshWireConstants(t *testing.T) {
+	if WireFormat != "borsh" || LengthPrefixSize != 4 || NestedPrefixSize != 0 {
+		t.Errorf("unexpected wire constants: %s %d %d", WireFormat, LengthPrefixSize, NestedPrefixSize)
+	}
+}